@@ -3,16 +3,45 @@ package api
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lib/pq"
+	"github.com/nhat195/simple_bank/authz"
 	db "github.com/nhat195/simple_bank/db/sqlc"
 	"github.com/nhat195/simple_bank/token"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
 )
 
+// accountResponse is an account as returned by the API. Accounts are given by
+// their account number; the internal ID is never exposed.
+type accountResponse struct {
+	AccountNumber string    `json:"account_number"`
+	Owner         string    `json:"owner"`
+	Balance       int64     `json:"balance"`
+	Currency      string    `json:"currency"`
+	ProductCode   string    `json:"product_code"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func newAccountResponse(account db.Account) accountResponse {
+	return accountResponse{
+		AccountNumber: account.AccountNumber,
+		Owner:         account.Owner,
+		Balance:       account.Balance,
+		Currency:      account.Currency,
+		ProductCode:   account.ProductCode,
+		CreatedAt:     account.CreatedAt,
+	}
+}
+
 type CreateAccountRequest struct {
 	Currency string `json:"currency" binding:"required,currency"`
+	Product  string `json:"product" binding:"omitempty,product"`
 }
 
 func (sever *Server) createAccount(ctx *gin.Context) {
@@ -25,11 +54,16 @@ func (sever *Server) createAccount(ctx *gin.Context) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
 	arg := db.CreateAccountParams{
-		Owner:    authPayload.Username,
-		Currency: req.Currency,
+		Owner:       authPayload.Username,
+		Currency:    req.Currency,
+		ProductCode: req.Product,
+	}
+	if arg.ProductCode == "" {
+		arg.ProductCode = util.ProductCurrent
 	}
+	arg.AccountNumber = util.NewAccountNumber(sever.config.AccountNumberCountry, sever.config.AccountNumberBankPrefix)
 
-	acc, err := sever.store.CreateAccount(ctx, arg)
+	result, err := sever.store.CreateAccountTx(ctx, arg)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
@@ -41,11 +75,12 @@ func (sever *Server) createAccount(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	ctx.JSON(http.StatusOK, acc)
+	ctx.JSON(http.StatusOK, newAccountResponse(result.Account))
 }
 
 type getAcountRequest struct {
-	Id int64 `uri:"id" binding:"required,min=1"`
+	// Id is either the account ID or the account number.
+	Id string `uri:"id" binding:"required"`
 }
 
 func (server *Server) getAccount(ctx *gin.Context) {
@@ -55,24 +90,67 @@ func (server *Server) getAccount(ctx *gin.Context) {
 		return
 	}
 
-	acc, err := server.store.GetAccount(ctx, req.Id)
+	accountID, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		account, valid := server.accountByNumber(ctx, req.Id)
+		if !valid {
+			return
+		}
+		accountID = account.ID
+	} else if accountID < 1 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("id must be a positive integer or an account number")))
+		return
+	}
+
+	acc, valid := server.authorizeAccount(ctx, accountID, authz.ViewAccount)
+	if !valid {
+		return
+	}
+	ctx.JSON(http.StatusOK, newAccountResponse(acc))
+}
+
+// accountByNumber loads an account by its account number, writing the error
+// response if the number is malformed or unknown.
+func (server *Server) accountByNumber(ctx *gin.Context, accountNumber string) (db.Account, bool) {
+	accountNumber = util.NormalizeAccountNumber(accountNumber)
+	if err := val.ValidateAccountNumber(accountNumber); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("account number %w", err)))
+		return db.Account{}, false
+	}
+
+	account, err := server.store.GetAccountByNumber(ctx, accountNumber)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
+			return account, false
 		}
 
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		return account, false
 	}
+
+	return account, true
+}
+
+// authorizeAccount loads an account and checks that the authenticated user is a
+// member allowed the permission on it, writing the error response otherwise.
+func (server *Server) authorizeAccount(ctx *gin.Context, accountID int64, permission authz.Permission) (db.Account, bool) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
-	if acc.Owner != authPayload.Username {
-		err := errors.New("account does not belong to the authenticated user")
-		ctx.JSON(http.StatusForbidden, errorResponse(err))
-		return
+	account, _, err := authz.AuthorizeAccount(ctx, server.store, authPayload.Username, accountID, permission)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, authz.ErrNotMember), errors.Is(err, authz.ErrNotPermitted):
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return account, false
 	}
-	ctx.JSON(http.StatusOK, acc)
+
+	return account, true
 }
 
 type ListAccountsParams struct {
@@ -89,9 +167,9 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
 	arg := db.ListAccountsParams{
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
-		Owner:  authPayload.Username,
+		Limit:    req.PageSize,
+		Offset:   (req.PageID - 1) * req.PageSize,
+		Username: authPayload.Username,
 	}
 
 	accounts, err := server.store.ListAccounts(ctx, arg)
@@ -100,5 +178,9 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	ctx.JSON(http.StatusOK, accounts)
+	rsp := make([]accountResponse, len(accounts))
+	for i, account := range accounts {
+		rsp[i] = newAccountResponse(account)
+	}
+	ctx.JSON(http.StatusOK, rsp)
 }
@@ -39,6 +39,10 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("currency", validatorCurrency)
+		v.RegisterValidation("product", validatorProduct)
+		v.RegisterValidation("reference", validatorReference)
+		v.RegisterValidation("alias_type", validatorAliasType)
+		v.RegisterValidation("account_number", validatorAccountNumber)
 	}
 
 	server.setupRouter()
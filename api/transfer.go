@@ -2,20 +2,71 @@ package api
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/nhat195/simple_bank/authz"
 	db "github.com/nhat195/simple_bank/db/sqlc"
 	"github.com/nhat195/simple_bank/token"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
 )
 
 type transferRequest struct {
-	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
-	Currency      string `json:"currency" binding:"required,currency"`
+	// Both accounts can be given by ID or by account number.
+	FromAccountID     int64  `json:"from_account_id" binding:"required_without=FromAccountNumber,excluded_with=FromAccountNumber,omitempty,min=1"`
+	FromAccountNumber string `json:"from_account_number" binding:"omitempty,account_number"`
+	// ToAccountID is left out when the payee is given by ToAliasType and ToAlias
+	// instead, which pay the default account of the recipient in the currency,
+	// or by BeneficiaryID, which pays a payee saved by the user.
+	ToAccountID     int64  `json:"to_account_id" binding:"required_without_all=ToAccountNumber ToAlias BeneficiaryID,excluded_with=ToAccountNumber ToAlias BeneficiaryID,omitempty,min=1"`
+	ToAccountNumber string `json:"to_account_number" binding:"excluded_with=ToAlias BeneficiaryID,omitempty,account_number"`
+	ToAliasType     string `json:"to_alias_type" binding:"required_with=ToAlias,omitempty,alias_type"`
+	ToAlias         string `json:"to_alias" binding:"required_with=ToAliasType,excluded_with=BeneficiaryID"`
+	BeneficiaryID   int64  `json:"beneficiary_id" binding:"omitempty,min=1"`
+	Amount          int64  `json:"amount" binding:"required,gt=0"`
+	Currency        string `json:"currency" binding:"required,currency"`
+	Description     string `json:"description" binding:"max=140"`
+	Reference       string `json:"reference" binding:"reference"`
+	// CounterpartyName overrides the payee name shown on the payer's statement.
+	CounterpartyName string          `json:"counterparty_name" binding:"max=140"`
+	Metadata         json.RawMessage `json:"metadata"`
+}
+
+// transferResponse is a completed transfer as returned to the payer. Both
+// accounts are given by account number, and only the payer's balance is shown.
+type transferResponse struct {
+	TransferID        int64           `json:"transfer_id"`
+	FromAccountNumber string          `json:"from_account_number"`
+	ToAccountNumber   string          `json:"to_account_number"`
+	Amount            int64           `json:"amount"`
+	Fee               int64           `json:"fee"`
+	Currency          string          `json:"currency"`
+	Description       string          `json:"description"`
+	Reference         string          `json:"reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+	Balance           int64           `json:"balance"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+func newTransferResponse(result db.TransferTxResult) transferResponse {
+	return transferResponse{
+		TransferID:        result.Transfer.ID,
+		FromAccountNumber: result.FromAccount.AccountNumber,
+		ToAccountNumber:   result.ToAccount.AccountNumber,
+		Amount:            result.Transfer.Amount,
+		Fee:               result.Fee,
+		Currency:          result.FromAccount.Currency,
+		Description:       result.Transfer.Description,
+		Reference:         result.Transfer.Reference,
+		Metadata:          result.Transfer.Metadata,
+		Balance:           result.FromAccount.Balance,
+		CreatedAt:         result.Transfer.CreatedAt,
+	}
 }
 
 func (server *Server) createTransfer(ctx *gin.Context) {
@@ -25,37 +76,65 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
+	if err := val.ValidateMetadata(req.Metadata); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("metadata %w", err)))
+		return
+	}
 
-	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	fromAccountID := req.FromAccountID
+	if req.FromAccountNumber != "" {
+		account, valid := server.accountByNumber(ctx, req.FromAccountNumber)
+		if !valid {
+			return
+		}
+		fromAccountID = account.ID
+	}
+
+	fromAccount, valid := server.authorizeAccount(ctx, fromAccountID, authz.MoveMoney)
 	if !valid {
 		return
 	}
-
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if fromAccount.Owner != authPayload.Username {
-		err := errors.New("from account doesn't belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	if !checkCurrency(ctx, fromAccount, req.Currency) {
 		return
 	}
 
-	_, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
-	if !valid {
+	var toAccount db.Account
+	switch {
+	case req.BeneficiaryID != 0:
+		toAccount, valid = server.beneficiaryAccount(ctx, req.BeneficiaryID, req.Currency)
+	case req.ToAlias != "":
+		toAccount, valid = server.recipientAccount(ctx, req.ToAliasType, req.ToAlias, req.Currency)
+	case req.ToAccountNumber != "":
+		toAccount, valid = server.accountByNumber(ctx, req.ToAccountNumber)
+		valid = valid && checkCurrency(ctx, toAccount, req.Currency)
+	default:
+		toAccount, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	}
+	if !valid || !server.checkPayeeCoolingOff(ctx, toAccount, req.Amount) {
 		return
 	}
 
 	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Amount:        req.Amount,
+		FromAccountID:    fromAccount.ID,
+		ToAccountID:      toAccount.ID,
+		Amount:           req.Amount,
+		Description:      req.Description,
+		Reference:        req.Reference,
+		CounterpartyName: req.CounterpartyName,
+		Metadata:         req.Metadata,
 	}
 
 	result, err := server.store.TransferTx(ctx, arg)
 
 	if err != nil {
+		if errors.Is(err, db.ErrTermDepositLocked) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	ctx.JSON(http.StatusOK, result)
+	ctx.JSON(http.StatusOK, newTransferResponse(result))
 }
 
 func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency string) (db.Account, bool) {
@@ -70,11 +149,102 @@ func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency s
 		return account, false
 	}
 
-	if account.Currency != currency {
-		err := fmt.Errorf("account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+	return account, checkCurrency(ctx, account, currency)
+}
+
+// recipientAccount resolves a payment alias to the account the recipient
+// receives payments in the currency into.
+func (server *Server) recipientAccount(ctx *gin.Context, aliasType string, alias string, currency string) (db.Account, bool) {
+	if err := val.ValidateAlias(aliasType, util.NormalizeAlias(aliasType, alias)); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("to_alias %w", err)))
+		return db.Account{}, false
+	}
+
+	user, err := server.store.ResolveAlias(ctx, aliasType, alias)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(fmt.Errorf("no recipient found for the alias")))
+			return db.Account{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Account{}, false
+	}
+
+	account, err := server.store.GetDefaultAccount(ctx, db.GetDefaultAccountParams{
+		Owner:    user.Username,
+		Currency: currency,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(fmt.Errorf("recipient has no %s account", currency)))
+			return account, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return account, false
 	}
 
 	return account, true
 }
+
+// beneficiaryAccount loads the account of a payee the user saved.
+func (server *Server) beneficiaryAccount(ctx *gin.Context, beneficiaryID int64, currency string) (db.Account, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	beneficiary, err := server.store.GetBeneficiary(ctx, beneficiaryID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return db.Account{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Account{}, false
+	}
+
+	if beneficiary.Username != authPayload.Username {
+		ctx.JSON(http.StatusNotFound, errorResponse(fmt.Errorf("beneficiary [%d] not found", beneficiaryID)))
+		return db.Account{}, false
+	}
+
+	return server.validAccount(ctx, beneficiary.AccountID, currency)
+}
+
+// checkPayeeCoolingOff checks that the user has known the payee long enough to
+// send it the amount, whichever way the payee was given.
+func (server *Server) checkPayeeCoolingOff(ctx *gin.Context, toAccount db.Account, amount int64) bool {
+	if amount <= db.CoolingOffTransferLimit {
+		return true
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	payeeSince, err := server.store.GetPayeeSince(ctx, db.GetPayeeSinceParams{
+		ToAccountID: toAccount.ID,
+		Username:    authPayload.Username,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if err := db.CheckPayeeTransfer(payeeSince, amount, time.Now()); err != nil {
+		err = fmt.Errorf("%w until %s", err, db.PayeeCoolingOffUntil(payeeSince).Format(time.RFC3339))
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+// checkCurrency checks that an account holds the requested currency, writing
+// the error response otherwise.
+func checkCurrency(ctx *gin.Context, account db.Account, currency string) bool {
+	if account.Currency != currency {
+		err := fmt.Errorf("account %s currency mismatch: %s vs %s", account.AccountNumber, account.Currency, currency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return false
+	}
+
+	return true
+}
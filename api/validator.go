@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/go-playground/validator/v10"
 	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
 )
 
 var validatorCurrency validator.Func = func(fl validator.FieldLevel) bool {
@@ -11,3 +12,31 @@ var validatorCurrency validator.Func = func(fl validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var validatorProduct validator.Func = func(fl validator.FieldLevel) bool {
+	if product, ok := fl.Field().Interface().(string); ok {
+		return util.IsCustomerProduct(product)
+	}
+	return false
+}
+
+var validatorReference validator.Func = func(fl validator.FieldLevel) bool {
+	if reference, ok := fl.Field().Interface().(string); ok {
+		return val.ValidateReference(reference) == nil
+	}
+	return false
+}
+
+var validatorAliasType validator.Func = func(fl validator.FieldLevel) bool {
+	if aliasType, ok := fl.Field().Interface().(string); ok {
+		return util.IsSupportedAliasType(aliasType)
+	}
+	return false
+}
+
+var validatorAccountNumber validator.Func = func(fl validator.FieldLevel) bool {
+	if accountNumber, ok := fl.Field().Interface().(string); ok {
+		return val.ValidateAccountNumber(util.NormalizeAccountNumber(accountNumber)) == nil
+	}
+	return false
+}
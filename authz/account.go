@@ -0,0 +1,69 @@
+package authz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+)
+
+// Permission is something a member may do with an account.
+type Permission int
+
+const (
+	// ViewAccount allows reading the account, its history and statements.
+	ViewAccount Permission = iota
+	// MoveMoney allows sending money out of the account.
+	MoveMoney
+	// ManageMembers allows adding and removing members of the account.
+	ManageMembers
+)
+
+var (
+	ErrNotMember    = errors.New("account doesn't belong to the user")
+	ErrNotPermitted = errors.New("the user's role on the account doesn't allow this")
+)
+
+// Allows reports whether a member with the given role has the permission.
+func (permission Permission) Allows(role string) bool {
+	switch permission {
+	case ViewAccount:
+		return util.IsSupportedMemberRole(role)
+	case MoveMoney:
+		return role == util.MemberOwner || role == util.MemberCoOwner
+	case ManageMembers:
+		return role == util.MemberOwner
+	}
+	return false
+}
+
+// AuthorizeAccount loads an account and checks that username is a member of it
+// whose role grants the permission. It returns sql.ErrNoRows if the account
+// doesn't exist, ErrNotMember or ErrNotPermitted if access is refused.
+func AuthorizeAccount(ctx context.Context, store db.Querier, username string, accountID int64, permission Permission) (db.Account, db.AccountMember, error) {
+	var member db.AccountMember
+
+	account, err := store.GetAccount(ctx, accountID)
+	if err != nil {
+		return account, member, err
+	}
+
+	member, err = store.GetAccountMember(ctx, db.GetAccountMemberParams{
+		AccountID: accountID,
+		Username:  username,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return account, member, ErrNotMember
+		}
+		return account, member, err
+	}
+
+	if !permission.Allows(member.Role) {
+		return account, member, ErrNotPermitted
+	}
+
+	return account, member, nil
+}
@@ -0,0 +1,126 @@
+package authz
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/nhat195/simple_bank/db/mock"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionAllows(t *testing.T) {
+	testCases := []struct {
+		role    string
+		allowed []Permission
+	}{
+		{role: util.MemberOwner, allowed: []Permission{ViewAccount, MoveMoney, ManageMembers}},
+		{role: util.MemberCoOwner, allowed: []Permission{ViewAccount, MoveMoney}},
+		{role: util.MemberViewer, allowed: []Permission{ViewAccount}},
+		{role: "unknown"},
+	}
+
+	for _, tc := range testCases {
+		for _, permission := range []Permission{ViewAccount, MoveMoney, ManageMembers} {
+			require.Equal(t, contains(tc.allowed, permission), permission.Allows(tc.role), "%s %d", tc.role, permission)
+		}
+	}
+}
+
+func contains(permissions []Permission, permission Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAuthorizeAccount(t *testing.T) {
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner()}
+	username := util.RandomOwner()
+	arg := db.GetAccountMemberParams{AccountID: account.ID, Username: username}
+
+	testCases := []struct {
+		name       string
+		permission Permission
+		buildStubs func(store *mockdb.MockStore)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name:       "OK",
+			permission: MoveMoney,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.AccountMember{AccountID: account.ID, Username: username, Role: util.MemberCoOwner}, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "AccountNotFound",
+			permission: ViewAccount,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name:       "NotMember",
+			permission: ViewAccount,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.AccountMember{}, sql.ErrNoRows)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrNotMember)
+			},
+		},
+		{
+			name:       "NotPermitted",
+			permission: ManageMembers,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.AccountMember{AccountID: account.ID, Username: username, Role: util.MemberCoOwner}, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrNotPermitted)
+			},
+		},
+		{
+			name:       "InternalError",
+			permission: ViewAccount,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.AccountMember{}, sql.ErrConnDone)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			_, _, err := AuthorizeAccount(context.Background(), store, username, account.ID, tc.permission)
+			tc.checkError(t, err)
+		})
+	}
+}
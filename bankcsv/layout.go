@@ -0,0 +1,73 @@
+// Package bankcsv parses the CSV statements exported by other banks into
+// external transactions, following a configurable column layout.
+package bankcsv
+
+import (
+	"fmt"
+)
+
+// Date formats a layout can use, named the way bank exports document them.
+var dateLayouts = map[string]string{
+	"YYYY-MM-DD": "2006-01-02",
+	"DD/MM/YYYY": "02/01/2006",
+	"MM/DD/YYYY": "01/02/2006",
+	"DD.MM.YYYY": "02.01.2006",
+	"YYYYMMDD":   "20060102",
+}
+
+// Layout describes where a bank puts each field in its CSV export.
+// Columns are numbered from 1; 0 means the export has no such column.
+// An export has either a signed amount column or separate credit and debit columns.
+type Layout struct {
+	Delimiter         rune
+	SkipRows          int
+	DateColumn        int
+	DateFormat        string
+	AmountColumn      int
+	CreditColumn      int
+	DebitColumn       int
+	ReferenceColumn   int
+	DescriptionColumn int
+	DecimalComma      bool
+}
+
+// Validate checks that the layout is complete and consistent.
+func (layout Layout) Validate() error {
+	if layout.Delimiter == 0 || layout.Delimiter == '"' || layout.Delimiter == '\r' || layout.Delimiter == '\n' {
+		return fmt.Errorf("invalid delimiter %q", layout.Delimiter)
+	}
+
+	if layout.SkipRows < 0 {
+		return fmt.Errorf("skip rows must not be negative")
+	}
+
+	if _, ok := dateLayouts[layout.DateFormat]; !ok {
+		return fmt.Errorf("unsupported date format %q", layout.DateFormat)
+	}
+
+	columns := map[string]int{
+		"date":        layout.DateColumn,
+		"amount":      layout.AmountColumn,
+		"credit":      layout.CreditColumn,
+		"debit":       layout.DebitColumn,
+		"reference":   layout.ReferenceColumn,
+		"description": layout.DescriptionColumn,
+	}
+	for name, column := range columns {
+		if column < 0 {
+			return fmt.Errorf("%s column must not be negative", name)
+		}
+	}
+
+	if layout.DateColumn == 0 {
+		return fmt.Errorf("date column is required")
+	}
+
+	signed := layout.AmountColumn > 0 && layout.CreditColumn == 0 && layout.DebitColumn == 0
+	split := layout.AmountColumn == 0 && layout.CreditColumn > 0 && layout.DebitColumn > 0
+	if !signed && !split {
+		return fmt.Errorf("either an amount column or both credit and debit columns are required")
+	}
+
+	return nil
+}
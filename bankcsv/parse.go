@@ -0,0 +1,202 @@
+package bankcsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Transaction is one booked line of an external statement. Amount is signed in
+// minor units: negative amounts left the account.
+type Transaction struct {
+	LineNumber  int
+	BookedOn    time.Time
+	Amount      int64
+	Reference   string
+	Description string
+}
+
+// LineError reports why a line of the file could not be parsed.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ParseError collects the errors of every line that could not be parsed.
+type ParseError struct {
+	Lines []*LineError
+}
+
+func (e *ParseError) Error() string {
+	if len(e.Lines) == 1 {
+		return e.Lines[0].Error()
+	}
+	return fmt.Sprintf("%v (and %d more)", e.Lines[0], len(e.Lines)-1)
+}
+
+// Parse reads every transaction of a CSV statement using the given layout.
+// It keeps going after a bad line so that all problems are reported at once
+// in a *ParseError; a file with any bad line yields no transactions.
+func Parse(r io.Reader, layout Layout) ([]Transaction, error) {
+	if err := layout.Validate(); err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = layout.Delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var transactions []Transaction
+	parseErr := &ParseError{}
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var csvErr *csv.ParseError
+			if errors.As(err, &csvErr) {
+				parseErr.Lines = append(parseErr.Lines, &LineError{Line: csvErr.Line, Err: csvErr.Err})
+				continue
+			}
+			return nil, err
+		}
+
+		if row < layout.SkipRows {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		transaction, err := parseRecord(record, layout)
+		if err != nil {
+			parseErr.Lines = append(parseErr.Lines, &LineError{Line: line, Err: err})
+			continue
+		}
+		transaction.LineNumber = line
+		transactions = append(transactions, transaction)
+	}
+
+	if len(parseErr.Lines) > 0 {
+		return nil, parseErr
+	}
+	return transactions, nil
+}
+
+func parseRecord(record []string, layout Layout) (Transaction, error) {
+	var transaction Transaction
+
+	field := func(column int) string {
+		if column == 0 || column > len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[column-1])
+	}
+
+	if layout.DateColumn > len(record) {
+		return transaction, fmt.Errorf("expected at least %d columns, got %d", layout.DateColumn, len(record))
+	}
+
+	bookedOn, err := time.Parse(dateLayouts[layout.DateFormat], field(layout.DateColumn))
+	if err != nil {
+		return transaction, fmt.Errorf("invalid date %q, expected %s", field(layout.DateColumn), layout.DateFormat)
+	}
+	transaction.BookedOn = bookedOn
+
+	if layout.AmountColumn > 0 {
+		transaction.Amount, err = ParseAmount(field(layout.AmountColumn), layout.DecimalComma)
+		if err != nil {
+			return transaction, err
+		}
+	} else {
+		credit, debit := field(layout.CreditColumn), field(layout.DebitColumn)
+		if credit != "" {
+			amount, err := ParseAmount(credit, layout.DecimalComma)
+			if err != nil {
+				return transaction, fmt.Errorf("credit: %w", err)
+			}
+			transaction.Amount += abs(amount)
+		}
+		if debit != "" {
+			amount, err := ParseAmount(debit, layout.DecimalComma)
+			if err != nil {
+				return transaction, fmt.Errorf("debit: %w", err)
+			}
+			transaction.Amount -= abs(amount)
+		}
+	}
+	if transaction.Amount == 0 {
+		return transaction, fmt.Errorf("amount is missing or zero")
+	}
+
+	transaction.Reference = field(layout.ReferenceColumn)
+	transaction.Description = field(layout.DescriptionColumn)
+	return transaction, nil
+}
+
+// ParseAmount parses a decimal amount like "-1,234.56" into minor units. With
+// decimalComma the roles of comma and dot are swapped, as in "1.234,56".
+// Negative amounts may also be written in parentheses or with a trailing minus.
+// Like every supported currency, amounts have at most two fraction digits.
+func ParseAmount(value string, decimalComma bool) (int64, error) {
+	decimal, thousands := ".", ","
+	if decimalComma {
+		decimal, thousands = ",", "."
+	}
+
+	s := strings.NewReplacer(thousands, "", " ", "", "'", "", " ", "").Replace(value)
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")"):
+		negative, s = true, s[1:len(s)-1]
+	case strings.HasPrefix(s, "-"):
+		negative, s = true, s[1:]
+	case strings.HasSuffix(s, "-"):
+		negative, s = true, s[:len(s)-1]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	units, fraction, _ := strings.Cut(s, decimal)
+	if units == "" || len(fraction) > 2 || !isDigits(units) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	amount, err := strconv.ParseInt(units+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+	return amount
+}
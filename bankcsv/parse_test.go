@@ -0,0 +1,153 @@
+package bankcsv
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	testCases := []struct {
+		name         string
+		value        string
+		decimalComma bool
+		want         int64
+		wantErr      bool
+	}{
+		{"Units", "12", false, 1_200, false},
+		{"Cents", "12.3", false, 1_230, false},
+		{"Thousands", "1,234.56", false, 123_456, false},
+		{"Negative", "-1,234.56", false, -123_456, false},
+		{"Plus", "+5.00", false, 500, false},
+		{"Parentheses", "(5.00)", false, -500, false},
+		{"TrailingMinus", "5,00-", true, -500, false},
+		{"DecimalComma", "1.234,56", true, 123_456, false},
+		{"Spaces", "1 234,56", true, 123_456, false},
+		{"TooPrecise", "1.234", false, 0, true},
+		{"Empty", "", false, 0, true},
+		{"Letters", "12a", false, 0, true},
+		{"Overflow", "999999999999999999999", false, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseAmount(tc.value, tc.decimalComma)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseSignedAmount(t *testing.T) {
+	layout := Layout{
+		Delimiter:         ',',
+		SkipRows:          1,
+		DateColumn:        1,
+		DateFormat:        "YYYY-MM-DD",
+		AmountColumn:      3,
+		ReferenceColumn:   4,
+		DescriptionColumn: 2,
+	}
+	file := "Date,Text,Amount,Ref\n" +
+		"2024-10-02,Rent,\"-1,250.00\",RENT-10\n" +
+		"\n" +
+		"2024-10-25,\"Salary, October\",2500,PAY/10\n"
+
+	transactions, err := Parse(strings.NewReader(file), layout)
+	require.NoError(t, err)
+	require.Equal(t, []Transaction{
+		{
+			LineNumber:  2,
+			BookedOn:    time.Date(2024, time.October, 2, 0, 0, 0, 0, time.UTC),
+			Amount:      -125_000,
+			Reference:   "RENT-10",
+			Description: "Rent",
+		},
+		{
+			LineNumber:  4,
+			BookedOn:    time.Date(2024, time.October, 25, 0, 0, 0, 0, time.UTC),
+			Amount:      250_000,
+			Reference:   "PAY/10",
+			Description: "Salary, October",
+		},
+	}, transactions)
+}
+
+func TestParseCreditDebitColumns(t *testing.T) {
+	layout := Layout{
+		Delimiter:       ';',
+		DateColumn:      1,
+		DateFormat:      "DD.MM.YYYY",
+		CreditColumn:    2,
+		DebitColumn:     3,
+		ReferenceColumn: 4,
+		DecimalComma:    true,
+	}
+	file := "02.10.2024;;1.250,00;RENT-10\n25.10.2024;2.500,00;;PAY/10\n"
+
+	transactions, err := Parse(strings.NewReader(file), layout)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	require.Equal(t, int64(-125_000), transactions[0].Amount)
+	require.Equal(t, int64(250_000), transactions[1].Amount)
+	require.Empty(t, transactions[0].Description)
+}
+
+func TestParseReportsEveryBadLine(t *testing.T) {
+	layout := Layout{
+		Delimiter:    ',',
+		DateColumn:   1,
+		DateFormat:   "YYYY-MM-DD",
+		AmountColumn: 2,
+	}
+	file := "2024-10-02,10.00\n02/10/2024,10.00\n2024-10-03,\n2024-10-04,1.00\n"
+
+	transactions, err := Parse(strings.NewReader(file), layout)
+	require.Nil(t, transactions)
+
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	require.Len(t, parseErr.Lines, 2)
+	require.Equal(t, 2, parseErr.Lines[0].Line)
+	require.Equal(t, 3, parseErr.Lines[1].Line)
+	require.Contains(t, err.Error(), "line 2")
+}
+
+func TestLayoutValidate(t *testing.T) {
+	valid := Layout{
+		Delimiter:    ',',
+		DateColumn:   1,
+		DateFormat:   "YYYY-MM-DD",
+		AmountColumn: 2,
+	}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name   string
+		update func(layout *Layout)
+	}{
+		{"NoDelimiter", func(layout *Layout) { layout.Delimiter = 0 }},
+		{"QuoteDelimiter", func(layout *Layout) { layout.Delimiter = '"' }},
+		{"UnknownDateFormat", func(layout *Layout) { layout.DateFormat = "2006-01-02" }},
+		{"NoDateColumn", func(layout *Layout) { layout.DateColumn = 0 }},
+		{"NegativeColumn", func(layout *Layout) { layout.ReferenceColumn = -1 }},
+		{"NoAmount", func(layout *Layout) { layout.AmountColumn = 0 }},
+		{"OnlyCredit", func(layout *Layout) { layout.AmountColumn, layout.CreditColumn = 0, 2 }},
+		{"AmountAndCredit", func(layout *Layout) { layout.CreditColumn, layout.DebitColumn = 3, 4 }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			layout := valid
+			tc.update(&layout)
+			require.Error(t, layout.Validate())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+DROP TABLE IF EXISTS "interest_accruals";
+
+DROP TABLE IF EXISTS "interest_postings";
+
+-- Take the posted interest back out of the customer accounts, so the ledger
+-- still sums to zero once the expense accounts are gone. Entries are not linked
+-- to their transfer yet; both sides of a transfer share its transaction timestamp.
+UPDATE "accounts" a
+SET
+    "balance" = a."balance" - posted."amount"
+FROM (
+        SELECT t."to_account_id" AS "account_id", sum(t."amount") AS "amount"
+        FROM "transfers" t
+            JOIN "accounts" expense ON expense."id" = t."from_account_id"
+        WHERE
+            expense."owner" = 'bank_interest_expense'
+        GROUP BY
+            t."to_account_id"
+    ) posted
+WHERE
+    a."id" = posted."account_id";
+
+DELETE FROM "entries" e USING "transfers" t,
+"accounts" expense
+WHERE
+    expense."id" = t."from_account_id"
+    AND expense."owner" = 'bank_interest_expense'
+    AND e."account_id" = t."to_account_id"
+    AND e."amount" = t."amount"
+    AND e."created_at" = t."created_at";
+
+DELETE FROM "entries"
+WHERE
+    "account_id" IN (
+        SELECT "id"
+        FROM "accounts"
+        WHERE
+            "owner" = 'bank_interest_expense'
+    );
+
+DELETE FROM "transfers"
+WHERE
+    "from_account_id" IN (
+        SELECT "id"
+        FROM "accounts"
+        WHERE
+            "owner" = 'bank_interest_expense'
+    );
+
+DELETE FROM "accounts" WHERE "owner" = 'bank_interest_expense';
+
+DELETE FROM "users" WHERE "username" = 'bank_interest_expense';
+
+ALTER TABLE IF EXISTS "accounts"
+DROP CONSTRAINT IF EXISTS "accounts_product_code_fkey";
+
+ALTER TABLE "accounts" DROP COLUMN "product_code";
+
+DROP TABLE IF EXISTS "account_products";
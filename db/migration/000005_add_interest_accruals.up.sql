@@ -0,0 +1,102 @@
+CREATE TABLE "account_products" (
+    "code" varchar PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "annual_rate_bps" bigint NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "account_products"."annual_rate_bps" IS 'annual interest rate in basis points';
+
+INSERT INTO
+    "account_products" ("code", "name", "annual_rate_bps")
+VALUES ('current', 'Current account', 0),
+    ('savings', 'Savings account', 250),
+    ('internal', 'Internal bank account', 0);
+
+ALTER TABLE "accounts"
+ADD COLUMN "product_code" varchar NOT NULL DEFAULT 'current';
+
+ALTER TABLE "accounts"
+ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+CREATE TABLE "interest_postings" (
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "period_end" date NOT NULL,
+    "accrued_micros" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    "carry_micros" bigint NOT NULL,
+    "transfer_id" bigint,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "interest_postings"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_postings"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE UNIQUE INDEX ON "interest_postings" ("account_id", "period_end");
+
+COMMENT ON COLUMN "interest_postings"."carry_micros" IS 'sub-unit remainder carried into the next posting';
+
+CREATE TABLE "interest_accruals" (
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "accrual_date" date NOT NULL,
+    "balance" bigint NOT NULL,
+    "annual_rate_bps" bigint NOT NULL,
+    "amount_micros" bigint NOT NULL,
+    "posting_id" bigint,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "interest_accruals"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals"
+ADD FOREIGN KEY ("posting_id") REFERENCES "interest_postings" ("id");
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+COMMENT ON COLUMN "interest_accruals"."amount_micros" IS 'interest in millionths of the minor currency unit';
+
+INSERT INTO
+    "users" (
+        "username",
+        "hashed_password",
+        "full_name",
+        "email"
+    )
+VALUES (
+        'bank_interest_expense',
+        '',
+        'Interest Expense',
+        'interest_expense@ebank.internal'
+    );
+
+INSERT INTO
+    "accounts" (
+        "owner",
+        "balance",
+        "currency",
+        "product_code"
+    )
+VALUES (
+        'bank_interest_expense',
+        0,
+        'USD',
+        'internal'
+    ),
+    (
+        'bank_interest_expense',
+        0,
+        'EUR',
+        'internal'
+    ),
+    (
+        'bank_interest_expense',
+        0,
+        'CAD',
+        'internal'
+    );
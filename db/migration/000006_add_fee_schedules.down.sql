@@ -0,0 +1,23 @@
+DELETE FROM "entries"
+WHERE
+    "account_id" IN (
+        SELECT "id"
+        FROM "accounts"
+        WHERE
+            "owner" = 'bank_fee_revenue'
+    );
+
+DELETE FROM "transfers"
+WHERE
+    "to_account_id" IN (
+        SELECT "id"
+        FROM "accounts"
+        WHERE
+            "owner" = 'bank_fee_revenue'
+    );
+
+DELETE FROM "accounts" WHERE "owner" = 'bank_fee_revenue';
+
+DELETE FROM "users" WHERE "username" = 'bank_fee_revenue';
+
+DROP TABLE IF EXISTS "fee_schedules";
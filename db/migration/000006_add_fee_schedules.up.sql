@@ -0,0 +1,60 @@
+CREATE TABLE "fee_schedules" (
+    "id" bigserial PRIMARY KEY,
+    "currency" varchar NOT NULL,
+    "product_code" varchar,
+    "flat_fee" bigint NOT NULL DEFAULT 0,
+    "percentage_bps" bigint NOT NULL DEFAULT 0,
+    "min_fee" bigint NOT NULL DEFAULT 0,
+    "max_fee" bigint,
+    "is_active" bool NOT NULL DEFAULT TRUE,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "fee_schedules"
+ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+CREATE INDEX ON "fee_schedules" ("currency", "product_code");
+
+COMMENT ON COLUMN "fee_schedules"."product_code" IS 'null applies to every product';
+
+COMMENT ON COLUMN "fee_schedules"."max_fee" IS 'null means uncapped';
+
+INSERT INTO
+    "users" (
+        "username",
+        "hashed_password",
+        "full_name",
+        "email"
+    )
+VALUES (
+        'bank_fee_revenue',
+        '',
+        'Fee Revenue',
+        'fee_revenue@ebank.internal'
+    );
+
+INSERT INTO
+    "accounts" (
+        "owner",
+        "balance",
+        "currency",
+        "product_code"
+    )
+VALUES (
+        'bank_fee_revenue',
+        0,
+        'USD',
+        'internal'
+    ),
+    (
+        'bank_fee_revenue',
+        0,
+        'EUR',
+        'internal'
+    ),
+    (
+        'bank_fee_revenue',
+        0,
+        'CAD',
+        'internal'
+    );
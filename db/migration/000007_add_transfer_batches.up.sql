@@ -0,0 +1,27 @@
+CREATE TABLE "transfer_batches" (
+    "id" uuid PRIMARY KEY,
+    "from_account_id" bigint NOT NULL,
+    "total_amount" bigint NOT NULL,
+    "total_fee" bigint NOT NULL DEFAULT 0,
+    "leg_count" int NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "transfer_batches"
+ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "transfer_batches" ("from_account_id");
+
+CREATE TABLE "transfer_batch_legs" (
+    "batch_id" uuid NOT NULL,
+    "leg_index" int NOT NULL,
+    "transfer_id" bigint NOT NULL,
+    "fee" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("batch_id", "leg_index")
+);
+
+ALTER TABLE "transfer_batch_legs"
+ADD FOREIGN KEY ("batch_id") REFERENCES "transfer_batches" ("id");
+
+ALTER TABLE "transfer_batch_legs"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
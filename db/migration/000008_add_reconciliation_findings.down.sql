@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "reconciliation_findings";
+
+ALTER TABLE IF EXISTS "entries"
+DROP CONSTRAINT IF EXISTS "entries_transfer_id_fkey";
+
+ALTER TABLE "entries" DROP COLUMN "transfer_id";
+
+ALTER TABLE "users" DROP COLUMN "role";
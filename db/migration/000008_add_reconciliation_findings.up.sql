@@ -0,0 +1,53 @@
+ALTER TABLE "users"
+ADD COLUMN "role" varchar NOT NULL DEFAULT 'depositor';
+
+ALTER TABLE "entries" ADD COLUMN "transfer_id" bigint;
+
+ALTER TABLE "entries"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "entries" ("transfer_id");
+
+-- entries written before this migration were not linked to their transfer;
+-- both sides of a transfer share its transaction timestamp
+UPDATE "entries" e
+SET
+    "transfer_id" = t."id"
+FROM "transfers" t
+WHERE
+    e."transfer_id" IS NULL
+    AND e."created_at" = t."created_at"
+    AND (
+        (
+            e."account_id" = t."from_account_id"
+            AND e."amount" = - t."amount"
+        )
+        OR (
+            e."account_id" = t."to_account_id"
+            AND e."amount" = t."amount"
+        )
+    );
+
+CREATE TABLE "reconciliation_findings" (
+    "id" bigserial PRIMARY KEY,
+    "run_id" uuid NOT NULL,
+    "kind" varchar NOT NULL,
+    "account_id" bigint,
+    "transfer_id" bigint,
+    "expected" bigint NOT NULL,
+    "actual" bigint NOT NULL,
+    "details" varchar NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "reconciliation_findings"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "reconciliation_findings"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "reconciliation_findings" ("run_id");
+
+CREATE INDEX ON "reconciliation_findings" ("created_at");
+
+COMMENT ON COLUMN "reconciliation_findings"."kind" IS 'balance_mismatch or unbalanced_transfer';
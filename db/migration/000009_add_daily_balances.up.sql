@@ -0,0 +1,17 @@
+CREATE TABLE "daily_balances" (
+    "account_id" bigint NOT NULL,
+    "balance_date" date NOT NULL,
+    "as_of" timestamptz NOT NULL,
+    "balance" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("account_id", "balance_date")
+);
+
+ALTER TABLE "daily_balances"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "daily_balances" ("account_id", "as_of");
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+COMMENT ON COLUMN "daily_balances"."as_of" IS 'end of balance_date; the balance excludes entries created at or after it';
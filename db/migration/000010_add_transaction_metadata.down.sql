@@ -0,0 +1,9 @@
+ALTER TABLE "entries"
+DROP COLUMN "counterparty_name",
+DROP COLUMN "reference",
+DROP COLUMN "description";
+
+ALTER TABLE "transfers"
+DROP COLUMN "metadata",
+DROP COLUMN "reference",
+DROP COLUMN "description";
@@ -0,0 +1,13 @@
+ALTER TABLE "transfers"
+ADD COLUMN "description" varchar NOT NULL DEFAULT '',
+ADD COLUMN "reference" varchar NOT NULL DEFAULT '',
+ADD COLUMN "metadata" jsonb NOT NULL DEFAULT '{}';
+
+ALTER TABLE "entries"
+ADD COLUMN "description" varchar NOT NULL DEFAULT '',
+ADD COLUMN "reference" varchar NOT NULL DEFAULT '',
+ADD COLUMN "counterparty_name" varchar NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN "transfers"."reference" IS 'end-to-end reference supplied by the payer';
+
+COMMENT ON COLUMN "entries"."counterparty_name" IS 'display name of the other side of the transfer';
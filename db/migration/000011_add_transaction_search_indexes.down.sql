@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "transfers_to_account_id_created_at_idx";
+
+DROP INDEX IF EXISTS "transfers_from_account_id_created_at_idx";
+
+DROP INDEX IF EXISTS "entries_reference_idx";
+
+DROP INDEX IF EXISTS "entries_account_id_amount_idx";
+
+DROP EXTENSION IF EXISTS "pg_trgm";
@@ -0,0 +1,9 @@
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+CREATE INDEX ON "entries" ("account_id", "amount");
+
+CREATE INDEX ON "entries" USING GIN ("reference" gin_trgm_ops);
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+CREATE INDEX ON "transfers" ("to_account_id", "created_at");
@@ -0,0 +1,23 @@
+CREATE TABLE "statements" (
+    "id" uuid PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "username" varchar NOT NULL,
+    "format" varchar NOT NULL,
+    "period_start" timestamptz NOT NULL,
+    "period_end" timestamptz NOT NULL,
+    "status" varchar NOT NULL DEFAULT 'pending',
+    "content" bytea,
+    "failure_reason" varchar NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "completed_at" timestamptz
+);
+
+ALTER TABLE "statements"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "statements"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+CREATE INDEX ON "statements" ("account_id");
+
+COMMENT ON COLUMN "statements"."status" IS 'pending, ready or failed';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "external_transactions";
+
+DROP TABLE IF EXISTS "external_imports";
@@ -0,0 +1,54 @@
+CREATE TABLE "external_imports" (
+    "id" uuid PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "source" varchar NOT NULL,
+    "imported_by" varchar NOT NULL,
+    "row_count" int NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "external_imports"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "external_imports"
+ADD FOREIGN KEY ("imported_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "external_imports" ("account_id");
+
+CREATE TABLE "external_transactions" (
+    "id" bigserial PRIMARY KEY,
+    "import_id" uuid NOT NULL,
+    "account_id" bigint NOT NULL,
+    "line_number" int NOT NULL,
+    "booked_on" date NOT NULL,
+    "amount" bigint NOT NULL,
+    "reference" varchar NOT NULL DEFAULT '',
+    "description" varchar NOT NULL DEFAULT '',
+    "status" varchar NOT NULL DEFAULT 'unmatched',
+    "transfer_id" bigint,
+    "matched_by" varchar,
+    "matched_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "external_transactions"
+ADD FOREIGN KEY ("import_id") REFERENCES "external_imports" ("id");
+
+ALTER TABLE "external_transactions"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "external_transactions"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "external_transactions"
+ADD FOREIGN KEY ("matched_by") REFERENCES "users" ("username");
+
+CREATE UNIQUE INDEX ON "external_transactions" ("import_id", "line_number");
+
+CREATE UNIQUE INDEX ON "external_transactions" ("transfer_id");
+
+CREATE INDEX ON "external_transactions" ("account_id", "status");
+
+COMMENT ON COLUMN "external_transactions"."amount" IS 'signed from the view of the account: negative leaves it';
+
+COMMENT ON COLUMN "external_transactions"."status" IS 'unmatched, auto_matched or confirmed';
@@ -0,0 +1,31 @@
+CREATE TABLE "account_members" (
+    "account_id" bigint NOT NULL,
+    "username" varchar NOT NULL,
+    "role" varchar NOT NULL,
+    "added_by" varchar,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("account_id", "username")
+);
+
+ALTER TABLE "account_members"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_members"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members"
+ADD FOREIGN KEY ("added_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "account_members" ("username");
+
+COMMENT ON COLUMN "account_members"."role" IS 'owner, co_owner or viewer';
+
+INSERT INTO
+    "account_members" ("account_id", "username", "role")
+SELECT "id", "owner", 'owner'
+FROM "accounts"
+WHERE
+    "product_code" <> 'internal';
+
+-- a joint account may share its currency with accounts the owner already has
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owner_currency_key";
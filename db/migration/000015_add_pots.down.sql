@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "pots";
+
+-- the accounts that held pots stay on as plain savings accounts
+UPDATE "accounts" SET "product_code" = 'savings' WHERE "product_code" = 'pot';
+
+DELETE FROM "account_products" WHERE "code" = 'pot';
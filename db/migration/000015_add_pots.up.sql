@@ -0,0 +1,26 @@
+INSERT INTO
+    "account_products" ("code", "name", "annual_rate_bps")
+VALUES ('pot', 'Savings pot', 0);
+
+CREATE TABLE "pots" (
+    "account_id" bigint PRIMARY KEY,
+    "parent_account_id" bigint NOT NULL,
+    "name" varchar NOT NULL,
+    "target_amount" bigint NOT NULL DEFAULT 0,
+    "target_date" date,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "pots"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pots"
+ADD FOREIGN KEY ("parent_account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "pots" ("parent_account_id");
+
+CREATE UNIQUE INDEX ON "pots" ("parent_account_id", "name");
+
+COMMENT ON COLUMN "pots"."account_id" IS 'the account holding the money of the pot';
+
+COMMENT ON COLUMN "pots"."target_amount" IS 'zero means no target';
@@ -0,0 +1,34 @@
+CREATE TABLE "payment_requests" (
+    "id" uuid PRIMARY KEY,
+    "requester" varchar NOT NULL,
+    "payee_account_id" bigint NOT NULL,
+    "payer" varchar NOT NULL,
+    "amount" bigint NOT NULL,
+    "currency" varchar NOT NULL,
+    "message" varchar NOT NULL DEFAULT '',
+    "status" varchar NOT NULL DEFAULT 'pending',
+    "transfer_id" bigint,
+    "expires_at" timestamptz NOT NULL,
+    "responded_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "payment_requests"
+ADD FOREIGN KEY ("requester") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests"
+ADD FOREIGN KEY ("payee_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests"
+ADD FOREIGN KEY ("payer") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "payment_requests" ("requester", "created_at");
+
+CREATE INDEX ON "payment_requests" ("payer", "created_at");
+
+COMMENT ON COLUMN "payment_requests"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "payment_requests"."status" IS 'pending, accepted or declined; a pending request past expires_at is expired';
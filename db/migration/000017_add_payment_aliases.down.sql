@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "payment_aliases";
+
+DROP INDEX IF EXISTS "users_lower_idx";
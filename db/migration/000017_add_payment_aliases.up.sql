@@ -0,0 +1,18 @@
+CREATE TABLE "payment_aliases" (
+    "alias_type" varchar NOT NULL,
+    "value" varchar NOT NULL,
+    "username" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("alias_type", "value")
+);
+
+ALTER TABLE "payment_aliases"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+CREATE INDEX ON "payment_aliases" ("username");
+
+CREATE INDEX ON "users" (lower("email"));
+
+COMMENT ON COLUMN "payment_aliases"."alias_type" IS 'phone or handle; usernames and verified emails resolve without a row';
+
+COMMENT ON COLUMN "payment_aliases"."value" IS 'normalized: E.164 phone number or lowercase handle';
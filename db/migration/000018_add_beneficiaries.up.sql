@@ -0,0 +1,19 @@
+CREATE TABLE "beneficiaries" (
+    "id" bigserial PRIMARY KEY,
+    "username" varchar NOT NULL,
+    "nickname" varchar NOT NULL,
+    "account_id" bigint NOT NULL,
+    "currency" varchar NOT NULL,
+    "is_verified" bool NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "beneficiaries"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "beneficiaries"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE UNIQUE INDEX ON "beneficiaries" ("username", "account_id");
+
+COMMENT ON COLUMN "beneficiaries"."is_verified" IS 'the holder name given when the payee was added matched the account';
@@ -0,0 +1,39 @@
+ALTER TABLE "accounts" ADD COLUMN "account_number" varchar;
+
+-- Number the existing accounts with the default country and bank prefix. New
+-- accounts get theirs from the application, which reads both from its config.
+DO $$
+DECLARE
+    acc record;
+    bban varchar;
+    digits varchar;
+    c text;
+BEGIN
+    FOR acc IN SELECT id FROM accounts ORDER BY id LOOP
+        LOOP
+            bban := 'SMPL0001' || lpad(floor(random() * 10000000000)::bigint::text, 10, '0');
+            EXIT WHEN NOT EXISTS (
+                SELECT 1 FROM accounts WHERE substr(account_number, 5) = bban
+            );
+        END LOOP;
+
+        digits := '';
+        FOREACH c IN ARRAY regexp_split_to_array(bban || 'VN00', '') LOOP
+            IF c BETWEEN 'A' AND 'Z' THEN
+                digits := digits || (ascii(c) - 55)::text;
+            ELSE
+                digits := digits || c;
+            END IF;
+        END LOOP;
+
+        UPDATE accounts
+        SET account_number = 'VN' || lpad((98 - digits::numeric % 97)::text, 2, '0') || bban
+        WHERE id = acc.id;
+    END LOOP;
+END $$;
+
+ALTER TABLE "accounts" ALTER COLUMN "account_number" SET NOT NULL;
+
+CREATE UNIQUE INDEX ON "accounts" ("account_number");
+
+COMMENT ON COLUMN "accounts"."account_number" IS 'IBAN-style number with mod-97 check digits given to customers instead of the id';
@@ -0,0 +1,30 @@
+DROP TABLE IF EXISTS "card_authorizations";
+
+DROP TABLE IF EXISTS "cards";
+
+DELETE FROM "entries"
+WHERE
+    "transfer_id" IN (
+        SELECT "id"
+        FROM "transfers"
+        WHERE
+            "to_account_id" IN (
+                SELECT "id"
+                FROM "accounts"
+                WHERE
+                    "owner" = 'bank_card_settlement'
+            )
+    );
+
+DELETE FROM "transfers"
+WHERE
+    "to_account_id" IN (
+        SELECT "id"
+        FROM "accounts"
+        WHERE
+            "owner" = 'bank_card_settlement'
+    );
+
+DELETE FROM "accounts" WHERE "owner" = 'bank_card_settlement';
+
+DELETE FROM "users" WHERE "username" = 'bank_card_settlement';
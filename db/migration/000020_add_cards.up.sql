@@ -0,0 +1,86 @@
+CREATE TABLE "cards" (
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "cardholder" varchar NOT NULL,
+    "token" varchar UNIQUE NOT NULL,
+    "masked_number" varchar NOT NULL,
+    "expiry_month" int NOT NULL,
+    "expiry_year" int NOT NULL,
+    "hashed_cvv" varchar NOT NULL,
+    "status" varchar NOT NULL DEFAULT 'active',
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "cards"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "cards"
+ADD FOREIGN KEY ("cardholder") REFERENCES "users" ("username");
+
+CREATE INDEX ON "cards" ("account_id");
+
+COMMENT ON COLUMN "cards"."token" IS 'HMAC of the card number, which is never stored';
+
+COMMENT ON COLUMN "cards"."status" IS 'active, frozen or cancelled';
+
+CREATE TABLE "card_authorizations" (
+    "id" bigserial PRIMARY KEY,
+    "card_id" bigint NOT NULL,
+    "transfer_id" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    "currency" varchar NOT NULL,
+    "merchant_name" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "card_authorizations"
+ADD FOREIGN KEY ("card_id") REFERENCES "cards" ("id");
+
+ALTER TABLE "card_authorizations"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "card_authorizations" ("card_id", "created_at");
+
+INSERT INTO
+    "users" (
+        "username",
+        "hashed_password",
+        "full_name",
+        "email"
+    )
+VALUES (
+        'bank_card_settlement',
+        '',
+        'Card Settlement',
+        'card_settlement@ebank.internal'
+    );
+
+INSERT INTO
+    "accounts" (
+        "owner",
+        "balance",
+        "currency",
+        "product_code",
+        "account_number"
+    )
+VALUES (
+        'bank_card_settlement',
+        0,
+        'USD',
+        'internal',
+        'VN79SMPL00019990000001'
+    ),
+    (
+        'bank_card_settlement',
+        0,
+        'EUR',
+        'internal',
+        'VN52SMPL00019990000002'
+    ),
+    (
+        'bank_card_settlement',
+        0,
+        'CAD',
+        'internal',
+        'VN25SMPL00019990000003'
+    );
@@ -0,0 +1,44 @@
+DROP TABLE IF EXISTS "loan_repayments";
+
+DROP TABLE IF EXISTS "loan_instalments";
+
+DROP TABLE IF EXISTS "loans";
+
+DELETE FROM "entries"
+WHERE
+    "transfer_id" IN (
+        SELECT "id"
+        FROM "transfers"
+        WHERE
+            "from_account_id" IN (
+                SELECT "id"
+                FROM "accounts"
+                WHERE
+                    "owner" = 'bank_loan_funding'
+            )
+            OR "to_account_id" IN (
+                SELECT "id"
+                FROM "accounts"
+                WHERE
+                    "owner" = 'bank_loan_funding'
+            )
+    );
+
+DELETE FROM "transfers"
+WHERE
+    "from_account_id" IN (
+        SELECT "id"
+        FROM "accounts"
+        WHERE
+            "owner" = 'bank_loan_funding'
+    )
+    OR "to_account_id" IN (
+        SELECT "id"
+        FROM "accounts"
+        WHERE
+            "owner" = 'bank_loan_funding'
+    );
+
+DELETE FROM "accounts" WHERE "owner" = 'bank_loan_funding';
+
+DELETE FROM "users" WHERE "username" = 'bank_loan_funding';
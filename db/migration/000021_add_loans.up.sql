@@ -0,0 +1,107 @@
+CREATE TABLE "loans" (
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "principal" bigint NOT NULL,
+    "annual_rate_bps" bigint NOT NULL,
+    "term_months" int NOT NULL,
+    "currency" varchar NOT NULL,
+    "status" varchar NOT NULL DEFAULT 'active',
+    "disbursement_transfer_id" bigint NOT NULL,
+    "created_by" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "loans"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans"
+ADD FOREIGN KEY ("disbursement_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "loans"
+ADD FOREIGN KEY ("created_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "loans" ("account_id");
+
+COMMENT ON COLUMN "loans"."account_id" IS 'the account the loan is paid into and repaid from';
+
+COMMENT ON COLUMN "loans"."status" IS 'active or paid_off';
+
+CREATE TABLE "loan_instalments" (
+    "loan_id" bigint NOT NULL,
+    "number" int NOT NULL,
+    "due_date" date NOT NULL,
+    "principal" bigint NOT NULL,
+    "interest" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    "paid_amount" bigint NOT NULL DEFAULT 0,
+    "paid_at" timestamptz,
+    PRIMARY KEY ("loan_id", "number")
+);
+
+ALTER TABLE "loan_instalments"
+ADD FOREIGN KEY ("loan_id") REFERENCES "loans" ("id");
+
+CREATE INDEX ON "loan_instalments" ("due_date");
+
+COMMENT ON COLUMN "loan_instalments"."paid_at" IS 'set once paid_amount reaches amount; an unpaid instalment past due_date is in arrears';
+
+CREATE TABLE "loan_repayments" (
+    "id" bigserial PRIMARY KEY,
+    "loan_id" bigint NOT NULL,
+    "instalment_number" int NOT NULL,
+    "transfer_id" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "loan_repayments"
+ADD FOREIGN KEY ("loan_id", "instalment_number") REFERENCES "loan_instalments" ("loan_id", "number");
+
+ALTER TABLE "loan_repayments"
+ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "loan_repayments" ("loan_id");
+
+INSERT INTO
+    "users" (
+        "username",
+        "hashed_password",
+        "full_name",
+        "email"
+    )
+VALUES (
+        'bank_loan_funding',
+        '',
+        'Loan Funding',
+        'loan_funding@ebank.internal'
+    );
+
+INSERT INTO
+    "accounts" (
+        "owner",
+        "balance",
+        "currency",
+        "product_code",
+        "account_number"
+    )
+VALUES (
+        'bank_loan_funding',
+        0,
+        'USD',
+        'internal',
+        'VN95SMPL00019990000004'
+    ),
+    (
+        'bank_loan_funding',
+        0,
+        'EUR',
+        'internal',
+        'VN68SMPL00019990000005'
+    ),
+    (
+        'bank_loan_funding',
+        0,
+        'CAD',
+        'internal',
+        'VN41SMPL00019990000006'
+    );
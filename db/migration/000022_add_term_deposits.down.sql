@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "term_deposits";
+
+DROP TABLE IF EXISTS "term_deposit_rates";
+
+-- the accounts that held deposits stay on as plain savings accounts
+UPDATE "accounts" SET "product_code" = 'savings' WHERE "product_code" = 'term_deposit';
+
+DELETE FROM "account_products" WHERE "code" = 'term_deposit';
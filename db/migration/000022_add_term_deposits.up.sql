@@ -0,0 +1,60 @@
+INSERT INTO
+    "account_products" ("code", "name", "annual_rate_bps")
+VALUES ('term_deposit', 'Term deposit', 0);
+
+CREATE TABLE "term_deposit_rates" (
+    "term_months" int PRIMARY KEY,
+    "annual_rate_bps" bigint NOT NULL,
+    "early_break_penalty_bps" bigint NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "term_deposit_rates"."early_break_penalty_bps" IS 'taken off the annual rate when a deposit is broken before maturity';
+
+INSERT INTO
+    "term_deposit_rates" (
+        "term_months",
+        "annual_rate_bps",
+        "early_break_penalty_bps"
+    )
+VALUES (3, 350, 100),
+    (6, 400, 150),
+    (12, 500, 200);
+
+CREATE TABLE "term_deposits" (
+    "account_id" bigint PRIMARY KEY,
+    "source_account_id" bigint NOT NULL,
+    "term_months" int NOT NULL,
+    "annual_rate_bps" bigint NOT NULL,
+    "early_break_penalty_bps" bigint NOT NULL,
+    "principal" bigint NOT NULL,
+    "starts_on" date NOT NULL,
+    "matures_on" date NOT NULL,
+    "on_maturity" varchar NOT NULL DEFAULT 'payout',
+    "status" varchar NOT NULL DEFAULT 'active',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "closed_at" timestamptz
+);
+
+ALTER TABLE "term_deposits"
+ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "term_deposits"
+ADD FOREIGN KEY ("source_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "term_deposits"
+ADD FOREIGN KEY ("term_months") REFERENCES "term_deposit_rates" ("term_months");
+
+CREATE INDEX ON "term_deposits" ("source_account_id");
+
+CREATE INDEX ON "term_deposits" ("status", "matures_on");
+
+COMMENT ON COLUMN "term_deposits"."account_id" IS 'the account holding the money of the deposit';
+
+COMMENT ON COLUMN "term_deposits"."source_account_id" IS 'the account the deposit was funded from and is paid out to';
+
+COMMENT ON COLUMN "term_deposits"."annual_rate_bps" IS 'the rate of the term when the deposit was opened or last rolled over';
+
+COMMENT ON COLUMN "term_deposits"."on_maturity" IS 'payout or rollover';
+
+COMMENT ON COLUMN "term_deposits"."status" IS 'active, paid_out or broken';
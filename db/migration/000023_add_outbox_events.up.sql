@@ -0,0 +1,19 @@
+CREATE TABLE "outbox_events" (
+    "id" bigserial PRIMARY KEY,
+    "event_id" uuid UNIQUE NOT NULL,
+    "event_type" varchar NOT NULL,
+    "aggregate_id" varchar NOT NULL,
+    "payload" jsonb NOT NULL,
+    "attempts" int NOT NULL DEFAULT 0,
+    "last_error" varchar NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "published_at" timestamptz
+);
+
+CREATE INDEX ON "outbox_events" ("published_at", "id");
+
+COMMENT ON COLUMN "outbox_events"."event_id" IS 'sent along with the event so consumers can drop duplicates';
+
+COMMENT ON COLUMN "outbox_events"."aggregate_id" IS 'the user, transfer, ... the event is about';
+
+COMMENT ON COLUMN "outbox_events"."published_at" IS 'null until the relay has handed the event to the queue';
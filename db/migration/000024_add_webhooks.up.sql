@@ -0,0 +1,43 @@
+CREATE TABLE "webhook_endpoints" (
+    "id" bigserial PRIMARY KEY,
+    "username" varchar NOT NULL,
+    "url" varchar NOT NULL,
+    "secret" varchar NOT NULL,
+    "event_types" varchar[] NOT NULL,
+    "active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+    "id" bigserial PRIMARY KEY,
+    "endpoint_id" bigint NOT NULL,
+    "event_id" uuid NOT NULL,
+    "event_type" varchar NOT NULL,
+    "payload" jsonb NOT NULL,
+    "status" varchar NOT NULL DEFAULT 'pending',
+    "attempts" int NOT NULL DEFAULT 0,
+    "response_status" int NOT NULL DEFAULT 0,
+    "last_error" varchar NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "delivered_at" timestamptz
+);
+
+ALTER TABLE "webhook_endpoints"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_deliveries"
+ADD FOREIGN KEY ("endpoint_id") REFERENCES "webhook_endpoints" ("id");
+
+CREATE INDEX ON "webhook_endpoints" ("username");
+
+CREATE UNIQUE INDEX ON "webhook_deliveries" ("endpoint_id", "event_id");
+
+COMMENT ON COLUMN "webhook_endpoints"."secret" IS 'deliveries are signed with HMAC-SHA256 under this secret';
+
+COMMENT ON COLUMN "webhook_endpoints"."event_types" IS 'the outbox event types delivered to the endpoint';
+
+COMMENT ON COLUMN "webhook_deliveries"."event_id" IS 'the outbox event delivered; one delivery per endpoint and event';
+
+COMMENT ON COLUMN "webhook_deliveries"."status" IS 'pending, succeeded or failed';
+
+COMMENT ON COLUMN "webhook_deliveries"."response_status" IS 'HTTP status of the last attempt, 0 if no response was received';
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS "entries_notify" ON "entries";
+
+DROP FUNCTION IF EXISTS "notify_account_entry";
@@ -0,0 +1,10 @@
+CREATE FUNCTION "notify_account_entry"() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('account_entries', json_build_object('account_id', NEW."account_id", 'entry_id', NEW."id")::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "entries_notify"
+AFTER INSERT ON "entries"
+FOR EACH ROW EXECUTE FUNCTION "notify_account_entry"();
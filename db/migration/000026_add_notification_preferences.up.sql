@@ -0,0 +1,17 @@
+CREATE TABLE "notification_preferences" (
+    "username" varchar NOT NULL,
+    "event_type" varchar NOT NULL,
+    "channels" varchar[] NOT NULL DEFAULT '{}',
+    "threshold" bigint NOT NULL DEFAULT 0,
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("username", "event_type")
+);
+
+ALTER TABLE "notification_preferences"
+ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+COMMENT ON COLUMN "notification_preferences"."event_type" IS 'incoming_transfer, low_balance, large_debit or new_login';
+
+COMMENT ON COLUMN "notification_preferences"."channels" IS 'where the alert is sent; empty turns it off';
+
+COMMENT ON COLUMN "notification_preferences"."threshold" IS 'low_balance: balance to fall below, large_debit: smallest debit, in the minor unit of the account';
@@ -0,0 +1,3 @@
+ALTER TABLE "users" ADD COLUMN "language" varchar NOT NULL DEFAULT 'en';
+
+COMMENT ON COLUMN "users"."language" IS 'language emails and alerts are written in';
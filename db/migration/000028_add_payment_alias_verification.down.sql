@@ -0,0 +1,35 @@
+-- keep one claim per alias: the verified one, or else the earliest
+DELETE FROM "payment_aliases" p
+WHERE
+    p."verified_at" IS NULL
+    AND EXISTS (
+        SELECT 1
+        FROM "payment_aliases" o
+        WHERE
+            o."alias_type" = p."alias_type"
+            AND o."value" = p."value"
+            AND o."username" <> p."username"
+            AND (
+                o."verified_at" IS NOT NULL
+                OR o."created_at" < p."created_at"
+                OR (
+                    o."created_at" = p."created_at"
+                    AND o."username" < p."username"
+                )
+            )
+    );
+
+DROP INDEX IF EXISTS "payment_aliases_verified_idx";
+
+ALTER TABLE "payment_aliases"
+DROP CONSTRAINT "payment_aliases_pkey";
+
+ALTER TABLE "payment_aliases" ADD PRIMARY KEY ("alias_type", "value");
+
+ALTER TABLE "payment_aliases" DROP COLUMN "verified_at";
+
+ALTER TABLE "payment_aliases" DROP COLUMN "code_expired_at";
+
+ALTER TABLE "payment_aliases" DROP COLUMN "verify_attempts";
+
+ALTER TABLE "payment_aliases" DROP COLUMN "secret_code";
@@ -0,0 +1,37 @@
+ALTER TABLE "payment_aliases"
+ADD COLUMN "secret_code" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "payment_aliases"
+ADD COLUMN "verify_attempts" int NOT NULL DEFAULT 0;
+
+ALTER TABLE "payment_aliases"
+ADD COLUMN "code_expired_at" timestamptz NOT NULL DEFAULT (now());
+
+ALTER TABLE "payment_aliases" ADD COLUMN "verified_at" timestamptz;
+
+-- handles are names users pick, so they need no verification; phone numbers
+-- registered before stay unresolvable until their owner confirms them
+UPDATE "payment_aliases"
+SET
+    "verified_at" = "created_at"
+WHERE
+    "alias_type" = 'handle';
+
+-- several users may claim a phone number, only the one who verifies it gets it
+ALTER TABLE "payment_aliases"
+DROP CONSTRAINT "payment_aliases_pkey";
+
+ALTER TABLE "payment_aliases"
+ADD PRIMARY KEY (
+    "alias_type",
+    "value",
+    "username"
+);
+
+CREATE UNIQUE INDEX "payment_aliases_verified_idx" ON "payment_aliases" ("alias_type", "value")
+WHERE
+    "verified_at" IS NOT NULL;
+
+COMMENT ON COLUMN "payment_aliases"."secret_code" IS 'sent to the phone number; cleared once verified';
+
+COMMENT ON COLUMN "payment_aliases"."verified_at" IS 'null until the user proves the alias is theirs; only verified aliases resolve';
@@ -0,0 +1,8 @@
+ALTER TABLE "outbox_events"
+ADD COLUMN "next_attempt_at" timestamptz NOT NULL DEFAULT (now());
+
+CREATE INDEX ON "outbox_events" ("next_attempt_at", "id")
+WHERE
+    "published_at" IS NULL;
+
+COMMENT ON COLUMN "outbox_events"."next_attempt_at" IS 'an event that failed to publish waits until then before it is retried';
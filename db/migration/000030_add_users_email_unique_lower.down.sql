@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "users_lower_email_key";
+
+CREATE INDEX ON "users" (lower("email"));
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "users_lower_idx";
+
+CREATE UNIQUE INDEX "users_lower_email_key" ON "users" (lower("email"));
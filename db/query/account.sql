@@ -1,22 +1,71 @@
 -- name: CreateAccount :one
 INSERT INTO
-    accounts (OWNER, balance, currency)
-VALUES ($1, $2, $3)
+    accounts (
+        OWNER,
+        balance,
+        currency,
+        product_code,
+        account_number
+    )
+VALUES ($1, $2, $3, $4, $5)
 RETURNING
     *;
 
 -- name: GetAccount :one
 SELECT * FROM accounts WHERE id = $1 LIMIT 1;
 
--- name: GetAccountForUpdate :one
-SELECT * FROM accounts WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE;
+-- name: GetAccountByNumber :one
+SELECT * FROM accounts WHERE account_number = $1 LIMIT 1;
 
--- name: ListAccounts :many
+-- name: ListAccountNumbers :many
+SELECT id, account_number FROM accounts WHERE id = ANY (sqlc.arg (ids)::bigint[]);
+
+-- name: GetInternalAccount :one
 SELECT *
 FROM accounts
 WHERE
     OWNER = $1
-ORDER BY id
+    AND currency = $2
+    AND product_code = 'internal'
+LIMIT 1;
+
+-- name: GetAccountHolderName :one
+SELECT u.full_name
+FROM accounts a
+    JOIN users u ON u.username = a.owner
+WHERE
+    a.id = $1
+LIMIT 1;
+
+-- name: ListDefaultAccounts :many
+SELECT DISTINCT
+    ON (currency) *
+FROM accounts
+WHERE
+    OWNER = $1
+    AND product_code IN ('current', 'savings')
+ORDER BY currency, product_code = 'current' DESC, id;
+
+-- name: GetDefaultAccount :one
+SELECT *
+FROM accounts
+WHERE
+    OWNER = $1
+    AND currency = $2
+    AND product_code IN ('current', 'savings')
+ORDER BY product_code = 'current' DESC, id
+LIMIT 1;
+
+-- name: GetAccountForUpdate :one
+SELECT * FROM accounts WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: ListAccounts :many
+SELECT a.*
+FROM accounts a
+    JOIN account_members m ON m.account_id = a.id
+WHERE
+    m.username = $1
+ORDER BY a.id
 LIMIT $2
 OFFSET
     $3;
@@ -0,0 +1,22 @@
+-- name: CreateAccountMember :one
+INSERT INTO
+    account_members (
+        account_id,
+        username,
+        role,
+        added_by
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
+
+-- name: GetAccountMember :one
+SELECT *
+FROM account_members
+WHERE
+    account_id = $1
+    AND username = $2
+LIMIT 1;
+
+-- name: DeleteAccountMember :exec
+DELETE FROM account_members WHERE account_id = $1 AND username = $2;
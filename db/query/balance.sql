@@ -0,0 +1,50 @@
+-- name: CreateDailyBalances :execrows
+INSERT INTO
+    daily_balances (
+        account_id,
+        balance_date,
+        as_of,
+        balance
+    )
+SELECT
+    a.id,
+    sqlc.arg (balance_date)::date,
+    sqlc.arg (as_of)::timestamptz,
+    a.balance - COALESCE(
+        (
+            SELECT SUM(e.amount)
+            FROM entries e
+            WHERE
+                e.account_id = a.id
+                AND e.created_at >= sqlc.arg (as_of)::timestamptz
+        ),
+        0
+    )
+FROM accounts a
+WHERE
+    a.created_at < sqlc.arg (as_of)::timestamptz
+ON CONFLICT (account_id, balance_date) DO NOTHING;
+
+-- name: GetLatestDailyBalance :one
+SELECT *
+FROM daily_balances
+WHERE
+    account_id = sqlc.arg (account_id)
+    AND as_of <= sqlc.arg (at)
+ORDER BY as_of DESC
+LIMIT 1;
+
+-- name: SumEntriesBetween :one
+SELECT COALESCE(SUM(amount), 0)::bigint
+FROM entries
+WHERE
+    account_id = sqlc.arg (account_id)
+    AND created_at >= sqlc.arg (from_time)
+    AND created_at <= sqlc.arg (to_time);
+
+-- name: SumEntriesAfter :one
+SELECT COALESCE(SUM(amount), 0)::bigint
+FROM entries
+WHERE
+    account_id = sqlc.arg (account_id)
+    AND created_at > sqlc.arg (after);
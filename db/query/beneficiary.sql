@@ -0,0 +1,69 @@
+-- name: CreateBeneficiary :one
+INSERT INTO
+    beneficiaries (
+        username,
+        nickname,
+        account_id,
+        currency,
+        is_verified
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    *;
+
+-- name: GetBeneficiary :one
+SELECT * FROM beneficiaries WHERE id = $1 LIMIT 1;
+
+-- name: ListBeneficiaries :many
+SELECT *
+FROM beneficiaries
+WHERE
+    username = $1
+ORDER BY nickname, id
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: UpdateBeneficiary :one
+UPDATE beneficiaries
+SET
+    nickname = sqlc.arg (nickname)
+WHERE
+    id = sqlc.arg (id)
+RETURNING
+    *;
+
+-- name: DeleteBeneficiary :exec
+DELETE FROM beneficiaries WHERE id = $1;
+
+-- name: GetPayeeSince :one
+SELECT (
+        CASE
+            WHEN EXISTS (
+                SELECT 1
+                FROM accounts a
+                WHERE
+                    a.id = sqlc.arg (to_account_id)
+                    AND a.owner = sqlc.arg (username)
+            ) THEN to_timestamp(0)
+            ELSE COALESCE(
+                LEAST(
+                    (
+                        SELECT min(b.created_at)
+                        FROM beneficiaries b
+                        WHERE
+                            b.username = sqlc.arg (username)
+                            AND b.account_id = sqlc.arg (to_account_id)
+                    ), (
+                        SELECT min(t.created_at)
+                        FROM
+                            transfers t
+                            JOIN account_members m ON m.account_id = t.from_account_id
+                        WHERE
+                            m.username = sqlc.arg (username)
+                            AND t.to_account_id = sqlc.arg (to_account_id)
+                    )
+                ), now()
+            )
+        END
+    )::timestamptz AS payee_since;
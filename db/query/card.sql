@@ -0,0 +1,52 @@
+-- name: CreateCard :one
+INSERT INTO
+    cards (
+        account_id,
+        cardholder,
+        token,
+        masked_number,
+        expiry_month,
+        expiry_year,
+        hashed_cvv
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    *;
+
+-- name: GetCard :one
+SELECT * FROM cards WHERE id = $1 LIMIT 1;
+
+-- name: GetCardByToken :one
+SELECT * FROM cards WHERE token = $1 LIMIT 1;
+
+-- name: ListCards :many
+SELECT *
+FROM cards
+WHERE
+    account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: UpdateCardStatus :one
+UPDATE cards
+SET
+    status = sqlc.arg (status)
+WHERE
+    id = sqlc.arg (id)
+RETURNING
+    *;
+
+-- name: CreateCardAuthorization :one
+INSERT INTO
+    card_authorizations (
+        card_id,
+        transfer_id,
+        amount,
+        currency,
+        merchant_name
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    *;
@@ -1,9 +1,13 @@
 -- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  transfer_id,
+  description,
+  reference,
+  counterparty_name
 ) VALUES (
-  $1, $2
+  $1, $2, $3, $4, $5, $6
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -16,3 +20,32 @@ WHERE account_id = $1
 ORDER BY id
 LIMIT $2
 OFFSET $3;
+
+-- name: ListEntriesBetween :many
+SELECT *
+FROM entries
+WHERE
+    account_id = sqlc.arg (account_id)
+    AND created_at > sqlc.arg (from_time)
+    AND created_at <= sqlc.arg (to_time)
+ORDER BY created_at, id;
+
+-- name: ListAccountActivity :many
+SELECT sqlc.embed(e), a.currency,
+    COALESCE(CASE WHEN e.amount < 0 THEN t.to_account_id ELSE t.from_account_id END, 0)::bigint AS counterparty_account_id,
+    COALESCE(t.metadata, '{}')::jsonb AS metadata,
+    (a.balance - COALESCE(SUM(e.amount) OVER (ORDER BY e.id DESC ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING), 0))::bigint AS balance_after
+FROM entries e
+    JOIN accounts a ON a.id = e.account_id
+    LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE
+    e.account_id = sqlc.arg (account_id)
+    AND e.id > sqlc.arg (after_id)
+ORDER BY e.id
+LIMIT sqlc.arg (row_limit);
+
+-- name: GetAccountActivityHead :one
+SELECT a.balance,
+    COALESCE((SELECT max(e.id) FROM entries e WHERE e.account_id = a.id), 0)::bigint AS last_entry_id
+FROM accounts a
+WHERE a.id = $1;
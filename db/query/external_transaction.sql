@@ -0,0 +1,80 @@
+-- name: CreateExternalImport :one
+INSERT INTO
+    external_imports (
+        id,
+        account_id,
+        source,
+        imported_by,
+        row_count
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    *;
+
+-- name: CreateExternalTransaction :one
+INSERT INTO
+    external_transactions (
+        import_id,
+        account_id,
+        line_number,
+        booked_on,
+        amount,
+        reference,
+        description
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    *;
+
+-- name: GetExternalTransactionForUpdate :one
+SELECT * FROM external_transactions WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: ListMatchCandidates :many
+SELECT t.*
+FROM transfers t
+WHERE
+    CASE
+        WHEN sqlc.arg (amount)::bigint < 0 THEN t.from_account_id
+        ELSE t.to_account_id
+    END = sqlc.arg (account_id)
+    AND t.amount = abs(sqlc.arg (amount)::bigint)
+    AND t.created_at >= sqlc.arg (from_time)
+    AND t.created_at < sqlc.arg (to_time)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM external_transactions x
+        WHERE
+            x.transfer_id = t.id
+    )
+ORDER BY t.created_at
+LIMIT sqlc.arg (row_limit);
+
+-- name: MatchExternalTransaction :one
+UPDATE external_transactions
+SET
+    status = sqlc.arg (status),
+    transfer_id = sqlc.arg (transfer_id),
+    matched_by = sqlc.narg (matched_by),
+    matched_at = now()
+WHERE
+    id = sqlc.arg (id)
+RETURNING
+    *;
+
+-- name: ListUnmatchedExternalTransactions :many
+SELECT *
+FROM external_transactions
+WHERE
+    status = 'unmatched'
+    AND (
+        sqlc.narg (account_id)::bigint IS NULL
+        OR account_id = sqlc.narg (account_id)
+    )
+    AND (
+        sqlc.narg (import_id)::uuid IS NULL
+        OR import_id = sqlc.narg (import_id)
+    )
+ORDER BY booked_on, id
+LIMIT sqlc.arg (row_limit)
+OFFSET
+    sqlc.arg (row_offset);
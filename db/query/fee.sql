@@ -0,0 +1,30 @@
+-- name: CreateFeeSchedule :one
+INSERT INTO
+    fee_schedules (
+        currency,
+        product_code,
+        flat_fee,
+        percentage_bps,
+        min_fee,
+        max_fee
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    *;
+
+-- name: GetFeeSchedule :one
+-- picks the active schedule for the currency, preferring one specific to the product
+SELECT *
+FROM fee_schedules
+WHERE
+    currency = sqlc.arg (currency)
+    AND (
+        product_code = sqlc.arg (product_code)::varchar
+        OR product_code IS NULL
+    )
+    AND is_active = TRUE
+ORDER BY product_code NULLS LAST, id DESC
+LIMIT 1;
+
+-- name: DeactivateFeeSchedule :one
+UPDATE fee_schedules SET is_active = FALSE WHERE id = $1 RETURNING *;
@@ -0,0 +1,101 @@
+-- name: GetAccountProduct :one
+SELECT * FROM account_products WHERE code = $1 LIMIT 1;
+
+-- name: ListAccountProducts :many
+SELECT * FROM account_products ORDER BY code;
+
+-- name: ListAccrualCandidates :many
+SELECT
+    a.id,
+    a.currency,
+    p.annual_rate_bps,
+    (
+        a.balance - COALESCE(
+            (
+                SELECT SUM(e.amount)
+                FROM entries e
+                WHERE
+                    e.account_id = a.id
+                    AND e.created_at >= sqlc.arg (cutoff)
+            ),
+            0
+        )
+    )::bigint AS end_of_day_balance
+FROM accounts a
+    JOIN account_products p ON p.code = a.product_code
+WHERE
+    p.annual_rate_bps > 0
+    AND a.created_at < sqlc.arg (cutoff)
+    AND a.id > sqlc.arg (after_id)
+ORDER BY a.id
+LIMIT sqlc.arg (row_limit);
+
+-- name: CreateInterestAccrual :execrows
+INSERT INTO
+    interest_accruals (
+        account_id,
+        accrual_date,
+        balance,
+        annual_rate_bps,
+        amount_micros
+    )
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (account_id, accrual_date) DO NOTHING;
+
+-- name: ListInterestAccruals :many
+SELECT *
+FROM interest_accruals
+WHERE
+    account_id = $1
+ORDER BY accrual_date
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: ListUnpostedAccrualAccounts :many
+SELECT DISTINCT
+    account_id
+FROM interest_accruals
+WHERE
+    posting_id IS NULL
+    AND accrual_date <= sqlc.arg (period_end)
+ORDER BY account_id;
+
+-- name: SumUnpostedAccruals :one
+SELECT COALESCE(SUM(amount_micros), 0)::bigint
+FROM interest_accruals
+WHERE
+    account_id = sqlc.arg (account_id)
+    AND posting_id IS NULL
+    AND accrual_date <= sqlc.arg (period_end);
+
+-- name: MarkAccrualsPosted :execrows
+UPDATE interest_accruals
+SET
+    posting_id = sqlc.arg (posting_id)
+WHERE
+    account_id = sqlc.arg (account_id)
+    AND posting_id IS NULL
+    AND accrual_date <= sqlc.arg (period_end);
+
+-- name: GetLastInterestPosting :one
+SELECT *
+FROM interest_postings
+WHERE
+    account_id = $1
+ORDER BY period_end DESC
+LIMIT 1;
+
+-- name: CreateInterestPosting :one
+INSERT INTO
+    interest_postings (
+        account_id,
+        period_end,
+        accrued_micros,
+        amount,
+        carry_micros,
+        transfer_id
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    *;
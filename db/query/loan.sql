@@ -0,0 +1,104 @@
+-- name: CreateLoan :one
+INSERT INTO
+    loans (
+        account_id,
+        principal,
+        annual_rate_bps,
+        term_months,
+        currency,
+        disbursement_transfer_id,
+        created_by
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    *;
+
+-- name: GetLoan :one
+SELECT * FROM loans WHERE id = $1 LIMIT 1;
+
+-- name: GetLoanForUpdate :one
+SELECT * FROM loans WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: ListLoans :many
+SELECT *
+FROM loans
+WHERE
+    account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: UpdateLoanStatus :one
+UPDATE loans
+SET
+    status = sqlc.arg (status)
+WHERE
+    id = sqlc.arg (id)
+RETURNING
+    *;
+
+-- name: CreateLoanInstalment :one
+INSERT INTO
+    loan_instalments (
+        loan_id,
+        number,
+        due_date,
+        principal,
+        interest,
+        amount
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    *;
+
+-- name: ListLoanInstalments :many
+SELECT * FROM loan_instalments WHERE loan_id = $1 ORDER BY number;
+
+-- name: ListDueInstalments :many
+SELECT *
+FROM loan_instalments
+WHERE
+    loan_id = sqlc.arg (loan_id)
+    AND due_date <= sqlc.arg (as_of)
+    AND paid_at IS NULL
+ORDER BY number;
+
+-- name: ListDueLoans :many
+SELECT DISTINCT
+    l.id
+FROM loans l
+    JOIN loan_instalments i ON i.loan_id = l.id
+WHERE
+    l.status = 'active'
+    AND i.due_date <= sqlc.arg (as_of)
+    AND i.paid_at IS NULL
+ORDER BY l.id;
+
+-- name: PayLoanInstalment :one
+UPDATE loan_instalments
+SET
+    paid_amount = paid_amount + sqlc.arg (amount),
+    paid_at = CASE
+        WHEN paid_amount + sqlc.arg (amount) >= amount THEN now()
+    END
+WHERE
+    loan_id = sqlc.arg (loan_id)
+    AND number = sqlc.arg (number)
+RETURNING
+    *;
+
+-- name: CountUnpaidInstalments :one
+SELECT count(*) FROM loan_instalments WHERE loan_id = $1 AND paid_at IS NULL;
+
+-- name: CreateLoanRepayment :one
+INSERT INTO
+    loan_repayments (
+        loan_id,
+        instalment_number,
+        transfer_id,
+        amount
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
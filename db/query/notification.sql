@@ -0,0 +1,45 @@
+-- name: UpsertNotificationPreference :one
+INSERT INTO notification_preferences (
+    username,
+    event_type,
+    channels,
+    threshold
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (username, event_type) DO UPDATE
+SET
+    channels = EXCLUDED.channels,
+    threshold = EXCLUDED.threshold,
+    updated_at = now()
+RETURNING *;
+
+-- name: ListNotificationPreferences :many
+SELECT * FROM notification_preferences
+WHERE username = $1
+ORDER BY event_type;
+
+-- name: GetNotificationPreference :one
+SELECT * FROM notification_preferences
+WHERE username = $1 AND event_type = $2
+LIMIT 1;
+
+-- name: ListAccountAlertSubscribers :many
+SELECT p.*
+FROM notification_preferences p
+    JOIN account_members m ON m.username = p.username
+WHERE
+    m.account_id = sqlc.arg (account_id)
+    AND p.event_type = sqlc.arg (event_type)
+    AND cardinality(p.channels) > 0
+ORDER BY p.username;
+
+-- name: GetBalanceAfterTransfer :one
+SELECT (a.balance - COALESCE(SUM(later.amount), 0))::bigint AS balance
+FROM entries e
+    JOIN accounts a ON a.id = e.account_id
+    LEFT JOIN entries later ON later.account_id = e.account_id AND later.id > e.id
+WHERE
+    e.transfer_id = sqlc.arg (transfer_id)::bigint
+    AND e.account_id = sqlc.arg (account_id)
+GROUP BY a.balance, e.id;
@@ -0,0 +1,47 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO
+    outbox_events (
+        event_id,
+        event_type,
+        aggregate_id,
+        payload
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
+
+-- name: ListPendingOutboxEvents :many
+SELECT *
+FROM outbox_events
+WHERE
+    published_at IS NULL
+    AND attempts < sqlc.arg (max_attempts)
+    AND next_attempt_at <= now()
+ORDER BY id
+LIMIT sqlc.arg (row_limit)
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET
+    attempts = attempts + 1,
+    last_error = '',
+    published_at = now()
+WHERE
+    id = $1;
+
+-- name: RecordOutboxEventFailure :exec
+UPDATE outbox_events
+SET
+    attempts = attempts + 1,
+    last_error = sqlc.arg (last_error),
+    next_attempt_at = sqlc.arg (next_attempt_at)
+WHERE
+    id = sqlc.arg (id);
+
+-- name: GetOutboxEvent :one
+SELECT *
+FROM outbox_events
+WHERE
+    event_type = $1
+    AND aggregate_id = $2;
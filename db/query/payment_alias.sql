@@ -0,0 +1,87 @@
+-- name: CreatePaymentAlias :one
+INSERT INTO
+    payment_aliases (
+        alias_type,
+        value,
+        username,
+        secret_code,
+        code_expired_at,
+        verified_at
+    )
+VALUES (
+        sqlc.arg (alias_type),
+        sqlc.arg (value),
+        sqlc.arg (username),
+        sqlc.arg (secret_code),
+        sqlc.arg (code_expired_at),
+        sqlc.narg (verified_at)
+    )
+ON CONFLICT (alias_type, value, username) DO
+UPDATE
+SET
+    secret_code = EXCLUDED.secret_code,
+    verify_attempts = 0,
+    code_expired_at = EXCLUDED.code_expired_at
+WHERE
+    payment_aliases.verified_at IS NULL
+RETURNING
+    *;
+
+-- name: GetPaymentAlias :one
+SELECT *
+FROM payment_aliases
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND verified_at IS NOT NULL
+LIMIT 1;
+
+-- name: GetUserPaymentAlias :one
+SELECT *
+FROM payment_aliases
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND username = $3
+LIMIT 1;
+
+-- name: ListPaymentAliases :many
+SELECT *
+FROM payment_aliases
+WHERE
+    username = $1
+ORDER BY alias_type, value;
+
+-- name: VerifyPaymentAlias :one
+UPDATE payment_aliases
+SET
+    secret_code = '',
+    verified_at = now()
+WHERE
+    alias_type = @alias_type
+    AND value = @value
+    AND username = @username
+    AND secret_code = @secret_code
+    AND secret_code <> ''
+    AND verified_at IS NULL
+    AND code_expired_at > now()
+    AND verify_attempts < @max_attempts
+RETURNING
+    *;
+
+-- name: RecordPaymentAliasVerifyFailure :exec
+UPDATE payment_aliases
+SET
+    verify_attempts = verify_attempts + 1
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND username = $3
+    AND verified_at IS NULL;
+
+-- name: DeletePaymentAlias :execrows
+DELETE FROM payment_aliases
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND username = $3;
@@ -0,0 +1,44 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO
+    payment_requests (
+        id,
+        requester,
+        payee_account_id,
+        payer,
+        amount,
+        currency,
+        message,
+        expires_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+    *;
+
+-- name: GetPaymentRequest :one
+SELECT * FROM payment_requests WHERE id = $1 LIMIT 1;
+
+-- name: GetPaymentRequestForUpdate :one
+SELECT * FROM payment_requests WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: ListPaymentRequests :many
+SELECT *
+FROM payment_requests
+WHERE
+    requester = sqlc.arg (username)
+    OR payer = sqlc.arg (username)
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg (row_limit)
+OFFSET
+    sqlc.arg (row_offset);
+
+-- name: RespondPaymentRequest :one
+UPDATE payment_requests
+SET
+    status = sqlc.arg (status),
+    transfer_id = sqlc.narg (transfer_id),
+    responded_at = now()
+WHERE
+    id = sqlc.arg (id)
+    AND status = 'pending'
+RETURNING
+    *;
@@ -0,0 +1,23 @@
+-- name: CreatePot :one
+INSERT INTO
+    pots (
+        account_id,
+        parent_account_id,
+        name,
+        target_amount,
+        target_date
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    *;
+
+-- name: GetPot :one
+SELECT * FROM pots WHERE account_id = $1 LIMIT 1;
+
+-- name: ListPots :many
+SELECT sqlc.embed(p), a.balance
+FROM pots p
+    JOIN accounts a ON a.id = p.account_id
+WHERE
+    p.parent_account_id = $1
+ORDER BY p.created_at, p.account_id;
@@ -0,0 +1,63 @@
+-- name: ListBalanceMismatches :many
+SELECT
+    a.id,
+    a.balance,
+    COALESCE(SUM(e.amount), 0)::bigint AS entries_total
+FROM accounts a
+    LEFT JOIN entries e ON e.account_id = a.id
+GROUP BY
+    a.id
+HAVING
+    a.balance <> COALESCE(SUM(e.amount), 0)
+ORDER BY a.id;
+
+-- name: ListUnbalancedTransfers :many
+SELECT
+    t.id,
+    t.amount,
+    COUNT(e.id)::bigint AS entry_count,
+    COALESCE(SUM(e.amount), 0)::bigint AS entries_total
+FROM transfers t
+    LEFT JOIN entries e ON e.transfer_id = t.id
+GROUP BY
+    t.id
+HAVING
+    COUNT(e.id) <> 2
+    OR COALESCE(SUM(e.amount), 0) <> 0
+    OR COUNT(e.id) FILTER (
+        WHERE
+            e.account_id = t.from_account_id
+            AND e.amount = - t.amount
+    ) <> 1
+    OR COUNT(e.id) FILTER (
+        WHERE
+            e.account_id = t.to_account_id
+            AND e.amount = t.amount
+    ) <> 1
+ORDER BY t.id;
+
+-- name: CreateReconciliationFinding :one
+INSERT INTO
+    reconciliation_findings (
+        run_id,
+        kind,
+        account_id,
+        transfer_id,
+        expected,
+        actual,
+        details
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    *;
+
+-- name: ListReconciliationFindings :many
+SELECT *
+FROM reconciliation_findings
+WHERE
+    sqlc.narg (run_id)::uuid IS NULL
+    OR run_id = sqlc.narg (run_id)
+ORDER BY id DESC
+LIMIT sqlc.arg (row_limit)
+OFFSET
+    sqlc.arg (row_offset);
@@ -14,4 +14,9 @@ RETURNING
     *;
 
 -- name: GetSession :one
-SELECT * FROM sessions WHERE id = $1 LIMIT 1;
\ No newline at end of file
+SELECT * FROM sessions WHERE id = $1 LIMIT 1;
+
+-- name: ListSessionOrigins :many
+SELECT DISTINCT user_agent, client_ip
+FROM sessions
+WHERE username = $1 AND created_at < sqlc.arg (before);
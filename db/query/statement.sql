@@ -0,0 +1,38 @@
+-- name: CreateStatement :one
+INSERT INTO
+    statements (
+        id,
+        account_id,
+        username,
+        format,
+        period_start,
+        period_end
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    *;
+
+-- name: GetStatement :one
+SELECT * FROM statements WHERE id = $1 LIMIT 1;
+
+-- name: CompleteStatement :one
+UPDATE statements
+SET
+    status = 'ready',
+    content = $2,
+    completed_at = now()
+WHERE
+    id = $1
+RETURNING
+    *;
+
+-- name: FailStatement :one
+UPDATE statements
+SET
+    status = 'failed',
+    failure_reason = $2,
+    completed_at = now()
+WHERE
+    id = $1
+RETURNING
+    *;
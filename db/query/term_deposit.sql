@@ -0,0 +1,72 @@
+-- name: GetTermDepositRate :one
+SELECT * FROM term_deposit_rates WHERE term_months = $1 LIMIT 1;
+
+-- name: ListTermDepositRates :many
+SELECT * FROM term_deposit_rates ORDER BY term_months;
+
+-- name: CreateTermDeposit :one
+INSERT INTO
+    term_deposits (
+        account_id,
+        source_account_id,
+        term_months,
+        annual_rate_bps,
+        early_break_penalty_bps,
+        principal,
+        starts_on,
+        matures_on,
+        on_maturity
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING
+    *;
+
+-- name: GetTermDeposit :one
+SELECT * FROM term_deposits WHERE account_id = $1 LIMIT 1;
+
+-- name: GetTermDepositForUpdate :one
+SELECT *
+FROM term_deposits
+WHERE
+    account_id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListTermDeposits :many
+SELECT sqlc.embed(d), a.currency, a.balance
+FROM term_deposits d
+    JOIN accounts a ON a.id = d.account_id
+WHERE
+    d.source_account_id = $1
+ORDER BY d.created_at, d.account_id;
+
+-- name: ListMaturedTermDeposits :many
+SELECT account_id
+FROM term_deposits
+WHERE
+    status = 'active'
+    AND matures_on <= sqlc.arg (as_of)
+ORDER BY account_id;
+
+-- name: RenewTermDeposit :one
+UPDATE term_deposits
+SET
+    annual_rate_bps = sqlc.arg (annual_rate_bps),
+    early_break_penalty_bps = sqlc.arg (early_break_penalty_bps),
+    principal = sqlc.arg (principal),
+    starts_on = sqlc.arg (starts_on),
+    matures_on = sqlc.arg (matures_on)
+WHERE
+    account_id = sqlc.arg (account_id)
+RETURNING
+    *;
+
+-- name: CloseTermDeposit :one
+UPDATE term_deposits
+SET
+    status = sqlc.arg (status),
+    closed_at = now()
+WHERE
+    account_id = sqlc.arg (account_id)
+RETURNING
+    *;
@@ -2,9 +2,17 @@
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  description,
+  reference,
+  metadata
 ) VALUES (
-  $1, $2, $3
+  @from_account_id,
+  @to_account_id,
+  @amount,
+  @description,
+  @reference,
+  COALESCE(@metadata::jsonb, '{}')
 ) RETURNING *;
 
 -- name: GetTransfer :one
@@ -13,9 +21,9 @@ WHERE id = $1 LIMIT 1;
 
 -- name: ListTransfers :many
 SELECT * FROM transfers
-WHERE 
-    from_account_id = $1 OR
-    to_account_id = $2
+WHERE
+    from_account_id = @account_id OR
+    to_account_id = @account_id
 ORDER BY id
-LIMIT $3
-OFFSET $4;
+LIMIT @row_limit
+OFFSET @row_offset;
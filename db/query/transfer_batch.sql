@@ -0,0 +1,30 @@
+-- name: CreateTransferBatch :one
+INSERT INTO
+    transfer_batches (
+        id,
+        from_account_id,
+        total_amount,
+        total_fee,
+        leg_count
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    *;
+
+-- name: GetTransferBatch :one
+SELECT * FROM transfer_batches WHERE id = $1 LIMIT 1;
+
+-- name: CreateTransferBatchLeg :one
+INSERT INTO
+    transfer_batch_legs (
+        batch_id,
+        leg_index,
+        transfer_id,
+        fee
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
+
+-- name: ListTransferBatchLegs :many
+SELECT * FROM transfer_batch_legs WHERE batch_id = $1 ORDER BY leg_index;
@@ -28,12 +28,33 @@ SET
         sqlc.narg (full_name),
         full_name
     ),
-    email = COALESCE(sqlc.narg (email), email)
-    -- is_email_verified = COALESCE(
-    --     sqlc.narg (is_email_verified),
-    --     is_email_verified
-    -- )
+    email = COALESCE(sqlc.narg (email), email),
+    language = COALESCE(sqlc.narg (language), language),
+    -- a new address has to be verified again
+    is_email_verified = CASE
+        WHEN sqlc.narg (email) IS NULL
+        OR sqlc.narg (email) = email THEN is_email_verified
+        ELSE FALSE
+    END
 WHERE
     username = sqlc.arg (username)
+RETURNING
+    *;
+
+-- name: GetUserByVerifiedEmail :one
+SELECT *
+FROM users
+WHERE
+    lower(email) = lower(sqlc.arg (email))
+    AND is_email_verified
+LIMIT 1;
+
+-- name: MarkEmailVerified :one
+UPDATE users
+SET
+    is_email_verified = TRUE
+WHERE
+    username = sqlc.arg (username)
+    AND email = sqlc.arg (email)
 RETURNING
     *;
\ No newline at end of file
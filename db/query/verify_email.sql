@@ -17,3 +17,15 @@ WHERE
     AND is_used = FALSE
     AND expired_at > now()
 RETURNING *;
+
+
+-- name: GetPendingVerifyEmail :one
+SELECT *
+FROM verify_emails
+WHERE
+    username = $1
+    AND email = $2
+    AND is_used = FALSE
+    AND expired_at > now()
+ORDER BY id DESC
+LIMIT 1;
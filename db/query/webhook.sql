@@ -0,0 +1,99 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO
+    webhook_endpoints (
+        username,
+        url,
+        secret,
+        event_types
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    *;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookEndpoints :many
+SELECT *
+FROM webhook_endpoints
+WHERE
+    username = $1
+    AND active
+ORDER BY id;
+
+-- name: DeactivateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET
+    active = false
+WHERE
+    id = $1
+RETURNING
+    *;
+
+-- name: ListWebhookEndpointsForAccounts :many
+SELECT *
+FROM webhook_endpoints
+WHERE
+    active
+    AND sqlc.arg (event_type)::varchar = ANY (event_types)
+    AND username IN (
+        SELECT m.username
+        FROM account_members m
+        WHERE
+            m.account_id = ANY (sqlc.arg (account_ids)::bigint[])
+    )
+ORDER BY id;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO
+    webhook_deliveries (
+        endpoint_id,
+        event_id,
+        event_type,
+        payload
+    )
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (endpoint_id, event_id) DO UPDATE
+SET
+    endpoint_id = EXCLUDED.endpoint_id
+RETURNING
+    *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE
+    endpoint_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: RecordWebhookAttempt :one
+UPDATE webhook_deliveries
+SET
+    attempts = attempts + 1,
+    status = sqlc.arg (status),
+    response_status = sqlc.arg (response_status),
+    last_error = sqlc.arg (last_error),
+    delivered_at = CASE
+        WHEN sqlc.arg (status) = 'succeeded' THEN now()
+        ELSE delivered_at
+    END
+WHERE
+    id = sqlc.arg (id)
+RETURNING
+    *;
+
+-- name: ResetWebhookDelivery :one
+UPDATE webhook_deliveries
+SET
+    status = 'pending',
+    last_error = ''
+WHERE
+    id = $1
+RETURNING
+    *;
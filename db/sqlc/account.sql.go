@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/lib/pq"
 )
 
 const addAccountBalance = `-- name: AddAccountBalance :one
@@ -16,7 +18,7 @@ SET
 WHERE
     id = $2
 RETURNING
-    id, owner, balance, currency, created_at
+    id, owner, balance, currency, created_at, product_code, account_number
 `
 
 type AddAccountBalanceParams struct {
@@ -33,26 +35,42 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
 	)
 	return i, err
 }
 
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO
-    accounts (OWNER, balance, currency)
-VALUES ($1, $2, $3)
+    accounts (
+        OWNER,
+        balance,
+        currency,
+        product_code,
+        account_number
+    )
+VALUES ($1, $2, $3, $4, $5)
 RETURNING
-    id, owner, balance, currency, created_at
+    id, owner, balance, currency, created_at, product_code, account_number
 `
 
 type CreateAccountParams struct {
-	Owner    string `json:"owner"`
-	Balance  int64  `json:"balance"`
-	Currency string `json:"currency"`
+	Owner         string `json:"owner"`
+	Balance       int64  `json:"balance"`
+	Currency      string `json:"currency"`
+	ProductCode   string `json:"product_code"`
+	AccountNumber string `json:"account_number"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRowContext(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRowContext(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.ProductCode,
+		arg.AccountNumber,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -60,6 +78,8 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
 	)
 	return i, err
 }
@@ -74,7 +94,7 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at FROM accounts WHERE id = $1 LIMIT 1
+SELECT id, owner, balance, currency, created_at, product_code, account_number FROM accounts WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
@@ -86,12 +106,33 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
+	)
+	return i, err
+}
+
+const getAccountByNumber = `-- name: GetAccountByNumber :one
+SELECT id, owner, balance, currency, created_at, product_code, account_number FROM accounts WHERE account_number = $1 LIMIT 1
+`
+
+func (q *Queries) GetAccountByNumber(ctx context.Context, accountNumber string) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountByNumber, accountNumber)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at FROM accounts WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
+SELECT id, owner, balance, currency, created_at, product_code, account_number FROM accounts WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
 `
 
 func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, error) {
@@ -103,29 +144,182 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
 	)
 	return i, err
 }
 
-const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at
+const getAccountHolderName = `-- name: GetAccountHolderName :one
+SELECT u.full_name
+FROM accounts a
+    JOIN users u ON u.username = a.owner
+WHERE
+    a.id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetAccountHolderName(ctx context.Context, id int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getAccountHolderName, id)
+	var full_name string
+	err := row.Scan(&full_name)
+	return full_name, err
+}
+
+const getDefaultAccount = `-- name: GetDefaultAccount :one
+SELECT id, owner, balance, currency, created_at, product_code, account_number
+FROM accounts
+WHERE
+    OWNER = $1
+    AND currency = $2
+    AND product_code IN ('current', 'savings')
+ORDER BY product_code = 'current' DESC, id
+LIMIT 1
+`
+
+type GetDefaultAccountParams struct {
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetDefaultAccount(ctx context.Context, arg GetDefaultAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getDefaultAccount, arg.Owner, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
+	)
+	return i, err
+}
+
+const getInternalAccount = `-- name: GetInternalAccount :one
+SELECT id, owner, balance, currency, created_at, product_code, account_number
 FROM accounts
 WHERE
     OWNER = $1
-ORDER BY id
+    AND currency = $2
+    AND product_code = 'internal'
+LIMIT 1
+`
+
+type GetInternalAccountParams struct {
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetInternalAccount(ctx context.Context, arg GetInternalAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getInternalAccount, arg.Owner, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
+	)
+	return i, err
+}
+
+const listAccountNumbers = `-- name: ListAccountNumbers :many
+SELECT id, account_number FROM accounts WHERE id = ANY ($1::bigint[])
+`
+
+type ListAccountNumbersRow struct {
+	ID            int64  `json:"id"`
+	AccountNumber string `json:"account_number"`
+}
+
+func (q *Queries) ListAccountNumbers(ctx context.Context, ids []int64) ([]ListAccountNumbersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountNumbers, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountNumbersRow{}
+	for rows.Next() {
+		var i ListAccountNumbersRow
+		if err := rows.Scan(&i.ID, &i.AccountNumber); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccounts = `-- name: ListAccounts :many
+SELECT a.id, a.owner, a.balance, a.currency, a.created_at, a.product_code, a.account_number
+FROM accounts a
+    JOIN account_members m ON m.account_id = a.id
+WHERE
+    m.username = $1
+ORDER BY a.id
 LIMIT $2
 OFFSET
     $3
 `
 
 type ListAccountsParams struct {
-	Owner  string `json:"owner"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Username string `json:"username"`
+	Limit    int32  `json:"limit"`
+	Offset   int32  `json:"offset"`
 }
 
 func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
-	rows, err := q.db.QueryContext(ctx, listAccounts, arg.Owner, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listAccounts, arg.Username, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.ProductCode,
+			&i.AccountNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDefaultAccounts = `-- name: ListDefaultAccounts :many
+SELECT DISTINCT
+    ON (currency) id, owner, balance, currency, created_at, product_code, account_number
+FROM accounts
+WHERE
+    OWNER = $1
+    AND product_code IN ('current', 'savings')
+ORDER BY currency, product_code = 'current' DESC, id
+`
+
+func (q *Queries) ListDefaultAccounts(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listDefaultAccounts, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +333,8 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.ProductCode,
+			&i.AccountNumber,
 		); err != nil {
 			return nil, err
 		}
@@ -154,7 +350,7 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 }
 
 const updateAccount = `-- name: UpdateAccount :one
-UPDATE accounts SET balance = $2 WHERE id = $1 RETURNING id, owner, balance, currency, created_at
+UPDATE accounts SET balance = $2 WHERE id = $1 RETURNING id, owner, balance, currency, created_at, product_code, account_number
 `
 
 type UpdateAccountParams struct {
@@ -171,6 +367,8 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.ProductCode,
+		&i.AccountNumber,
 	)
 	return i, err
 }
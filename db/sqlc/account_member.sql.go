@@ -0,0 +1,90 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: account_member.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createAccountMember = `-- name: CreateAccountMember :one
+INSERT INTO
+    account_members (
+        account_id,
+        username,
+        role,
+        added_by
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    account_id, username, role, added_by, created_at
+`
+
+type CreateAccountMemberParams struct {
+	AccountID int64          `json:"account_id"`
+	Username  string         `json:"username"`
+	Role      string         `json:"role"`
+	AddedBy   sql.NullString `json:"added_by"`
+}
+
+func (q *Queries) CreateAccountMember(ctx context.Context, arg CreateAccountMemberParams) (AccountMember, error) {
+	row := q.db.QueryRowContext(ctx, createAccountMember,
+		arg.AccountID,
+		arg.Username,
+		arg.Role,
+		arg.AddedBy,
+	)
+	var i AccountMember
+	err := row.Scan(
+		&i.AccountID,
+		&i.Username,
+		&i.Role,
+		&i.AddedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAccountMember = `-- name: DeleteAccountMember :exec
+DELETE FROM account_members WHERE account_id = $1 AND username = $2
+`
+
+type DeleteAccountMemberParams struct {
+	AccountID int64  `json:"account_id"`
+	Username  string `json:"username"`
+}
+
+func (q *Queries) DeleteAccountMember(ctx context.Context, arg DeleteAccountMemberParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAccountMember, arg.AccountID, arg.Username)
+	return err
+}
+
+const getAccountMember = `-- name: GetAccountMember :one
+SELECT account_id, username, role, added_by, created_at
+FROM account_members
+WHERE
+    account_id = $1
+    AND username = $2
+LIMIT 1
+`
+
+type GetAccountMemberParams struct {
+	AccountID int64  `json:"account_id"`
+	Username  string `json:"username"`
+}
+
+func (q *Queries) GetAccountMember(ctx context.Context, arg GetAccountMemberParams) (AccountMember, error) {
+	row := q.db.QueryRowContext(ctx, getAccountMember, arg.AccountID, arg.Username)
+	var i AccountMember
+	err := row.Scan(
+		&i.AccountID,
+		&i.Username,
+		&i.Role,
+		&i.AddedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
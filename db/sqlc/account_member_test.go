@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountMembers(t *testing.T) {
+	account := createRandomAccount(t)
+	user := createRandomUser(t)
+
+	arg := CreateAccountMemberParams{
+		AccountID: account.ID,
+		Username:  user.Username,
+		Role:      util.MemberCoOwner,
+		AddedBy:   sql.NullString{String: account.Owner, Valid: true},
+	}
+	member, err := testQueries.CreateAccountMember(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.AccountID, member.AccountID)
+	require.Equal(t, arg.Username, member.Username)
+	require.Equal(t, arg.Role, member.Role)
+	require.Equal(t, arg.AddedBy, member.AddedBy)
+	require.NotZero(t, member.CreatedAt)
+
+	_, err = testQueries.CreateAccountMember(context.Background(), arg)
+	require.Error(t, err)
+
+	// the account shows up in the member's list next to their own accounts
+	accounts, err := testQueries.ListAccounts(context.Background(), ListAccountsParams{
+		Username: user.Username,
+		Limit:    5,
+	})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].ID)
+
+	key := GetAccountMemberParams{AccountID: account.ID, Username: user.Username}
+	err = testQueries.DeleteAccountMember(context.Background(), DeleteAccountMemberParams(key))
+	require.NoError(t, err)
+
+	_, err = testQueries.GetAccountMember(context.Background(), key)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCreateAccountSameCurrency(t *testing.T) {
+	account1 := createRandomAccount(t)
+
+	// a second account in the same currency, e.g. to share as a joint account
+	result, err := NewStore(testDB).CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:         account1.Owner,
+		Currency:      account1.Currency,
+		ProductCode:   util.ProductCurrent,
+		AccountNumber: util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, account1.ID, result.Account.ID)
+	require.Equal(t, account1.Owner, result.Member.Username)
+}
@@ -14,22 +14,32 @@ func createRandomAccount(t *testing.T) Account {
 	user := createRandomUser(t)
 
 	arg := CreateAccountParams{
-		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
-		Currency: util.RandomCurrency(),
+		Owner:         user.Username,
+		Balance:       util.RandomMoney(),
+		Currency:      util.RandomCurrency(),
+		ProductCode:   util.ProductCurrent,
+		AccountNumber: util.RandomAccountNumber(),
 	}
 
-	account, err := testQueries.CreateAccount(context.Background(), arg)
+	result, err := NewStore(testDB).CreateAccountTx(context.Background(), arg)
 	require.NoError(t, err)
+
+	account := result.Account
 	require.NotEmpty(t, account)
 
 	require.Equal(t, arg.Owner, account.Owner)
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
+	require.Equal(t, arg.ProductCode, account.ProductCode)
+	require.Equal(t, arg.AccountNumber, account.AccountNumber)
 
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
 
+	require.Equal(t, account.ID, result.Member.AccountID)
+	require.Equal(t, account.Owner, result.Member.Username)
+	require.Equal(t, util.MemberOwner, result.Member.Role)
+
 	return account
 }
 
@@ -50,6 +60,17 @@ func TestGetAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
+func TestGetAccountByNumber(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2, err := testQueries.GetAccountByNumber(context.Background(), account1.AccountNumber)
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, account1.AccountNumber, account2.AccountNumber)
+
+	_, err = testQueries.GetAccountByNumber(context.Background(), util.RandomAccountNumber())
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func TestUpdateAccount(t *testing.T) {
 	account1 := createRandomAccount(t)
 
@@ -87,9 +108,9 @@ func TestListAccounts(t *testing.T) {
 	}
 
 	arg := ListAccountsParams{
-		Owner:  lastAccount.Owner,
-		Limit:  5,
-		Offset: 0,
+		Username: lastAccount.Owner,
+		Limit:    5,
+		Offset:   0,
 	}
 
 	accounts, err := testQueries.ListAccounts(context.Background(), arg)
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var ErrAccountNotOpen = errors.New("account was not open at the requested time")
+
+// GetBalanceAt returns the balance of an account as it was at the given time.
+// It starts from the latest daily snapshot taken at or before that time and adds
+// the entries created since; without a snapshot it works back from the current balance.
+func (q *Queries) GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error) {
+	account, err := q.GetAccount(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	if at.Before(account.CreatedAt) {
+		return 0, ErrAccountNotOpen
+	}
+
+	snapshot, err := q.GetLatestDailyBalance(ctx, GetLatestDailyBalanceParams{
+		AccountID: accountID,
+		At:        at,
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return 0, err
+		}
+
+		later, err := q.SumEntriesAfter(ctx, SumEntriesAfterParams{
+			AccountID: accountID,
+			After:     at,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return account.Balance - later, nil
+	}
+
+	since, err := q.SumEntriesBetween(ctx, SumEntriesBetweenParams{
+		AccountID: accountID,
+		FromTime:  snapshot.AsOf,
+		ToTime:    at,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return snapshot.Balance + since, nil
+}
@@ -0,0 +1,122 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: balance.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createDailyBalances = `-- name: CreateDailyBalances :execrows
+INSERT INTO
+    daily_balances (
+        account_id,
+        balance_date,
+        as_of,
+        balance
+    )
+SELECT
+    a.id,
+    $1::date,
+    $2::timestamptz,
+    a.balance - COALESCE(
+        (
+            SELECT SUM(e.amount)
+            FROM entries e
+            WHERE
+                e.account_id = a.id
+                AND e.created_at >= $2::timestamptz
+        ),
+        0
+    )
+FROM accounts a
+WHERE
+    a.created_at < $2::timestamptz
+ON CONFLICT (account_id, balance_date) DO NOTHING
+`
+
+type CreateDailyBalancesParams struct {
+	BalanceDate time.Time `json:"balance_date"`
+	AsOf        time.Time `json:"as_of"`
+}
+
+func (q *Queries) CreateDailyBalances(ctx context.Context, arg CreateDailyBalancesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createDailyBalances, arg.BalanceDate, arg.AsOf)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getLatestDailyBalance = `-- name: GetLatestDailyBalance :one
+SELECT account_id, balance_date, as_of, balance, created_at
+FROM daily_balances
+WHERE
+    account_id = $1
+    AND as_of <= $2
+ORDER BY as_of DESC
+LIMIT 1
+`
+
+type GetLatestDailyBalanceParams struct {
+	AccountID int64     `json:"account_id"`
+	At        time.Time `json:"at"`
+}
+
+func (q *Queries) GetLatestDailyBalance(ctx context.Context, arg GetLatestDailyBalanceParams) (DailyBalance, error) {
+	row := q.db.QueryRowContext(ctx, getLatestDailyBalance, arg.AccountID, arg.At)
+	var i DailyBalance
+	err := row.Scan(
+		&i.AccountID,
+		&i.BalanceDate,
+		&i.AsOf,
+		&i.Balance,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const sumEntriesAfter = `-- name: SumEntriesAfter :one
+SELECT COALESCE(SUM(amount), 0)::bigint
+FROM entries
+WHERE
+    account_id = $1
+    AND created_at > $2
+`
+
+type SumEntriesAfterParams struct {
+	AccountID int64     `json:"account_id"`
+	After     time.Time `json:"after"`
+}
+
+func (q *Queries) SumEntriesAfter(ctx context.Context, arg SumEntriesAfterParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sumEntriesAfter, arg.AccountID, arg.After)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const sumEntriesBetween = `-- name: SumEntriesBetween :one
+SELECT COALESCE(SUM(amount), 0)::bigint
+FROM entries
+WHERE
+    account_id = $1
+    AND created_at >= $2
+    AND created_at <= $3
+`
+
+type SumEntriesBetweenParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+func (q *Queries) SumEntriesBetween(ctx context.Context, arg SumEntriesBetweenParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sumEntriesBetween, arg.AccountID, arg.FromTime, arg.ToTime)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
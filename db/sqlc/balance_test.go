@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBalanceAt(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	amount := int64(10)
+
+	_, err := store.GetBalanceAt(context.Background(), account1.ID, account1.CreatedAt.Add(-time.Hour))
+	require.ErrorIs(t, err, ErrAccountNotOpen)
+
+	beforeTransfer := time.Now()
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount,
+	})
+	require.NoError(t, err)
+	afterTransfer := time.Now()
+
+	balance, err := store.GetBalanceAt(context.Background(), account1.ID, beforeTransfer)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, balance)
+
+	balance, err = store.GetBalanceAt(context.Background(), account1.ID, afterTransfer)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-amount, balance)
+
+	// snapshot every account, then move more money after the snapshot
+	_, err = testQueries.CreateDailyBalances(context.Background(), CreateDailyBalancesParams{
+		BalanceDate: time.Date(2100+int(util.RandomInt(0, 800)), time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(util.RandomInt(0, 364))),
+		AsOf:        afterTransfer,
+	})
+	require.NoError(t, err)
+
+	snapshot, err := testQueries.GetLatestDailyBalance(context.Background(), GetLatestDailyBalanceParams{
+		AccountID: account1.ID,
+		At:        time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-amount, snapshot.Balance)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount,
+	})
+	require.NoError(t, err)
+
+	balance, err = store.GetBalanceAt(context.Background(), account1.ID, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-2*amount, balance)
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// A payer can only send up to CoolingOffTransferLimit per transfer to an account
+// they first saved as a payee or first paid less than BeneficiaryCoolingOff ago,
+// however the account is given. This limits the damage when someone who took
+// over a profile pays their own account.
+const (
+	BeneficiaryCoolingOff   = 24 * time.Hour
+	CoolingOffTransferLimit = 100_000
+)
+
+var ErrBeneficiaryCoolingOff = errors.New("payee was added too recently to receive this amount")
+
+// PayeeCoolingOffUntil returns when a payee known since the given time, as
+// returned by GetPayeeSince, can receive transfers of any amount.
+func PayeeCoolingOffUntil(payeeSince time.Time) time.Time {
+	return payeeSince.Add(BeneficiaryCoolingOff)
+}
+
+// CoolingOffUntil returns when the payee can receive transfers of any amount at
+// the latest. It is sooner if the user paid the account before saving it.
+func (beneficiary Beneficiary) CoolingOffUntil() time.Time {
+	return PayeeCoolingOffUntil(beneficiary.CreatedAt)
+}
+
+// CheckPayeeTransfer returns an error if a payee known since the given time
+// cannot receive the amount yet.
+func CheckPayeeTransfer(payeeSince time.Time, amount int64, now time.Time) error {
+	if amount > CoolingOffTransferLimit && now.Before(PayeeCoolingOffUntil(payeeSince)) {
+		return ErrBeneficiaryCoolingOff
+	}
+	return nil
+}
+
+// AddBeneficiaryParams contains the input parameters of adding a payee
+type AddBeneficiaryParams struct {
+	Username  string `json:"username"`
+	Nickname  string `json:"nickname"`
+	AccountID int64  `json:"account_id"`
+	Currency  string `json:"currency"`
+	// HolderName is the name the user expects on the account. The payee is
+	// verified if it matches; it is saved either way.
+	HolderName string `json:"holder_name"`
+}
+
+// AddBeneficiary saves an account to the address book of a user after checking
+// that it holds the currency and whether the holder name matches.
+func (q *Queries) AddBeneficiary(ctx context.Context, arg AddBeneficiaryParams) (Beneficiary, error) {
+	account, err := q.GetAccount(ctx, arg.AccountID)
+	if err != nil {
+		return Beneficiary{}, err
+	}
+	if account.Currency != arg.Currency {
+		return Beneficiary{}, ErrCurrencyMismatch
+	}
+
+	holderName, err := q.GetAccountHolderName(ctx, account.ID)
+	if err != nil {
+		return Beneficiary{}, err
+	}
+
+	return q.CreateBeneficiary(ctx, CreateBeneficiaryParams{
+		Username:   arg.Username,
+		Nickname:   arg.Nickname,
+		AccountID:  account.ID,
+		Currency:   account.Currency,
+		IsVerified: arg.HolderName != "" && namesMatch(holderName, arg.HolderName),
+	})
+}
+
+// namesMatch compares two names ignoring case and extra spaces.
+func namesMatch(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
@@ -0,0 +1,205 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: beneficiary.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createBeneficiary = `-- name: CreateBeneficiary :one
+INSERT INTO
+    beneficiaries (
+        username,
+        nickname,
+        account_id,
+        currency,
+        is_verified
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    id, username, nickname, account_id, currency, is_verified, created_at
+`
+
+type CreateBeneficiaryParams struct {
+	Username   string `json:"username"`
+	Nickname   string `json:"nickname"`
+	AccountID  int64  `json:"account_id"`
+	Currency   string `json:"currency"`
+	IsVerified bool   `json:"is_verified"`
+}
+
+func (q *Queries) CreateBeneficiary(ctx context.Context, arg CreateBeneficiaryParams) (Beneficiary, error) {
+	row := q.db.QueryRowContext(ctx, createBeneficiary,
+		arg.Username,
+		arg.Nickname,
+		arg.AccountID,
+		arg.Currency,
+		arg.IsVerified,
+	)
+	var i Beneficiary
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Nickname,
+		&i.AccountID,
+		&i.Currency,
+		&i.IsVerified,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteBeneficiary = `-- name: DeleteBeneficiary :exec
+DELETE FROM beneficiaries WHERE id = $1
+`
+
+func (q *Queries) DeleteBeneficiary(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteBeneficiary, id)
+	return err
+}
+
+const getBeneficiary = `-- name: GetBeneficiary :one
+SELECT id, username, nickname, account_id, currency, is_verified, created_at FROM beneficiaries WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetBeneficiary(ctx context.Context, id int64) (Beneficiary, error) {
+	row := q.db.QueryRowContext(ctx, getBeneficiary, id)
+	var i Beneficiary
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Nickname,
+		&i.AccountID,
+		&i.Currency,
+		&i.IsVerified,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPayeeSince = `-- name: GetPayeeSince :one
+SELECT (
+        CASE
+            WHEN EXISTS (
+                SELECT 1
+                FROM accounts a
+                WHERE
+                    a.id = $1
+                    AND a.owner = $2
+            ) THEN to_timestamp(0)
+            ELSE COALESCE(
+                LEAST(
+                    (
+                        SELECT min(b.created_at)
+                        FROM beneficiaries b
+                        WHERE
+                            b.username = $2
+                            AND b.account_id = $1
+                    ), (
+                        SELECT min(t.created_at)
+                        FROM
+                            transfers t
+                            JOIN account_members m ON m.account_id = t.from_account_id
+                        WHERE
+                            m.username = $2
+                            AND t.to_account_id = $1
+                    )
+                ), now()
+            )
+        END
+    )::timestamptz AS payee_since
+`
+
+type GetPayeeSinceParams struct {
+	ToAccountID int64  `json:"to_account_id"`
+	Username    string `json:"username"`
+}
+
+func (q *Queries) GetPayeeSince(ctx context.Context, arg GetPayeeSinceParams) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getPayeeSince, arg.ToAccountID, arg.Username)
+	var payee_since time.Time
+	err := row.Scan(&payee_since)
+	return payee_since, err
+}
+
+const listBeneficiaries = `-- name: ListBeneficiaries :many
+SELECT id, username, nickname, account_id, currency, is_verified, created_at
+FROM beneficiaries
+WHERE
+    username = $1
+ORDER BY nickname, id
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListBeneficiariesParams struct {
+	Username string `json:"username"`
+	Limit    int32  `json:"limit"`
+	Offset   int32  `json:"offset"`
+}
+
+func (q *Queries) ListBeneficiaries(ctx context.Context, arg ListBeneficiariesParams) ([]Beneficiary, error) {
+	rows, err := q.db.QueryContext(ctx, listBeneficiaries, arg.Username, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Beneficiary{}
+	for rows.Next() {
+		var i Beneficiary
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Nickname,
+			&i.AccountID,
+			&i.Currency,
+			&i.IsVerified,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateBeneficiary = `-- name: UpdateBeneficiary :one
+UPDATE beneficiaries
+SET
+    nickname = $1
+WHERE
+    id = $2
+RETURNING
+    id, username, nickname, account_id, currency, is_verified, created_at
+`
+
+type UpdateBeneficiaryParams struct {
+	Nickname string `json:"nickname"`
+	ID       int64  `json:"id"`
+}
+
+func (q *Queries) UpdateBeneficiary(ctx context.Context, arg UpdateBeneficiaryParams) (Beneficiary, error) {
+	row := q.db.QueryRowContext(ctx, updateBeneficiary, arg.Nickname, arg.ID)
+	var i Beneficiary
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Nickname,
+		&i.AccountID,
+		&i.Currency,
+		&i.IsVerified,
+		&i.CreatedAt,
+	)
+	return i, err
+}
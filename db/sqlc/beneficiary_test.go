@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddBeneficiary(t *testing.T) {
+	user := createRandomUser(t)
+	account := createRandomAccount(t)
+
+	holder, err := testQueries.GetUser(context.Background(), account.Owner)
+	require.NoError(t, err)
+
+	arg := AddBeneficiaryParams{
+		Username:   user.Username,
+		Nickname:   "landlord",
+		AccountID:  account.ID,
+		Currency:   account.Currency,
+		HolderName: " " + strings.ToUpper(holder.FullName) + " ",
+	}
+	beneficiary, err := testQueries.AddBeneficiary(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, beneficiary.ID)
+	require.Equal(t, arg.Username, beneficiary.Username)
+	require.Equal(t, arg.Nickname, beneficiary.Nickname)
+	require.Equal(t, arg.AccountID, beneficiary.AccountID)
+	require.Equal(t, arg.Currency, beneficiary.Currency)
+	require.True(t, beneficiary.IsVerified)
+	require.NotZero(t, beneficiary.CreatedAt)
+
+	// the same account can only be saved once
+	_, err = testQueries.AddBeneficiary(context.Background(), arg)
+	require.Error(t, err)
+
+	// a payee whose name doesn't match is saved unverified
+	other := createRandomAccount(t)
+	arg.AccountID = other.ID
+	arg.Currency = other.Currency
+	arg.HolderName = "Somebody Else"
+	beneficiary, err = testQueries.AddBeneficiary(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, beneficiary.IsVerified)
+
+	arg.AccountID = createRandomAccount(t).ID
+	arg.Currency = "XXX"
+	_, err = testQueries.AddBeneficiary(context.Background(), arg)
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestBeneficiaries(t *testing.T) {
+	user := createRandomUser(t)
+
+	for _, nickname := range []string{"b", "a"} {
+		account := createRandomAccount(t)
+		_, err := testQueries.AddBeneficiary(context.Background(), AddBeneficiaryParams{
+			Username:  user.Username,
+			Nickname:  nickname,
+			AccountID: account.ID,
+			Currency:  account.Currency,
+		})
+		require.NoError(t, err)
+	}
+
+	beneficiaries, err := testQueries.ListBeneficiaries(context.Background(), ListBeneficiariesParams{
+		Username: user.Username,
+		Limit:    5,
+	})
+	require.NoError(t, err)
+	require.Len(t, beneficiaries, 2)
+	require.Equal(t, "a", beneficiaries[0].Nickname)
+	require.Equal(t, "b", beneficiaries[1].Nickname)
+
+	updated, err := testQueries.UpdateBeneficiary(context.Background(), UpdateBeneficiaryParams{
+		ID:       beneficiaries[0].ID,
+		Nickname: "c",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "c", updated.Nickname)
+	require.Equal(t, beneficiaries[0].AccountID, updated.AccountID)
+
+	err = testQueries.DeleteBeneficiary(context.Background(), updated.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetBeneficiary(context.Background(), updated.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCheckPayeeTransfer(t *testing.T) {
+	payeeSince := time.Now()
+
+	require.NoError(t, CheckPayeeTransfer(payeeSince, CoolingOffTransferLimit, time.Now()))
+	require.ErrorIs(t, CheckPayeeTransfer(payeeSince, CoolingOffTransferLimit+1, time.Now()), ErrBeneficiaryCoolingOff)
+	require.NoError(t, CheckPayeeTransfer(payeeSince, CoolingOffTransferLimit+1, PayeeCoolingOffUntil(payeeSince)))
+}
+
+func TestGetPayeeSince(t *testing.T) {
+	payer := createRandomAccount(t)
+	payee := createRandomAccount(t)
+
+	payeeSince := func(accountID int64) time.Time {
+		since, err := testQueries.GetPayeeSince(context.Background(), GetPayeeSinceParams{
+			ToAccountID: accountID,
+			Username:    payer.Owner,
+		})
+		require.NoError(t, err)
+		return since
+	}
+
+	// an account never saved nor paid is new, however it is given
+	require.WithinDuration(t, time.Now(), payeeSince(payee.ID), time.Minute)
+
+	// the first transfer to it starts the cooling-off
+	transfer, err := NewStore(testDB).TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: payer.ID,
+		ToAccountID:   payee.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, transfer.Transfer.CreatedAt, payeeSince(payee.ID), time.Second)
+
+	// accounts of the payer are never new
+	require.True(t, payeeSince(payer.ID).Before(time.Now().Add(-BeneficiaryCoolingOff)))
+}
@@ -0,0 +1,233 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: card.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createCard = `-- name: CreateCard :one
+INSERT INTO
+    cards (
+        account_id,
+        cardholder,
+        token,
+        masked_number,
+        expiry_month,
+        expiry_year,
+        hashed_cvv
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    id, account_id, cardholder, token, masked_number, expiry_month, expiry_year, hashed_cvv, status, created_at
+`
+
+type CreateCardParams struct {
+	AccountID    int64  `json:"account_id"`
+	Cardholder   string `json:"cardholder"`
+	Token        string `json:"token"`
+	MaskedNumber string `json:"masked_number"`
+	ExpiryMonth  int32  `json:"expiry_month"`
+	ExpiryYear   int32  `json:"expiry_year"`
+	HashedCvv    string `json:"hashed_cvv"`
+}
+
+func (q *Queries) CreateCard(ctx context.Context, arg CreateCardParams) (Card, error) {
+	row := q.db.QueryRowContext(ctx, createCard,
+		arg.AccountID,
+		arg.Cardholder,
+		arg.Token,
+		arg.MaskedNumber,
+		arg.ExpiryMonth,
+		arg.ExpiryYear,
+		arg.HashedCvv,
+	)
+	var i Card
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Cardholder,
+		&i.Token,
+		&i.MaskedNumber,
+		&i.ExpiryMonth,
+		&i.ExpiryYear,
+		&i.HashedCvv,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createCardAuthorization = `-- name: CreateCardAuthorization :one
+INSERT INTO
+    card_authorizations (
+        card_id,
+        transfer_id,
+        amount,
+        currency,
+        merchant_name
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    id, card_id, transfer_id, amount, currency, merchant_name, created_at
+`
+
+type CreateCardAuthorizationParams struct {
+	CardID       int64  `json:"card_id"`
+	TransferID   int64  `json:"transfer_id"`
+	Amount       int64  `json:"amount"`
+	Currency     string `json:"currency"`
+	MerchantName string `json:"merchant_name"`
+}
+
+func (q *Queries) CreateCardAuthorization(ctx context.Context, arg CreateCardAuthorizationParams) (CardAuthorization, error) {
+	row := q.db.QueryRowContext(ctx, createCardAuthorization,
+		arg.CardID,
+		arg.TransferID,
+		arg.Amount,
+		arg.Currency,
+		arg.MerchantName,
+	)
+	var i CardAuthorization
+	err := row.Scan(
+		&i.ID,
+		&i.CardID,
+		&i.TransferID,
+		&i.Amount,
+		&i.Currency,
+		&i.MerchantName,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCard = `-- name: GetCard :one
+SELECT id, account_id, cardholder, token, masked_number, expiry_month, expiry_year, hashed_cvv, status, created_at FROM cards WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetCard(ctx context.Context, id int64) (Card, error) {
+	row := q.db.QueryRowContext(ctx, getCard, id)
+	var i Card
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Cardholder,
+		&i.Token,
+		&i.MaskedNumber,
+		&i.ExpiryMonth,
+		&i.ExpiryYear,
+		&i.HashedCvv,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCardByToken = `-- name: GetCardByToken :one
+SELECT id, account_id, cardholder, token, masked_number, expiry_month, expiry_year, hashed_cvv, status, created_at FROM cards WHERE token = $1 LIMIT 1
+`
+
+func (q *Queries) GetCardByToken(ctx context.Context, token string) (Card, error) {
+	row := q.db.QueryRowContext(ctx, getCardByToken, token)
+	var i Card
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Cardholder,
+		&i.Token,
+		&i.MaskedNumber,
+		&i.ExpiryMonth,
+		&i.ExpiryYear,
+		&i.HashedCvv,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCards = `-- name: ListCards :many
+SELECT id, account_id, cardholder, token, masked_number, expiry_month, expiry_year, hashed_cvv, status, created_at
+FROM cards
+WHERE
+    account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListCardsParams struct {
+	AccountID int64 `json:"account_id"`
+	Limit     int32 `json:"limit"`
+	Offset    int32 `json:"offset"`
+}
+
+func (q *Queries) ListCards(ctx context.Context, arg ListCardsParams) ([]Card, error) {
+	rows, err := q.db.QueryContext(ctx, listCards, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Card{}
+	for rows.Next() {
+		var i Card
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Cardholder,
+			&i.Token,
+			&i.MaskedNumber,
+			&i.ExpiryMonth,
+			&i.ExpiryYear,
+			&i.HashedCvv,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCardStatus = `-- name: UpdateCardStatus :one
+UPDATE cards
+SET
+    status = $1
+WHERE
+    id = $2
+RETURNING
+    id, account_id, cardholder, token, masked_number, expiry_month, expiry_year, hashed_cvv, status, created_at
+`
+
+type UpdateCardStatusParams struct {
+	Status string `json:"status"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) UpdateCardStatus(ctx context.Context, arg UpdateCardStatusParams) (Card, error) {
+	row := q.db.QueryRowContext(ctx, updateCardStatus, arg.Status, arg.ID)
+	var i Card
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Cardholder,
+		&i.Token,
+		&i.MaskedNumber,
+		&i.ExpiryMonth,
+		&i.ExpiryYear,
+		&i.HashedCvv,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,168 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+const testCardKey = "test card token key"
+
+func createRandomCard(t *testing.T, account Account, number string, cvv string) Card {
+	hashedCvv, err := util.HashPassword(cvv)
+	require.NoError(t, err)
+
+	month, year := util.CardExpiry(time.Now())
+	arg := CreateCardParams{
+		AccountID:    account.ID,
+		Cardholder:   account.Owner,
+		Token:        util.CardToken(testCardKey, number),
+		MaskedNumber: util.MaskCardNumber(number),
+		ExpiryMonth:  month,
+		ExpiryYear:   year,
+		HashedCvv:    hashedCvv,
+	}
+
+	card, err := testQueries.CreateCard(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.AccountID, card.AccountID)
+	require.Equal(t, arg.Token, card.Token)
+	require.Equal(t, arg.MaskedNumber, card.MaskedNumber)
+	require.Equal(t, CardActive, card.Status)
+
+	return card
+}
+
+func TestCardStatus(t *testing.T) {
+	account := createRandomAccount(t)
+	card := createRandomCard(t, account, util.NewCardNumber("400123"), util.NewCVV())
+
+	frozen, err := testQueries.UpdateCardStatus(context.Background(), UpdateCardStatusParams{
+		ID:     card.ID,
+		Status: CardFrozen,
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, frozen.CheckUsable(time.Now()), ErrCardFrozen)
+
+	cards, err := testQueries.ListCards(context.Background(), ListCardsParams{
+		AccountID: account.ID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Card{frozen}, cards)
+
+	card.ExpiryYear = int32(time.Now().Year() - 1)
+	require.ErrorIs(t, card.CheckUsable(time.Now()), ErrCardExpired)
+}
+
+func TestAuthorizeCardTx(t *testing.T) {
+	store := NewStore(testDB)
+	account, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      createRandomAccount(t).ID,
+		Balance: 100,
+	})
+	require.NoError(t, err)
+
+	number := util.NewCardNumber("400123")
+	cvv := util.NewCVV()
+	card := createRandomCard(t, account, number, cvv)
+
+	arg := AuthorizeCardTxParams{
+		Token:        util.CardToken(testCardKey, number),
+		ExpiryMonth:  card.ExpiryMonth,
+		ExpiryYear:   card.ExpiryYear,
+		CVV:          cvv,
+		Amount:       40,
+		Currency:     account.Currency,
+		MerchantName: "Corner Shop",
+	}
+
+	result, err := store.AuthorizeCardTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, card.ID, result.Authorization.CardID)
+	require.Equal(t, result.Transfer.Transfer.ID, result.Authorization.TransferID)
+	require.Equal(t, account.ID, result.Transfer.Transfer.FromAccountID)
+	require.Equal(t, int64(60), result.Transfer.FromAccount.Balance)
+	require.Equal(t, CardSettlementOwner, result.Transfer.ToAccount.Owner)
+	require.Equal(t, "Corner Shop", result.Transfer.FromEntry.CounterpartyName)
+
+	// the account cannot go below zero, and the declined payment leaves no trace
+	arg.Amount = 61
+	_, err = store.AuthorizeCardTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	arg.Amount = 10
+	wrongCvv := arg
+	wrongCvv.CVV = "x" + cvv
+	_, err = store.AuthorizeCardTx(context.Background(), wrongCvv)
+	require.ErrorIs(t, err, ErrCardDetailsInvalid)
+
+	wrongExpiry := arg
+	wrongExpiry.ExpiryYear++
+	_, err = store.AuthorizeCardTx(context.Background(), wrongExpiry)
+	require.ErrorIs(t, err, ErrCardDetailsInvalid)
+
+	unknown := arg
+	unknown.Token = util.CardToken(testCardKey, util.NewCardNumber("400123"))
+	_, err = store.AuthorizeCardTx(context.Background(), unknown)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQueries.UpdateCardStatus(context.Background(), UpdateCardStatusParams{
+		ID:     card.ID,
+		Status: CardCancelled,
+	})
+	require.NoError(t, err)
+	_, err = store.AuthorizeCardTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrCardCancelled)
+
+	account, err = testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(60), account.Balance)
+}
+
+func TestAuthorizeCardTxWritesOutboxEvent(t *testing.T) {
+	store := NewStore(testDB)
+	account, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      createRandomAccount(t).ID,
+		Balance: 1000,
+	})
+	require.NoError(t, err)
+
+	number := util.NewCardNumber("400123")
+	cvv := util.NewCVV()
+	card := createRandomCard(t, account, number, cvv)
+
+	result, err := store.AuthorizeCardTx(context.Background(), AuthorizeCardTxParams{
+		Token:        util.CardToken(testCardKey, number),
+		ExpiryMonth:  card.ExpiryMonth,
+		ExpiryYear:   card.ExpiryYear,
+		CVV:          cvv,
+		Amount:       600,
+		Currency:     account.Currency,
+		MerchantName: "Electronics Store",
+	})
+	require.NoError(t, err)
+
+	// the payment is evaluated for alerts like any other debit of the account
+	event, err := testQueries.GetOutboxEvent(context.Background(), GetOutboxEventParams{
+		EventType:   EventTransferCompleted,
+		AggregateID: fmt.Sprint(result.Transfer.Transfer.ID),
+	})
+	require.NoError(t, err)
+
+	var payload TransferCompletedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, result.Transfer.Transfer.ID, payload.TransferID)
+	require.Equal(t, account.ID, payload.FromAccountID)
+	require.Equal(t, account.AccountNumber, payload.FromAccountNumber)
+	require.Equal(t, int64(600), payload.Amount)
+	require.Zero(t, payload.Fee)
+	require.Equal(t, account.Currency, payload.Currency)
+}
@@ -0,0 +1,11 @@
+package db
+
+// AccountEntriesChannel is the channel Postgres notifies on for every entry
+// inserted. Notifications are only delivered once the transaction commits.
+const AccountEntriesChannel = "account_entries"
+
+// EntryNotification is the payload of a notification on AccountEntriesChannel.
+type EntryNotification struct {
+	AccountID int64 `json:"account_id"`
+	EntryID   int64 `json:"entry_id"`
+}
@@ -7,36 +7,77 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
 )
 
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  transfer_id,
+  description,
+  reference,
+  counterparty_name
 ) VALUES (
-  $1, $2
-) RETURNING id, account_id, amount, created_at
+  $1, $2, $3, $4, $5, $6
+) RETURNING id, account_id, amount, created_at, transfer_id, description, reference, counterparty_name
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID        int64         `json:"account_id"`
+	Amount           int64         `json:"amount"`
+	TransferID       sql.NullInt64 `json:"transfer_id"`
+	Description      string        `json:"description"`
+	Reference        string        `json:"reference"`
+	CounterpartyName string        `json:"counterparty_name"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRowContext(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createEntry,
+		arg.AccountID,
+		arg.Amount,
+		arg.TransferID,
+		arg.Description,
+		arg.Reference,
+		arg.CounterpartyName,
+	)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
+		&i.Description,
+		&i.Reference,
+		&i.CounterpartyName,
 	)
 	return i, err
 }
 
+const getAccountActivityHead = `-- name: GetAccountActivityHead :one
+SELECT a.balance,
+    COALESCE((SELECT max(e.id) FROM entries e WHERE e.account_id = a.id), 0)::bigint AS last_entry_id
+FROM accounts a
+WHERE a.id = $1
+`
+
+type GetAccountActivityHeadRow struct {
+	Balance     int64 `json:"balance"`
+	LastEntryID int64 `json:"last_entry_id"`
+}
+
+func (q *Queries) GetAccountActivityHead(ctx context.Context, id int64) (GetAccountActivityHeadRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccountActivityHead, id)
+	var i GetAccountActivityHeadRow
+	err := row.Scan(&i.Balance, &i.LastEntryID)
+	return i, err
+}
+
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, counterparty_name FROM entries
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,12 +89,81 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
+		&i.Description,
+		&i.Reference,
+		&i.CounterpartyName,
 	)
 	return i, err
 }
 
+const listAccountActivity = `-- name: ListAccountActivity :many
+SELECT e.id, e.account_id, e.amount, e.created_at, e.transfer_id, e.description, e.reference, e.counterparty_name, a.currency,
+    COALESCE(CASE WHEN e.amount < 0 THEN t.to_account_id ELSE t.from_account_id END, 0)::bigint AS counterparty_account_id,
+    COALESCE(t.metadata, '{}')::jsonb AS metadata,
+    (a.balance - COALESCE(SUM(e.amount) OVER (ORDER BY e.id DESC ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING), 0))::bigint AS balance_after
+FROM entries e
+    JOIN accounts a ON a.id = e.account_id
+    LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE
+    e.account_id = $1
+    AND e.id > $2
+ORDER BY e.id
+LIMIT $3
+`
+
+type ListAccountActivityParams struct {
+	AccountID int64 `json:"account_id"`
+	AfterID   int64 `json:"after_id"`
+	RowLimit  int32 `json:"row_limit"`
+}
+
+type ListAccountActivityRow struct {
+	Entry                 Entry           `json:"entry"`
+	Currency              string          `json:"currency"`
+	CounterpartyAccountID int64           `json:"counterparty_account_id"`
+	Metadata              json.RawMessage `json:"metadata"`
+	BalanceAfter          int64           `json:"balance_after"`
+}
+
+func (q *Queries) ListAccountActivity(ctx context.Context, arg ListAccountActivityParams) ([]ListAccountActivityRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountActivity, arg.AccountID, arg.AfterID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountActivityRow{}
+	for rows.Next() {
+		var i ListAccountActivityRow
+		if err := rows.Scan(
+			&i.Entry.ID,
+			&i.Entry.AccountID,
+			&i.Entry.Amount,
+			&i.Entry.CreatedAt,
+			&i.Entry.TransferID,
+			&i.Entry.Description,
+			&i.Entry.Reference,
+			&i.Entry.CounterpartyName,
+			&i.Currency,
+			&i.CounterpartyAccountID,
+			&i.Metadata,
+			&i.BalanceAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, counterparty_name FROM entries
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2
@@ -80,6 +190,58 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.CounterpartyName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesBetween = `-- name: ListEntriesBetween :many
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, counterparty_name
+FROM entries
+WHERE
+    account_id = $1
+    AND created_at > $2
+    AND created_at <= $3
+ORDER BY created_at, id
+`
+
+type ListEntriesBetweenParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+func (q *Queries) ListEntriesBetween(ctx context.Context, arg ListEntriesBetweenParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntriesBetween, arg.AccountID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.CounterpartyName,
 		); err != nil {
 			return nil, err
 		}
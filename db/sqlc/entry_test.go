@@ -11,8 +11,11 @@ import (
 
 func createRandomEntry(t *testing.T, account Account) Entry {
 	arg := CreateEntryParams{
-		AccountID: account.ID,
-		Amount:    util.RandomMoney(),
+		AccountID:        account.ID,
+		Amount:           util.RandomMoney(),
+		Description:      util.RandomString(20),
+		Reference:        util.RandomString(12),
+		CounterpartyName: util.RandomOwner(),
 	}
 
 	entry, err := testQueries.CreateEntry(context.Background(), arg)
@@ -21,6 +24,9 @@ func createRandomEntry(t *testing.T, account Account) Entry {
 
 	require.Equal(t, arg.AccountID, entry.AccountID)
 	require.Equal(t, arg.Amount, entry.Amount)
+	require.Equal(t, arg.Description, entry.Description)
+	require.Equal(t, arg.Reference, entry.Reference)
+	require.Equal(t, arg.CounterpartyName, entry.CounterpartyName)
 
 	require.NotZero(t, entry.ID)
 	require.NotZero(t, entry.CreatedAt)
@@ -67,3 +73,50 @@ func TestListEntries(t *testing.T) {
 		require.Equal(t, arg.AccountID, entry.AccountID)
 	}
 }
+
+func TestListAccountActivity(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	head, err := testQueries.GetAccountActivityHead(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, head.Balance)
+	require.Zero(t, head.LastEntryID)
+
+	for i := 0; i < 3; i++ {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        10,
+		})
+		require.NoError(t, err)
+	}
+
+	activity, err := testQueries.ListAccountActivity(context.Background(), ListAccountActivityParams{
+		AccountID: account1.ID,
+		AfterID:   head.LastEntryID,
+		RowLimit:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, activity, 2)
+	require.Equal(t, int64(-10), activity[0].Entry.Amount)
+	require.Equal(t, account2.ID, activity[0].CounterpartyAccountID)
+	require.Equal(t, account1.Balance-10, activity[0].BalanceAfter)
+	require.Equal(t, account1.Balance-20, activity[1].BalanceAfter)
+
+	// resume after the last entry seen
+	activity, err = testQueries.ListAccountActivity(context.Background(), ListAccountActivityParams{
+		AccountID: account1.ID,
+		AfterID:   activity[1].Entry.ID,
+		RowLimit:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, activity, 1)
+	require.Equal(t, account1.Balance-30, activity[0].BalanceAfter)
+
+	head, err = testQueries.GetAccountActivityHead(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-30, head.Balance)
+	require.Equal(t, activity[0].Entry.ID, head.LastEntryID)
+}
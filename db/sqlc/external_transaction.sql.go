@@ -0,0 +1,317 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: external_transaction.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createExternalImport = `-- name: CreateExternalImport :one
+INSERT INTO
+    external_imports (
+        id,
+        account_id,
+        source,
+        imported_by,
+        row_count
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    id, account_id, source, imported_by, row_count, created_at
+`
+
+type CreateExternalImportParams struct {
+	ID         uuid.UUID `json:"id"`
+	AccountID  int64     `json:"account_id"`
+	Source     string    `json:"source"`
+	ImportedBy string    `json:"imported_by"`
+	RowCount   int32     `json:"row_count"`
+}
+
+func (q *Queries) CreateExternalImport(ctx context.Context, arg CreateExternalImportParams) (ExternalImport, error) {
+	row := q.db.QueryRowContext(ctx, createExternalImport,
+		arg.ID,
+		arg.AccountID,
+		arg.Source,
+		arg.ImportedBy,
+		arg.RowCount,
+	)
+	var i ExternalImport
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Source,
+		&i.ImportedBy,
+		&i.RowCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createExternalTransaction = `-- name: CreateExternalTransaction :one
+INSERT INTO
+    external_transactions (
+        import_id,
+        account_id,
+        line_number,
+        booked_on,
+        amount,
+        reference,
+        description
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    id, import_id, account_id, line_number, booked_on, amount, reference, description, status, transfer_id, matched_by, matched_at, created_at
+`
+
+type CreateExternalTransactionParams struct {
+	ImportID    uuid.UUID `json:"import_id"`
+	AccountID   int64     `json:"account_id"`
+	LineNumber  int32     `json:"line_number"`
+	BookedOn    time.Time `json:"booked_on"`
+	Amount      int64     `json:"amount"`
+	Reference   string    `json:"reference"`
+	Description string    `json:"description"`
+}
+
+func (q *Queries) CreateExternalTransaction(ctx context.Context, arg CreateExternalTransactionParams) (ExternalTransaction, error) {
+	row := q.db.QueryRowContext(ctx, createExternalTransaction,
+		arg.ImportID,
+		arg.AccountID,
+		arg.LineNumber,
+		arg.BookedOn,
+		arg.Amount,
+		arg.Reference,
+		arg.Description,
+	)
+	var i ExternalTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.ImportID,
+		&i.AccountID,
+		&i.LineNumber,
+		&i.BookedOn,
+		&i.Amount,
+		&i.Reference,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.MatchedBy,
+		&i.MatchedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getExternalTransactionForUpdate = `-- name: GetExternalTransactionForUpdate :one
+SELECT id, import_id, account_id, line_number, booked_on, amount, reference, description, status, transfer_id, matched_by, matched_at, created_at FROM external_transactions WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetExternalTransactionForUpdate(ctx context.Context, id int64) (ExternalTransaction, error) {
+	row := q.db.QueryRowContext(ctx, getExternalTransactionForUpdate, id)
+	var i ExternalTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.ImportID,
+		&i.AccountID,
+		&i.LineNumber,
+		&i.BookedOn,
+		&i.Amount,
+		&i.Reference,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.MatchedBy,
+		&i.MatchedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMatchCandidates = `-- name: ListMatchCandidates :many
+SELECT t.id, t.from_account_id, t.to_account_id, t.amount, t.created_at, t.description, t.reference, t.metadata
+FROM transfers t
+WHERE
+    CASE
+        WHEN $1::bigint < 0 THEN t.from_account_id
+        ELSE t.to_account_id
+    END = $2
+    AND t.amount = abs($1::bigint)
+    AND t.created_at >= $3
+    AND t.created_at < $4
+    AND NOT EXISTS (
+        SELECT 1
+        FROM external_transactions x
+        WHERE
+            x.transfer_id = t.id
+    )
+ORDER BY t.created_at
+LIMIT $5
+`
+
+type ListMatchCandidatesParams struct {
+	Amount    int64     `json:"amount"`
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListMatchCandidates(ctx context.Context, arg ListMatchCandidatesParams) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, listMatchCandidates,
+		arg.Amount,
+		arg.AccountID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnmatchedExternalTransactions = `-- name: ListUnmatchedExternalTransactions :many
+SELECT id, import_id, account_id, line_number, booked_on, amount, reference, description, status, transfer_id, matched_by, matched_at, created_at
+FROM external_transactions
+WHERE
+    status = 'unmatched'
+    AND (
+        $1::bigint IS NULL
+        OR account_id = $1
+    )
+    AND (
+        $2::uuid IS NULL
+        OR import_id = $2
+    )
+ORDER BY booked_on, id
+LIMIT $4
+OFFSET
+    $3
+`
+
+type ListUnmatchedExternalTransactionsParams struct {
+	AccountID sql.NullInt64 `json:"account_id"`
+	ImportID  uuid.NullUUID `json:"import_id"`
+	RowOffset int32         `json:"row_offset"`
+	RowLimit  int32         `json:"row_limit"`
+}
+
+func (q *Queries) ListUnmatchedExternalTransactions(ctx context.Context, arg ListUnmatchedExternalTransactionsParams) ([]ExternalTransaction, error) {
+	rows, err := q.db.QueryContext(ctx, listUnmatchedExternalTransactions,
+		arg.AccountID,
+		arg.ImportID,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExternalTransaction{}
+	for rows.Next() {
+		var i ExternalTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.ImportID,
+			&i.AccountID,
+			&i.LineNumber,
+			&i.BookedOn,
+			&i.Amount,
+			&i.Reference,
+			&i.Description,
+			&i.Status,
+			&i.TransferID,
+			&i.MatchedBy,
+			&i.MatchedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const matchExternalTransaction = `-- name: MatchExternalTransaction :one
+UPDATE external_transactions
+SET
+    status = $1,
+    transfer_id = $2,
+    matched_by = $3,
+    matched_at = now()
+WHERE
+    id = $4
+RETURNING
+    id, import_id, account_id, line_number, booked_on, amount, reference, description, status, transfer_id, matched_by, matched_at, created_at
+`
+
+type MatchExternalTransactionParams struct {
+	Status     string         `json:"status"`
+	TransferID sql.NullInt64  `json:"transfer_id"`
+	MatchedBy  sql.NullString `json:"matched_by"`
+	ID         int64          `json:"id"`
+}
+
+func (q *Queries) MatchExternalTransaction(ctx context.Context, arg MatchExternalTransactionParams) (ExternalTransaction, error) {
+	row := q.db.QueryRowContext(ctx, matchExternalTransaction,
+		arg.Status,
+		arg.TransferID,
+		arg.MatchedBy,
+		arg.ID,
+	)
+	var i ExternalTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.ImportID,
+		&i.AccountID,
+		&i.LineNumber,
+		&i.BookedOn,
+		&i.Amount,
+		&i.Reference,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.MatchedBy,
+		&i.MatchedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
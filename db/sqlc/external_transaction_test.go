@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportExternalTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccountInCurrency(t, account1.Currency)
+
+	reference := util.RandomString(12)
+	transfer, _, _, err := recordTransfer(context.Background(), testQueries, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        42,
+		Reference:     reference,
+	})
+	require.NoError(t, err)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	result, err := store.ImportExternalTx(context.Background(), ImportExternalTxParams{
+		AccountID:  account2.ID,
+		Source:     "test",
+		ImportedBy: account2.Owner,
+		Lines: []ExternalTransactionLine{
+			{LineNumber: 2, BookedOn: today, Amount: 42, Reference: reference},
+			{LineNumber: 3, BookedOn: today, Amount: -42, Reference: reference},
+			{LineNumber: 4, BookedOn: today.AddDate(0, 0, -30), Amount: 42},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, account2.ID, result.Import.AccountID)
+	require.Equal(t, int32(3), result.Import.RowCount)
+	require.Equal(t, 1, result.Matched)
+	require.Len(t, result.Transactions, 3)
+
+	matched := result.Transactions[0]
+	require.Equal(t, ExternalAutoMatched, matched.Status)
+	require.Equal(t, transfer.ID, matched.TransferID.Int64)
+	require.False(t, matched.MatchedBy.Valid)
+	require.True(t, matched.MatchedAt.Valid)
+
+	// the debit has no transfer out of the account and the old line is outside the window
+	for _, transaction := range result.Transactions[1:] {
+		require.Equal(t, ExternalUnmatched, transaction.Status)
+		require.False(t, transaction.TransferID.Valid)
+	}
+
+	unmatched, err := store.ListUnmatchedExternalTransactions(context.Background(), ListUnmatchedExternalTransactionsParams{
+		ImportID:  uuid.NullUUID{UUID: result.Import.ID, Valid: true},
+		RowLimit:  10,
+		RowOffset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, unmatched, 2)
+	require.Equal(t, result.Transactions[2].ID, unmatched[0].ID)
+	require.Equal(t, result.Transactions[1].ID, unmatched[1].ID)
+}
+
+func TestImportExternalTxAmbiguous(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccountInCurrency(t, account1.Currency)
+
+	for i := 0; i < 2; i++ {
+		_, _, _, err := recordTransfer(context.Background(), testQueries, TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        7,
+		})
+		require.NoError(t, err)
+	}
+
+	result, err := store.ImportExternalTx(context.Background(), ImportExternalTxParams{
+		AccountID:  account2.ID,
+		Source:     "test",
+		ImportedBy: account2.Owner,
+		Lines: []ExternalTransactionLine{
+			{LineNumber: 1, BookedOn: time.Now().UTC().Truncate(24 * time.Hour), Amount: 7},
+		},
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Matched)
+	require.Equal(t, ExternalUnmatched, result.Transactions[0].Status)
+}
+
+func TestConfirmExternalMatchTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccountInCurrency(t, account1.Currency)
+
+	transfer, _, _, err := recordTransfer(context.Background(), testQueries, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        15,
+	})
+	require.NoError(t, err)
+
+	result, err := store.ImportExternalTx(context.Background(), ImportExternalTxParams{
+		AccountID:  account1.ID,
+		Source:     "test",
+		ImportedBy: account1.Owner,
+		Lines: []ExternalTransactionLine{
+			{LineNumber: 1, BookedOn: time.Now().UTC().AddDate(0, 0, -10), Amount: -15},
+		},
+	})
+	require.NoError(t, err)
+	transaction := result.Transactions[0]
+	require.Equal(t, ExternalUnmatched, transaction.Status)
+
+	// a transfer into the account doesn't match a debit
+	other, _, _, err := recordTransfer(context.Background(), testQueries, TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        15,
+	})
+	require.NoError(t, err)
+	_, err = store.ConfirmExternalMatchTx(context.Background(), ConfirmExternalMatchTxParams{
+		ExternalTransactionID: transaction.ID,
+		TransferID:            other.ID,
+		ConfirmedBy:           account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrTransferMismatch)
+
+	confirmed, err := store.ConfirmExternalMatchTx(context.Background(), ConfirmExternalMatchTxParams{
+		ExternalTransactionID: transaction.ID,
+		TransferID:            transfer.ID,
+		ConfirmedBy:           account1.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ExternalConfirmed, confirmed.Status)
+	require.Equal(t, transfer.ID, confirmed.TransferID.Int64)
+	require.Equal(t, account1.Owner, confirmed.MatchedBy.String)
+	require.WithinDuration(t, time.Now(), confirmed.MatchedAt.Time, time.Second)
+
+	_, err = store.ConfirmExternalMatchTx(context.Background(), ConfirmExternalMatchTxParams{
+		ExternalTransactionID: transaction.ID,
+		TransferID:            transfer.ID,
+		ConfirmedBy:           account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrAlreadyConfirmed)
+}
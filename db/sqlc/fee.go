@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+const (
+	// FeeRevenueOwner owns the internal accounts that transfer fees are paid into.
+	FeeRevenueOwner = "bank_fee_revenue"
+	// FeeDescription describes fee transfers on statements.
+	FeeDescription = "Transfer fee"
+)
+
+// TransferFee is the fee charged for a transfer and the schedule it was taken from.
+// ScheduleID is zero when no schedule applies and the transfer is free.
+type TransferFee struct {
+	ScheduleID int64 `json:"schedule_id"`
+	Amount     int64 `json:"amount"`
+}
+
+// CalculateFee returns the fee that schedule charges for a transfer of amount.
+func CalculateFee(schedule FeeSchedule, amount int64) int64 {
+	fee := schedule.FlatFee + util.PercentageOf(amount, schedule.PercentageBps)
+	if fee < schedule.MinFee {
+		fee = schedule.MinFee
+	}
+	if schedule.MaxFee.Valid && fee > schedule.MaxFee.Int64 {
+		fee = schedule.MaxFee.Int64
+	}
+	return fee
+}
+
+// GetTransferFee evaluates the fee schedule that applies to a transfer of amount out of account.
+func (q *Queries) GetTransferFee(ctx context.Context, account Account, amount int64) (TransferFee, error) {
+	schedule, err := q.GetFeeSchedule(ctx, GetFeeScheduleParams{
+		Currency:    account.Currency,
+		ProductCode: account.ProductCode,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TransferFee{}, nil
+		}
+		return TransferFee{}, err
+	}
+
+	return TransferFee{
+		ScheduleID: schedule.ID,
+		Amount:     CalculateFee(schedule, amount),
+	}, nil
+}
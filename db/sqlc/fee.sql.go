@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: fee.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createFeeSchedule = `-- name: CreateFeeSchedule :one
+INSERT INTO
+    fee_schedules (
+        currency,
+        product_code,
+        flat_fee,
+        percentage_bps,
+        min_fee,
+        max_fee
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    id, currency, product_code, flat_fee, percentage_bps, min_fee, max_fee, is_active, created_at
+`
+
+type CreateFeeScheduleParams struct {
+	Currency      string         `json:"currency"`
+	ProductCode   sql.NullString `json:"product_code"`
+	FlatFee       int64          `json:"flat_fee"`
+	PercentageBps int64          `json:"percentage_bps"`
+	MinFee        int64          `json:"min_fee"`
+	MaxFee        sql.NullInt64  `json:"max_fee"`
+}
+
+func (q *Queries) CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (FeeSchedule, error) {
+	row := q.db.QueryRowContext(ctx, createFeeSchedule,
+		arg.Currency,
+		arg.ProductCode,
+		arg.FlatFee,
+		arg.PercentageBps,
+		arg.MinFee,
+		arg.MaxFee,
+	)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.ProductCode,
+		&i.FlatFee,
+		&i.PercentageBps,
+		&i.MinFee,
+		&i.MaxFee,
+		&i.IsActive,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deactivateFeeSchedule = `-- name: DeactivateFeeSchedule :one
+UPDATE fee_schedules SET is_active = FALSE WHERE id = $1 RETURNING id, currency, product_code, flat_fee, percentage_bps, min_fee, max_fee, is_active, created_at
+`
+
+func (q *Queries) DeactivateFeeSchedule(ctx context.Context, id int64) (FeeSchedule, error) {
+	row := q.db.QueryRowContext(ctx, deactivateFeeSchedule, id)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.ProductCode,
+		&i.FlatFee,
+		&i.PercentageBps,
+		&i.MinFee,
+		&i.MaxFee,
+		&i.IsActive,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFeeSchedule = `-- name: GetFeeSchedule :one
+SELECT id, currency, product_code, flat_fee, percentage_bps, min_fee, max_fee, is_active, created_at
+FROM fee_schedules
+WHERE
+    currency = $1
+    AND (
+        product_code = $2::varchar
+        OR product_code IS NULL
+    )
+    AND is_active = TRUE
+ORDER BY product_code NULLS LAST, id DESC
+LIMIT 1
+`
+
+type GetFeeScheduleParams struct {
+	Currency    string `json:"currency"`
+	ProductCode string `json:"product_code"`
+}
+
+// picks the active schedule for the currency, preferring one specific to the product
+func (q *Queries) GetFeeSchedule(ctx context.Context, arg GetFeeScheduleParams) (FeeSchedule, error) {
+	row := q.db.QueryRowContext(ctx, getFeeSchedule, arg.Currency, arg.ProductCode)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.ProductCode,
+		&i.FlatFee,
+		&i.PercentageBps,
+		&i.MinFee,
+		&i.MaxFee,
+		&i.IsActive,
+		&i.CreatedAt,
+	)
+	return i, err
+}
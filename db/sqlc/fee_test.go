@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateFee(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule FeeSchedule
+		amount   int64
+		fee      int64
+	}{
+		{
+			name:     "Flat",
+			schedule: FeeSchedule{FlatFee: 25},
+			amount:   1_000,
+			fee:      25,
+		},
+		{
+			name:     "Percentage",
+			schedule: FeeSchedule{PercentageBps: 150},
+			amount:   10_000,
+			fee:      150,
+		},
+		{
+			name:     "FlatAndPercentage",
+			schedule: FeeSchedule{FlatFee: 10, PercentageBps: 100},
+			amount:   1_050,
+			fee:      21,
+		},
+		{
+			name:     "Min",
+			schedule: FeeSchedule{PercentageBps: 100, MinFee: 50},
+			amount:   1_000,
+			fee:      50,
+		},
+		{
+			name:     "Max",
+			schedule: FeeSchedule{PercentageBps: 100, MaxFee: sql.NullInt64{Int64: 500, Valid: true}},
+			amount:   1_000_000,
+			fee:      500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.fee, CalculateFee(tc.schedule, tc.amount))
+		})
+	}
+}
+
+func TestTransferTxWithFee(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomSavingsAccount(t)
+	account2 := createRandomAccount(t)
+
+	schedule, err := testQueries.CreateFeeSchedule(context.Background(), CreateFeeScheduleParams{
+		Currency:    account1.Currency,
+		ProductCode: sql.NullString{String: util.ProductSavings, Valid: true},
+		FlatFee:     3,
+	})
+	require.NoError(t, err)
+	defer testQueries.DeactivateFeeSchedule(context.Background(), schedule.ID)
+
+	amount := int64(10)
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, int64(3), result.Fee)
+	require.Equal(t, account1.ID, result.FeeTransfer.FromAccountID)
+	require.Equal(t, int64(3), result.FeeTransfer.Amount)
+	require.Equal(t, int64(-3), result.FeeEntry.Amount)
+	require.Equal(t, account1.Balance-amount-3, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+amount, result.ToAccount.Balance)
+
+	revenue, err := testQueries.GetAccount(context.Background(), result.FeeTransfer.ToAccountID)
+	require.NoError(t, err)
+	require.Equal(t, FeeRevenueOwner, revenue.Owner)
+	require.Equal(t, account1.Currency, revenue.Currency)
+
+	// alerts see the fee, which is booked after the transfer
+	event, err := testQueries.GetOutboxEvent(context.Background(), GetOutboxEventParams{
+		EventType:   EventTransferCompleted,
+		AggregateID: fmt.Sprint(result.Transfer.ID),
+	})
+	require.NoError(t, err)
+
+	var payload TransferCompletedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, amount, payload.Amount)
+	require.Equal(t, int64(3), payload.Fee)
+}
@@ -0,0 +1,362 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: interest.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createInterestAccrual = `-- name: CreateInterestAccrual :execrows
+INSERT INTO
+    interest_accruals (
+        account_id,
+        accrual_date,
+        balance,
+        annual_rate_bps,
+        amount_micros
+    )
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (account_id, accrual_date) DO NOTHING
+`
+
+type CreateInterestAccrualParams struct {
+	AccountID     int64     `json:"account_id"`
+	AccrualDate   time.Time `json:"accrual_date"`
+	Balance       int64     `json:"balance"`
+	AnnualRateBps int64     `json:"annual_rate_bps"`
+	AmountMicros  int64     `json:"amount_micros"`
+}
+
+func (q *Queries) CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createInterestAccrual,
+		arg.AccountID,
+		arg.AccrualDate,
+		arg.Balance,
+		arg.AnnualRateBps,
+		arg.AmountMicros,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createInterestPosting = `-- name: CreateInterestPosting :one
+INSERT INTO
+    interest_postings (
+        account_id,
+        period_end,
+        accrued_micros,
+        amount,
+        carry_micros,
+        transfer_id
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    id, account_id, period_end, accrued_micros, amount, carry_micros, transfer_id, created_at
+`
+
+type CreateInterestPostingParams struct {
+	AccountID     int64         `json:"account_id"`
+	PeriodEnd     time.Time     `json:"period_end"`
+	AccruedMicros int64         `json:"accrued_micros"`
+	Amount        int64         `json:"amount"`
+	CarryMicros   int64         `json:"carry_micros"`
+	TransferID    sql.NullInt64 `json:"transfer_id"`
+}
+
+func (q *Queries) CreateInterestPosting(ctx context.Context, arg CreateInterestPostingParams) (InterestPosting, error) {
+	row := q.db.QueryRowContext(ctx, createInterestPosting,
+		arg.AccountID,
+		arg.PeriodEnd,
+		arg.AccruedMicros,
+		arg.Amount,
+		arg.CarryMicros,
+		arg.TransferID,
+	)
+	var i InterestPosting
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodEnd,
+		&i.AccruedMicros,
+		&i.Amount,
+		&i.CarryMicros,
+		&i.TransferID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAccountProduct = `-- name: GetAccountProduct :one
+SELECT code, name, annual_rate_bps, created_at FROM account_products WHERE code = $1 LIMIT 1
+`
+
+func (q *Queries) GetAccountProduct(ctx context.Context, code string) (AccountProduct, error) {
+	row := q.db.QueryRowContext(ctx, getAccountProduct, code)
+	var i AccountProduct
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.AnnualRateBps,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLastInterestPosting = `-- name: GetLastInterestPosting :one
+SELECT id, account_id, period_end, accrued_micros, amount, carry_micros, transfer_id, created_at
+FROM interest_postings
+WHERE
+    account_id = $1
+ORDER BY period_end DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLastInterestPosting(ctx context.Context, accountID int64) (InterestPosting, error) {
+	row := q.db.QueryRowContext(ctx, getLastInterestPosting, accountID)
+	var i InterestPosting
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodEnd,
+		&i.AccruedMicros,
+		&i.Amount,
+		&i.CarryMicros,
+		&i.TransferID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountProducts = `-- name: ListAccountProducts :many
+SELECT code, name, annual_rate_bps, created_at FROM account_products ORDER BY code
+`
+
+func (q *Queries) ListAccountProducts(ctx context.Context) ([]AccountProduct, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountProducts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountProduct{}
+	for rows.Next() {
+		var i AccountProduct
+		if err := rows.Scan(
+			&i.Code,
+			&i.Name,
+			&i.AnnualRateBps,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccrualCandidates = `-- name: ListAccrualCandidates :many
+SELECT
+    a.id,
+    a.currency,
+    p.annual_rate_bps,
+    (
+        a.balance - COALESCE(
+            (
+                SELECT SUM(e.amount)
+                FROM entries e
+                WHERE
+                    e.account_id = a.id
+                    AND e.created_at >= $1
+            ),
+            0
+        )
+    )::bigint AS end_of_day_balance
+FROM accounts a
+    JOIN account_products p ON p.code = a.product_code
+WHERE
+    p.annual_rate_bps > 0
+    AND a.created_at < $1
+    AND a.id > $2
+ORDER BY a.id
+LIMIT $3
+`
+
+type ListAccrualCandidatesParams struct {
+	Cutoff   time.Time `json:"cutoff"`
+	AfterID  int64     `json:"after_id"`
+	RowLimit int32     `json:"row_limit"`
+}
+
+type ListAccrualCandidatesRow struct {
+	ID              int64  `json:"id"`
+	Currency        string `json:"currency"`
+	AnnualRateBps   int64  `json:"annual_rate_bps"`
+	EndOfDayBalance int64  `json:"end_of_day_balance"`
+}
+
+func (q *Queries) ListAccrualCandidates(ctx context.Context, arg ListAccrualCandidatesParams) ([]ListAccrualCandidatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccrualCandidates, arg.Cutoff, arg.AfterID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccrualCandidatesRow{}
+	for rows.Next() {
+		var i ListAccrualCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Currency,
+			&i.AnnualRateBps,
+			&i.EndOfDayBalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestAccruals = `-- name: ListInterestAccruals :many
+SELECT id, account_id, accrual_date, balance, annual_rate_bps, amount_micros, posting_id, created_at
+FROM interest_accruals
+WHERE
+    account_id = $1
+ORDER BY accrual_date
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListInterestAccrualsParams struct {
+	AccountID int64 `json:"account_id"`
+	Limit     int32 `json:"limit"`
+	Offset    int32 `json:"offset"`
+}
+
+func (q *Queries) ListInterestAccruals(ctx context.Context, arg ListInterestAccrualsParams) ([]InterestAccrual, error) {
+	rows, err := q.db.QueryContext(ctx, listInterestAccruals, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestAccrual{}
+	for rows.Next() {
+		var i InterestAccrual
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.AccrualDate,
+			&i.Balance,
+			&i.AnnualRateBps,
+			&i.AmountMicros,
+			&i.PostingID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnpostedAccrualAccounts = `-- name: ListUnpostedAccrualAccounts :many
+SELECT DISTINCT
+    account_id
+FROM interest_accruals
+WHERE
+    posting_id IS NULL
+    AND accrual_date <= $1
+ORDER BY account_id
+`
+
+func (q *Queries) ListUnpostedAccrualAccounts(ctx context.Context, periodEnd time.Time) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listUnpostedAccrualAccounts, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var account_id int64
+		if err := rows.Scan(&account_id); err != nil {
+			return nil, err
+		}
+		items = append(items, account_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAccrualsPosted = `-- name: MarkAccrualsPosted :execrows
+UPDATE interest_accruals
+SET
+    posting_id = $1
+WHERE
+    account_id = $2
+    AND posting_id IS NULL
+    AND accrual_date <= $3
+`
+
+type MarkAccrualsPostedParams struct {
+	PostingID sql.NullInt64 `json:"posting_id"`
+	AccountID int64         `json:"account_id"`
+	PeriodEnd time.Time     `json:"period_end"`
+}
+
+func (q *Queries) MarkAccrualsPosted(ctx context.Context, arg MarkAccrualsPostedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markAccrualsPosted, arg.PostingID, arg.AccountID, arg.PeriodEnd)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const sumUnpostedAccruals = `-- name: SumUnpostedAccruals :one
+SELECT COALESCE(SUM(amount_micros), 0)::bigint
+FROM interest_accruals
+WHERE
+    account_id = $1
+    AND posting_id IS NULL
+    AND accrual_date <= $2
+`
+
+type SumUnpostedAccrualsParams struct {
+	AccountID int64     `json:"account_id"`
+	PeriodEnd time.Time `json:"period_end"`
+}
+
+func (q *Queries) SumUnpostedAccruals(ctx context.Context, arg SumUnpostedAccrualsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sumUnpostedAccruals, arg.AccountID, arg.PeriodEnd)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
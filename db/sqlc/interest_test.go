@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSavingsAccount(t *testing.T) Account {
+	user := createRandomUser(t)
+
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:         user.Username,
+		Balance:       util.RandomMoney(),
+		Currency:      util.RandomCurrency(),
+		ProductCode:   util.ProductSavings,
+		AccountNumber: util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ProductSavings, account.ProductCode)
+
+	return account
+}
+
+func createTestInterestAccrual(t *testing.T, account Account, date time.Time, micros int64) {
+	n, err := testQueries.CreateInterestAccrual(context.Background(), CreateInterestAccrualParams{
+		AccountID:     account.ID,
+		AccrualDate:   date,
+		Balance:       account.Balance,
+		AnnualRateBps: 250,
+		AmountMicros:  micros,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+}
+
+func TestCreateInterestAccrual(t *testing.T) {
+	account := createRandomSavingsAccount(t)
+	date := time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	createTestInterestAccrual(t, account, date, 123)
+
+	// accruing the same day twice is a no-op
+	n, err := testQueries.CreateInterestAccrual(context.Background(), CreateInterestAccrualParams{
+		AccountID:     account.ID,
+		AccrualDate:   date,
+		Balance:       account.Balance,
+		AnnualRateBps: 250,
+		AmountMicros:  123,
+	})
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	accruals, err := testQueries.ListInterestAccruals(context.Background(), ListInterestAccrualsParams{
+		AccountID: account.ID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, accruals, 1)
+	require.Equal(t, int64(123), accruals[0].AmountMicros)
+	require.False(t, accruals[0].PostingID.Valid)
+}
+
+func TestPostInterestTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomSavingsAccount(t)
+
+	march30 := time.Date(2024, time.March, 30, 0, 0, 0, 0, time.UTC)
+	march31 := time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)
+	april1 := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	createTestInterestAccrual(t, account, march30, 700_000)
+	createTestInterestAccrual(t, account, march31, 700_000)
+	createTestInterestAccrual(t, account, april1, 700_000)
+
+	result, err := store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		PeriodEnd: march31,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1_400_000), result.Posting.AccruedMicros)
+	require.Equal(t, int64(1), result.Posting.Amount)
+	require.Equal(t, int64(400_000), result.Posting.CarryMicros)
+	require.True(t, result.Posting.TransferID.Valid)
+	require.Equal(t, account.ID, result.Transfer.ToAccount.ID)
+	require.Equal(t, account.Balance+1, result.Transfer.ToAccount.Balance)
+	require.Equal(t, InterestExpenseOwner, result.Transfer.FromAccount.Owner)
+
+	// the carry is added to the next period
+	result, err = store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		PeriodEnd: april1.AddDate(0, 1, -1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(700_000), result.Posting.AccruedMicros)
+	require.Equal(t, int64(1), result.Posting.Amount)
+	require.Equal(t, int64(100_000), result.Posting.CarryMicros)
+
+	updatedAccount, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance+2, updatedAccount.Balance)
+}
@@ -0,0 +1,442 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: loan.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const countUnpaidInstalments = `-- name: CountUnpaidInstalments :one
+SELECT count(*) FROM loan_instalments WHERE loan_id = $1 AND paid_at IS NULL
+`
+
+func (q *Queries) CountUnpaidInstalments(ctx context.Context, loanID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnpaidInstalments, loanID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createLoan = `-- name: CreateLoan :one
+INSERT INTO
+    loans (
+        account_id,
+        principal,
+        annual_rate_bps,
+        term_months,
+        currency,
+        disbursement_transfer_id,
+        created_by
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    id, account_id, principal, annual_rate_bps, term_months, currency, status, disbursement_transfer_id, created_by, created_at
+`
+
+type CreateLoanParams struct {
+	AccountID              int64  `json:"account_id"`
+	Principal              int64  `json:"principal"`
+	AnnualRateBps          int64  `json:"annual_rate_bps"`
+	TermMonths             int32  `json:"term_months"`
+	Currency               string `json:"currency"`
+	DisbursementTransferID int64  `json:"disbursement_transfer_id"`
+	CreatedBy              string `json:"created_by"`
+}
+
+func (q *Queries) CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error) {
+	row := q.db.QueryRowContext(ctx, createLoan,
+		arg.AccountID,
+		arg.Principal,
+		arg.AnnualRateBps,
+		arg.TermMonths,
+		arg.Currency,
+		arg.DisbursementTransferID,
+		arg.CreatedBy,
+	)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Principal,
+		&i.AnnualRateBps,
+		&i.TermMonths,
+		&i.Currency,
+		&i.Status,
+		&i.DisbursementTransferID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createLoanInstalment = `-- name: CreateLoanInstalment :one
+INSERT INTO
+    loan_instalments (
+        loan_id,
+        number,
+        due_date,
+        principal,
+        interest,
+        amount
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    loan_id, number, due_date, principal, interest, amount, paid_amount, paid_at
+`
+
+type CreateLoanInstalmentParams struct {
+	LoanID    int64     `json:"loan_id"`
+	Number    int32     `json:"number"`
+	DueDate   time.Time `json:"due_date"`
+	Principal int64     `json:"principal"`
+	Interest  int64     `json:"interest"`
+	Amount    int64     `json:"amount"`
+}
+
+func (q *Queries) CreateLoanInstalment(ctx context.Context, arg CreateLoanInstalmentParams) (LoanInstalment, error) {
+	row := q.db.QueryRowContext(ctx, createLoanInstalment,
+		arg.LoanID,
+		arg.Number,
+		arg.DueDate,
+		arg.Principal,
+		arg.Interest,
+		arg.Amount,
+	)
+	var i LoanInstalment
+	err := row.Scan(
+		&i.LoanID,
+		&i.Number,
+		&i.DueDate,
+		&i.Principal,
+		&i.Interest,
+		&i.Amount,
+		&i.PaidAmount,
+		&i.PaidAt,
+	)
+	return i, err
+}
+
+const createLoanRepayment = `-- name: CreateLoanRepayment :one
+INSERT INTO
+    loan_repayments (
+        loan_id,
+        instalment_number,
+        transfer_id,
+        amount
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    id, loan_id, instalment_number, transfer_id, amount, created_at
+`
+
+type CreateLoanRepaymentParams struct {
+	LoanID           int64 `json:"loan_id"`
+	InstalmentNumber int32 `json:"instalment_number"`
+	TransferID       int64 `json:"transfer_id"`
+	Amount           int64 `json:"amount"`
+}
+
+func (q *Queries) CreateLoanRepayment(ctx context.Context, arg CreateLoanRepaymentParams) (LoanRepayment, error) {
+	row := q.db.QueryRowContext(ctx, createLoanRepayment,
+		arg.LoanID,
+		arg.InstalmentNumber,
+		arg.TransferID,
+		arg.Amount,
+	)
+	var i LoanRepayment
+	err := row.Scan(
+		&i.ID,
+		&i.LoanID,
+		&i.InstalmentNumber,
+		&i.TransferID,
+		&i.Amount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLoan = `-- name: GetLoan :one
+SELECT id, account_id, principal, annual_rate_bps, term_months, currency, status, disbursement_transfer_id, created_by, created_at FROM loans WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetLoan(ctx context.Context, id int64) (Loan, error) {
+	row := q.db.QueryRowContext(ctx, getLoan, id)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Principal,
+		&i.AnnualRateBps,
+		&i.TermMonths,
+		&i.Currency,
+		&i.Status,
+		&i.DisbursementTransferID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLoanForUpdate = `-- name: GetLoanForUpdate :one
+SELECT id, account_id, principal, annual_rate_bps, term_months, currency, status, disbursement_transfer_id, created_by, created_at FROM loans WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetLoanForUpdate(ctx context.Context, id int64) (Loan, error) {
+	row := q.db.QueryRowContext(ctx, getLoanForUpdate, id)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Principal,
+		&i.AnnualRateBps,
+		&i.TermMonths,
+		&i.Currency,
+		&i.Status,
+		&i.DisbursementTransferID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listDueInstalments = `-- name: ListDueInstalments :many
+SELECT loan_id, number, due_date, principal, interest, amount, paid_amount, paid_at
+FROM loan_instalments
+WHERE
+    loan_id = $1
+    AND due_date <= $2
+    AND paid_at IS NULL
+ORDER BY number
+`
+
+type ListDueInstalmentsParams struct {
+	LoanID int64     `json:"loan_id"`
+	AsOf   time.Time `json:"as_of"`
+}
+
+func (q *Queries) ListDueInstalments(ctx context.Context, arg ListDueInstalmentsParams) ([]LoanInstalment, error) {
+	rows, err := q.db.QueryContext(ctx, listDueInstalments, arg.LoanID, arg.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LoanInstalment{}
+	for rows.Next() {
+		var i LoanInstalment
+		if err := rows.Scan(
+			&i.LoanID,
+			&i.Number,
+			&i.DueDate,
+			&i.Principal,
+			&i.Interest,
+			&i.Amount,
+			&i.PaidAmount,
+			&i.PaidAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueLoans = `-- name: ListDueLoans :many
+SELECT DISTINCT
+    l.id
+FROM loans l
+    JOIN loan_instalments i ON i.loan_id = l.id
+WHERE
+    l.status = 'active'
+    AND i.due_date <= $1
+    AND i.paid_at IS NULL
+ORDER BY l.id
+`
+
+func (q *Queries) ListDueLoans(ctx context.Context, asOf time.Time) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listDueLoans, asOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLoanInstalments = `-- name: ListLoanInstalments :many
+SELECT loan_id, number, due_date, principal, interest, amount, paid_amount, paid_at FROM loan_instalments WHERE loan_id = $1 ORDER BY number
+`
+
+func (q *Queries) ListLoanInstalments(ctx context.Context, loanID int64) ([]LoanInstalment, error) {
+	rows, err := q.db.QueryContext(ctx, listLoanInstalments, loanID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LoanInstalment{}
+	for rows.Next() {
+		var i LoanInstalment
+		if err := rows.Scan(
+			&i.LoanID,
+			&i.Number,
+			&i.DueDate,
+			&i.Principal,
+			&i.Interest,
+			&i.Amount,
+			&i.PaidAmount,
+			&i.PaidAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLoans = `-- name: ListLoans :many
+SELECT id, account_id, principal, annual_rate_bps, term_months, currency, status, disbursement_transfer_id, created_by, created_at
+FROM loans
+WHERE
+    account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListLoansParams struct {
+	AccountID int64 `json:"account_id"`
+	Limit     int32 `json:"limit"`
+	Offset    int32 `json:"offset"`
+}
+
+func (q *Queries) ListLoans(ctx context.Context, arg ListLoansParams) ([]Loan, error) {
+	rows, err := q.db.QueryContext(ctx, listLoans, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Loan{}
+	for rows.Next() {
+		var i Loan
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Principal,
+			&i.AnnualRateBps,
+			&i.TermMonths,
+			&i.Currency,
+			&i.Status,
+			&i.DisbursementTransferID,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const payLoanInstalment = `-- name: PayLoanInstalment :one
+UPDATE loan_instalments
+SET
+    paid_amount = paid_amount + $1,
+    paid_at = CASE
+        WHEN paid_amount + $1 >= amount THEN now()
+    END
+WHERE
+    loan_id = $2
+    AND number = $3
+RETURNING
+    loan_id, number, due_date, principal, interest, amount, paid_amount, paid_at
+`
+
+type PayLoanInstalmentParams struct {
+	Amount int64 `json:"amount"`
+	LoanID int64 `json:"loan_id"`
+	Number int32 `json:"number"`
+}
+
+func (q *Queries) PayLoanInstalment(ctx context.Context, arg PayLoanInstalmentParams) (LoanInstalment, error) {
+	row := q.db.QueryRowContext(ctx, payLoanInstalment, arg.Amount, arg.LoanID, arg.Number)
+	var i LoanInstalment
+	err := row.Scan(
+		&i.LoanID,
+		&i.Number,
+		&i.DueDate,
+		&i.Principal,
+		&i.Interest,
+		&i.Amount,
+		&i.PaidAmount,
+		&i.PaidAt,
+	)
+	return i, err
+}
+
+const updateLoanStatus = `-- name: UpdateLoanStatus :one
+UPDATE loans
+SET
+    status = $1
+WHERE
+    id = $2
+RETURNING
+    id, account_id, principal, annual_rate_bps, term_months, currency, status, disbursement_transfer_id, created_by, created_at
+`
+
+type UpdateLoanStatusParams struct {
+	Status string `json:"status"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) UpdateLoanStatus(ctx context.Context, arg UpdateLoanStatusParams) (Loan, error) {
+	row := q.db.QueryRowContext(ctx, updateLoanStatus, arg.Status, arg.ID)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Principal,
+		&i.AnnualRateBps,
+		&i.TermMonths,
+		&i.Currency,
+		&i.Status,
+		&i.DisbursementTransferID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
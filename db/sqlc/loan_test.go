@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLoanTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+	disbursedOn := time.Now().UTC().Truncate(24 * time.Hour)
+
+	result, err := store.CreateLoanTx(context.Background(), CreateLoanTxParams{
+		AccountID:     account.ID,
+		Principal:     1200,
+		AnnualRateBps: 1200,
+		TermMonths:    3,
+		CreatedBy:     account.Owner,
+		DisbursedOn:   disbursedOn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, LoanActive, result.Loan.Status)
+	require.Equal(t, account.Currency, result.Loan.Currency)
+	require.Equal(t, account.Balance+1200, result.Transfer.ToAccount.Balance)
+	require.Equal(t, LoanFundingOwner, result.Transfer.FromAccount.Owner)
+	require.Len(t, result.Instalments, 3)
+
+	instalments, err := testQueries.ListLoanInstalments(context.Background(), result.Loan.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Instalments, instalments)
+
+	var principal int64
+	for _, instalment := range instalments {
+		principal += instalment.Principal
+	}
+	require.Equal(t, int64(1200), principal)
+}
+
+func TestCreateLoanTxChargesNoFee(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+
+	// a schedule without a product applies to every account of the currency,
+	// but must not charge the loan funding account for the payout
+	schedule, err := testQueries.CreateFeeSchedule(context.Background(), CreateFeeScheduleParams{
+		Currency: account.Currency,
+		FlatFee:  5,
+	})
+	require.NoError(t, err)
+	defer testQueries.DeactivateFeeSchedule(context.Background(), schedule.ID)
+
+	funding, err := testQueries.GetInternalAccount(context.Background(), GetInternalAccountParams{
+		Owner:    LoanFundingOwner,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+
+	result, err := store.CreateLoanTx(context.Background(), CreateLoanTxParams{
+		AccountID:     account.ID,
+		Principal:     100,
+		AnnualRateBps: 0,
+		TermMonths:    1,
+		CreatedBy:     account.Owner,
+		DisbursedOn:   time.Now().UTC().Truncate(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.Zero(t, result.Transfer.Fee)
+	require.Zero(t, result.Transfer.FeeTransfer.ID)
+	require.Equal(t, funding.ID, result.Transfer.FromAccount.ID)
+	require.Equal(t, funding.Balance-100, result.Transfer.FromAccount.Balance)
+	require.Equal(t, account.Balance+100, result.Transfer.ToAccount.Balance)
+}
+
+func TestCollectLoanRepaymentTx(t *testing.T) {
+	store := NewStore(testDB)
+	account, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      createRandomAccount(t).ID,
+		Balance: 0,
+	})
+	require.NoError(t, err)
+
+	disbursedOn := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, -3, 0)
+	loan, err := store.CreateLoanTx(context.Background(), CreateLoanTxParams{
+		AccountID:     account.ID,
+		Principal:     300,
+		AnnualRateBps: 0,
+		TermMonths:    3,
+		CreatedBy:     account.Owner,
+		DisbursedOn:   disbursedOn,
+	})
+	require.NoError(t, err)
+
+	// spend most of the loan so the first collection only covers part of it
+	_, err = testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      account.ID,
+		Balance: 150,
+	})
+	require.NoError(t, err)
+
+	asOf := util.AddMonths(disbursedOn, 2)
+	result, err := store.CollectLoanRepaymentTx(context.Background(), CollectLoanRepaymentTxParams{
+		LoanID: loan.Loan.ID,
+		AsOf:   asOf,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(150), result.Collected)
+	require.Len(t, result.Repayments, 2)
+	require.Equal(t, LoanActive, result.Loan.Status)
+
+	instalments, err := testQueries.ListLoanInstalments(context.Background(), loan.Loan.ID)
+	require.NoError(t, err)
+	require.True(t, instalments[0].PaidAt.Valid)
+	require.False(t, instalments[1].PaidAt.Valid)
+	require.Equal(t, int64(50), instalments[1].PaidAmount)
+	require.Equal(t, int64(150), OutstandingPrincipal(instalments))
+
+	arrears := GetLoanArrears(instalments, asOf.AddDate(0, 0, 1))
+	require.Equal(t, 1, arrears.Instalments)
+	require.Equal(t, int64(50), arrears.Amount)
+	require.Equal(t, asOf.AddDate(0, 0, 1), arrears.Since)
+
+	_, err = testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      account.ID,
+		Balance: 1000,
+	})
+	require.NoError(t, err)
+
+	result, err = store.CollectLoanRepaymentTx(context.Background(), CollectLoanRepaymentTxParams{
+		LoanID: loan.Loan.ID,
+		AsOf:   util.AddMonths(disbursedOn, 3),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(150), result.Collected)
+	require.Equal(t, LoanPaidOff, result.Loan.Status)
+
+	account, err = testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(850), account.Balance)
+}
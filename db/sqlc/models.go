@@ -5,16 +5,83 @@
 package db
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Account struct {
-	ID        int64     `json:"id"`
-	Owner     string    `json:"owner"`
+	ID          int64     `json:"id"`
+	Owner       string    `json:"owner"`
+	Balance     int64     `json:"balance"`
+	Currency    string    `json:"currency"`
+	CreatedAt   time.Time `json:"created_at"`
+	ProductCode string    `json:"product_code"`
+	// IBAN-style number with mod-97 check digits given to customers instead of the id
+	AccountNumber string `json:"account_number"`
+}
+
+type AccountMember struct {
+	AccountID int64  `json:"account_id"`
+	Username  string `json:"username"`
+	// owner, co_owner or viewer
+	Role      string         `json:"role"`
+	AddedBy   sql.NullString `json:"added_by"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+type AccountProduct struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	// annual interest rate in basis points
+	AnnualRateBps int64     `json:"annual_rate_bps"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type Beneficiary struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	Nickname  string `json:"nickname"`
+	AccountID int64  `json:"account_id"`
+	Currency  string `json:"currency"`
+	// the holder name given when the payee was added matched the account
+	IsVerified bool      `json:"is_verified"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type Card struct {
+	ID         int64  `json:"id"`
+	AccountID  int64  `json:"account_id"`
+	Cardholder string `json:"cardholder"`
+	// HMAC of the card number, which is never stored
+	Token        string `json:"token"`
+	MaskedNumber string `json:"masked_number"`
+	ExpiryMonth  int32  `json:"expiry_month"`
+	ExpiryYear   int32  `json:"expiry_year"`
+	HashedCvv    string `json:"hashed_cvv"`
+	// active, frozen or cancelled
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CardAuthorization struct {
+	ID           int64     `json:"id"`
+	CardID       int64     `json:"card_id"`
+	TransferID   int64     `json:"transfer_id"`
+	Amount       int64     `json:"amount"`
+	Currency     string    `json:"currency"`
+	MerchantName string    `json:"merchant_name"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type DailyBalance struct {
+	AccountID   int64     `json:"account_id"`
+	BalanceDate time.Time `json:"balance_date"`
+	// end of balance_date; the balance excludes entries created at or after it
+	AsOf      time.Time `json:"as_of"`
 	Balance   int64     `json:"balance"`
-	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -22,8 +89,198 @@ type Entry struct {
 	ID        int64 `json:"id"`
 	AccountID int64 `json:"account_id"`
 	// can be negative or positive
-	Amount    int64     `json:"amount"`
+	Amount      int64         `json:"amount"`
+	CreatedAt   time.Time     `json:"created_at"`
+	TransferID  sql.NullInt64 `json:"transfer_id"`
+	Description string        `json:"description"`
+	Reference   string        `json:"reference"`
+	// display name of the other side of the transfer
+	CounterpartyName string `json:"counterparty_name"`
+}
+
+type ExternalImport struct {
+	ID         uuid.UUID `json:"id"`
+	AccountID  int64     `json:"account_id"`
+	Source     string    `json:"source"`
+	ImportedBy string    `json:"imported_by"`
+	RowCount   int32     `json:"row_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ExternalTransaction struct {
+	ID         int64     `json:"id"`
+	ImportID   uuid.UUID `json:"import_id"`
+	AccountID  int64     `json:"account_id"`
+	LineNumber int32     `json:"line_number"`
+	BookedOn   time.Time `json:"booked_on"`
+	// signed from the view of the account: negative leaves it
+	Amount      int64  `json:"amount"`
+	Reference   string `json:"reference"`
+	Description string `json:"description"`
+	// unmatched, auto_matched or confirmed
+	Status     string         `json:"status"`
+	TransferID sql.NullInt64  `json:"transfer_id"`
+	MatchedBy  sql.NullString `json:"matched_by"`
+	MatchedAt  sql.NullTime   `json:"matched_at"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type FeeSchedule struct {
+	ID       int64  `json:"id"`
+	Currency string `json:"currency"`
+	// null applies to every product
+	ProductCode   sql.NullString `json:"product_code"`
+	FlatFee       int64          `json:"flat_fee"`
+	PercentageBps int64          `json:"percentage_bps"`
+	MinFee        int64          `json:"min_fee"`
+	// null means uncapped
+	MaxFee    sql.NullInt64 `json:"max_fee"`
+	IsActive  bool          `json:"is_active"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type InterestAccrual struct {
+	ID            int64     `json:"id"`
+	AccountID     int64     `json:"account_id"`
+	AccrualDate   time.Time `json:"accrual_date"`
+	Balance       int64     `json:"balance"`
+	AnnualRateBps int64     `json:"annual_rate_bps"`
+	// interest in millionths of the minor currency unit
+	AmountMicros int64         `json:"amount_micros"`
+	PostingID    sql.NullInt64 `json:"posting_id"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+type InterestPosting struct {
+	ID            int64     `json:"id"`
+	AccountID     int64     `json:"account_id"`
+	PeriodEnd     time.Time `json:"period_end"`
+	AccruedMicros int64     `json:"accrued_micros"`
+	Amount        int64     `json:"amount"`
+	// sub-unit remainder carried into the next posting
+	CarryMicros int64         `json:"carry_micros"`
+	TransferID  sql.NullInt64 `json:"transfer_id"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+type Loan struct {
+	ID int64 `json:"id"`
+	// the account the loan is paid into and repaid from
+	AccountID     int64  `json:"account_id"`
+	Principal     int64  `json:"principal"`
+	AnnualRateBps int64  `json:"annual_rate_bps"`
+	TermMonths    int32  `json:"term_months"`
+	Currency      string `json:"currency"`
+	// active or paid_off
+	Status                 string    `json:"status"`
+	DisbursementTransferID int64     `json:"disbursement_transfer_id"`
+	CreatedBy              string    `json:"created_by"`
+	CreatedAt              time.Time `json:"created_at"`
+}
+
+type LoanInstalment struct {
+	LoanID     int64     `json:"loan_id"`
+	Number     int32     `json:"number"`
+	DueDate    time.Time `json:"due_date"`
+	Principal  int64     `json:"principal"`
+	Interest   int64     `json:"interest"`
+	Amount     int64     `json:"amount"`
+	PaidAmount int64     `json:"paid_amount"`
+	// set once paid_amount reaches amount; an unpaid instalment past due_date is in arrears
+	PaidAt sql.NullTime `json:"paid_at"`
+}
+
+type LoanRepayment struct {
+	ID               int64     `json:"id"`
+	LoanID           int64     `json:"loan_id"`
+	InstalmentNumber int32     `json:"instalment_number"`
+	TransferID       int64     `json:"transfer_id"`
+	Amount           int64     `json:"amount"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type NotificationPreference struct {
+	Username string `json:"username"`
+	// incoming_transfer, low_balance, large_debit or new_login
+	EventType string `json:"event_type"`
+	// where the alert is sent; empty turns it off
+	Channels []string `json:"channels"`
+	// low_balance: balance to fall below, large_debit: smallest debit, in the minor unit of the account
+	Threshold int64     `json:"threshold"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type OutboxEvent struct {
+	ID int64 `json:"id"`
+	// sent along with the event so consumers can drop duplicates
+	EventID   uuid.UUID `json:"event_id"`
+	EventType string    `json:"event_type"`
+	// the user, transfer, ... the event is about
+	AggregateID string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	Attempts    int32           `json:"attempts"`
+	LastError   string          `json:"last_error"`
+	CreatedAt   time.Time       `json:"created_at"`
+	// null until the relay has handed the event to the queue
+	PublishedAt sql.NullTime `json:"published_at"`
+	// an event that failed to publish waits until then before it is retried
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+}
+
+type PaymentAlias struct {
+	// phone or handle; usernames and verified emails resolve without a row
+	AliasType string `json:"alias_type"`
+	// normalized: E.164 phone number or lowercase handle
+	Value     string    `json:"value"`
+	Username  string    `json:"username"`
 	CreatedAt time.Time `json:"created_at"`
+	// sent to the phone number; cleared once verified
+	SecretCode     string    `json:"secret_code"`
+	VerifyAttempts int32     `json:"verify_attempts"`
+	CodeExpiredAt  time.Time `json:"code_expired_at"`
+	// null until the user proves the alias is theirs; only verified aliases resolve
+	VerifiedAt sql.NullTime `json:"verified_at"`
+}
+
+type PaymentRequest struct {
+	ID             uuid.UUID `json:"id"`
+	Requester      string    `json:"requester"`
+	PayeeAccountID int64     `json:"payee_account_id"`
+	Payer          string    `json:"payer"`
+	// must be positive
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Message  string `json:"message"`
+	// pending, accepted or declined; a pending request past expires_at is expired
+	Status      string        `json:"status"`
+	TransferID  sql.NullInt64 `json:"transfer_id"`
+	ExpiresAt   time.Time     `json:"expires_at"`
+	RespondedAt sql.NullTime  `json:"responded_at"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+type Pot struct {
+	// the account holding the money of the pot
+	AccountID       int64  `json:"account_id"`
+	ParentAccountID int64  `json:"parent_account_id"`
+	Name            string `json:"name"`
+	// zero means no target
+	TargetAmount int64        `json:"target_amount"`
+	TargetDate   sql.NullTime `json:"target_date"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+type ReconciliationFinding struct {
+	ID    int64     `json:"id"`
+	RunID uuid.UUID `json:"run_id"`
+	// balance_mismatch or unbalanced_transfer
+	Kind       string        `json:"kind"`
+	AccountID  sql.NullInt64 `json:"account_id"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	Expected   int64         `json:"expected"`
+	Actual     int64         `json:"actual"`
+	Details    string        `json:"details"`
+	CreatedAt  time.Time     `json:"created_at"`
 }
 
 type Session struct {
@@ -37,13 +294,76 @@ type Session struct {
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+type Statement struct {
+	ID          uuid.UUID `json:"id"`
+	AccountID   int64     `json:"account_id"`
+	Username    string    `json:"username"`
+	Format      string    `json:"format"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// pending, ready or failed
+	Status        string       `json:"status"`
+	Content       []byte       `json:"content"`
+	FailureReason string       `json:"failure_reason"`
+	CreatedAt     time.Time    `json:"created_at"`
+	CompletedAt   sql.NullTime `json:"completed_at"`
+}
+
+type TermDeposit struct {
+	// the account holding the money of the deposit
+	AccountID int64 `json:"account_id"`
+	// the account the deposit was funded from and is paid out to
+	SourceAccountID int64 `json:"source_account_id"`
+	TermMonths      int32 `json:"term_months"`
+	// the rate of the term when the deposit was opened or last rolled over
+	AnnualRateBps        int64     `json:"annual_rate_bps"`
+	EarlyBreakPenaltyBps int64     `json:"early_break_penalty_bps"`
+	Principal            int64     `json:"principal"`
+	StartsOn             time.Time `json:"starts_on"`
+	MaturesOn            time.Time `json:"matures_on"`
+	// payout or rollover
+	OnMaturity string `json:"on_maturity"`
+	// active, paid_out or broken
+	Status    string       `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+	ClosedAt  sql.NullTime `json:"closed_at"`
+}
+
+type TermDepositRate struct {
+	TermMonths    int32 `json:"term_months"`
+	AnnualRateBps int64 `json:"annual_rate_bps"`
+	// taken off the annual rate when a deposit is broken before maturity
+	EarlyBreakPenaltyBps int64     `json:"early_break_penalty_bps"`
+	CreatedAt            time.Time `json:"created_at"`
+}
+
 type Transfer struct {
 	ID            int64 `json:"id"`
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	// must be positive
-	Amount    int64     `json:"amount"`
-	CreatedAt time.Time `json:"created_at"`
+	Amount      int64     `json:"amount"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	// end-to-end reference supplied by the payer
+	Reference string          `json:"reference"`
+	Metadata  json.RawMessage `json:"metadata"`
+}
+
+type TransferBatch struct {
+	ID            uuid.UUID `json:"id"`
+	FromAccountID int64     `json:"from_account_id"`
+	TotalAmount   int64     `json:"total_amount"`
+	TotalFee      int64     `json:"total_fee"`
+	LegCount      int32     `json:"leg_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type TransferBatchLeg struct {
+	BatchID    uuid.UUID `json:"batch_id"`
+	LegIndex   int32     `json:"leg_index"`
+	TransferID int64     `json:"transfer_id"`
+	Fee        int64     `json:"fee"`
 }
 
 type User struct {
@@ -54,6 +374,9 @@ type User struct {
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
+	Role              string    `json:"role"`
+	// language emails and alerts are written in
+	Language string `json:"language"`
 }
 
 type VerifyEmail struct {
@@ -65,3 +388,32 @@ type VerifyEmail struct {
 	CreatedAt  time.Time `json:"created_at"`
 	ExpiredAt  time.Time `json:"expired_at"`
 }
+
+type WebhookDelivery struct {
+	ID         int64 `json:"id"`
+	EndpointID int64 `json:"endpoint_id"`
+	// the outbox event delivered; one delivery per endpoint and event
+	EventID   uuid.UUID       `json:"event_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	// pending, succeeded or failed
+	Status   string `json:"status"`
+	Attempts int32  `json:"attempts"`
+	// HTTP status of the last attempt, 0 if no response was received
+	ResponseStatus int32        `json:"response_status"`
+	LastError      string       `json:"last_error"`
+	CreatedAt      time.Time    `json:"created_at"`
+	DeliveredAt    sql.NullTime `json:"delivered_at"`
+}
+
+type WebhookEndpoint struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Url      string `json:"url"`
+	// deliveries are signed with HMAC-SHA256 under this secret
+	Secret string `json:"secret"`
+	// the outbox event types delivered to the endpoint
+	EventTypes []string  `json:"event_types"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,24 @@
+package db
+
+// Events users can be alerted of
+const (
+	AlertIncomingTransfer = "incoming_transfer"
+	AlertLowBalance       = "low_balance"
+	AlertLargeDebit       = "large_debit"
+	AlertNewLogin         = "new_login"
+)
+
+// AlertTypes are the events users can set notification preferences for.
+var AlertTypes = []string{AlertIncomingTransfer, AlertLowBalance, AlertLargeDebit, AlertNewLogin}
+
+// NotificationChannelEmail sends alerts to the email address of the user.
+const NotificationChannelEmail = "email"
+
+// NotificationChannels are the channels alerts can be sent through.
+var NotificationChannels = []string{NotificationChannelEmail}
+
+// AlertHasThreshold reports whether alerts of the given type only fire past
+// the threshold of the preference.
+func AlertHasThreshold(alertType string) bool {
+	return alertType == AlertLowBalance || alertType == AlertLargeDebit
+}
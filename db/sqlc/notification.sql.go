@@ -0,0 +1,181 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: notification.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+const getBalanceAfterTransfer = `-- name: GetBalanceAfterTransfer :one
+SELECT (a.balance - COALESCE(SUM(later.amount), 0))::bigint AS balance
+FROM entries e
+    JOIN accounts a ON a.id = e.account_id
+    LEFT JOIN entries later ON later.account_id = e.account_id AND later.id > e.id
+WHERE
+    e.transfer_id = $1::bigint
+    AND e.account_id = $2
+GROUP BY a.balance, e.id
+`
+
+type GetBalanceAfterTransferParams struct {
+	TransferID int64 `json:"transfer_id"`
+	AccountID  int64 `json:"account_id"`
+}
+
+func (q *Queries) GetBalanceAfterTransfer(ctx context.Context, arg GetBalanceAfterTransferParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getBalanceAfterTransfer, arg.TransferID, arg.AccountID)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const getNotificationPreference = `-- name: GetNotificationPreference :one
+SELECT username, event_type, channels, threshold, updated_at FROM notification_preferences
+WHERE username = $1 AND event_type = $2
+LIMIT 1
+`
+
+type GetNotificationPreferenceParams struct {
+	Username  string `json:"username"`
+	EventType string `json:"event_type"`
+}
+
+func (q *Queries) GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) (NotificationPreference, error) {
+	row := q.db.QueryRowContext(ctx, getNotificationPreference, arg.Username, arg.EventType)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.Username,
+		&i.EventType,
+		pq.Array(&i.Channels),
+		&i.Threshold,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listAccountAlertSubscribers = `-- name: ListAccountAlertSubscribers :many
+SELECT p.username, p.event_type, p.channels, p.threshold, p.updated_at
+FROM notification_preferences p
+    JOIN account_members m ON m.username = p.username
+WHERE
+    m.account_id = $1
+    AND p.event_type = $2
+    AND cardinality(p.channels) > 0
+ORDER BY p.username
+`
+
+type ListAccountAlertSubscribersParams struct {
+	AccountID int64  `json:"account_id"`
+	EventType string `json:"event_type"`
+}
+
+func (q *Queries) ListAccountAlertSubscribers(ctx context.Context, arg ListAccountAlertSubscribersParams) ([]NotificationPreference, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountAlertSubscribers, arg.AccountID, arg.EventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NotificationPreference{}
+	for rows.Next() {
+		var i NotificationPreference
+		if err := rows.Scan(
+			&i.Username,
+			&i.EventType,
+			pq.Array(&i.Channels),
+			&i.Threshold,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationPreferences = `-- name: ListNotificationPreferences :many
+SELECT username, event_type, channels, threshold, updated_at FROM notification_preferences
+WHERE username = $1
+ORDER BY event_type
+`
+
+func (q *Queries) ListNotificationPreferences(ctx context.Context, username string) ([]NotificationPreference, error) {
+	rows, err := q.db.QueryContext(ctx, listNotificationPreferences, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NotificationPreference{}
+	for rows.Next() {
+		var i NotificationPreference
+		if err := rows.Scan(
+			&i.Username,
+			&i.EventType,
+			pq.Array(&i.Channels),
+			&i.Threshold,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertNotificationPreference = `-- name: UpsertNotificationPreference :one
+INSERT INTO notification_preferences (
+    username,
+    event_type,
+    channels,
+    threshold
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (username, event_type) DO UPDATE
+SET
+    channels = EXCLUDED.channels,
+    threshold = EXCLUDED.threshold,
+    updated_at = now()
+RETURNING username, event_type, channels, threshold, updated_at
+`
+
+type UpsertNotificationPreferenceParams struct {
+	Username  string   `json:"username"`
+	EventType string   `json:"event_type"`
+	Channels  []string `json:"channels"`
+	Threshold int64    `json:"threshold"`
+}
+
+func (q *Queries) UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) (NotificationPreference, error) {
+	row := q.db.QueryRowContext(ctx, upsertNotificationPreference,
+		arg.Username,
+		arg.EventType,
+		pq.Array(arg.Channels),
+		arg.Threshold,
+	)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.Username,
+		&i.EventType,
+		pq.Array(&i.Channels),
+		&i.Threshold,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationPreferences(t *testing.T) {
+	account := createRandomAccount(t)
+
+	preference, err := testQueries.UpsertNotificationPreference(context.Background(), UpsertNotificationPreferenceParams{
+		Username:  account.Owner,
+		EventType: AlertLowBalance,
+		Channels:  []string{NotificationChannelEmail},
+		Threshold: 100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{NotificationChannelEmail}, preference.Channels)
+
+	subscribers, err := testQueries.ListAccountAlertSubscribers(context.Background(), ListAccountAlertSubscribersParams{
+		AccountID: account.ID,
+		EventType: AlertLowBalance,
+	})
+	require.NoError(t, err)
+	require.Len(t, subscribers, 1)
+	require.Equal(t, int64(100), subscribers[0].Threshold)
+
+	// turning the alert off keeps the preference but drops the subscriber
+	_, err = testQueries.UpsertNotificationPreference(context.Background(), UpsertNotificationPreferenceParams{
+		Username:  account.Owner,
+		EventType: AlertLowBalance,
+		Channels:  []string{},
+		Threshold: 100,
+	})
+	require.NoError(t, err)
+
+	subscribers, err = testQueries.ListAccountAlertSubscribers(context.Background(), ListAccountAlertSubscribersParams{
+		AccountID: account.ID,
+		EventType: AlertLowBalance,
+	})
+	require.NoError(t, err)
+	require.Empty(t, subscribers)
+
+	preferences, err := testQueries.ListNotificationPreferences(context.Background(), account.Owner)
+	require.NoError(t, err)
+	require.Len(t, preferences, 1)
+	require.Empty(t, preferences[0].Channels)
+}
+
+func TestGetBalanceAfterTransfer(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	first, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        20,
+	})
+	require.NoError(t, err)
+
+	balance, err := testQueries.GetBalanceAfterTransfer(context.Background(), GetBalanceAfterTransferParams{
+		TransferID: first.Transfer.ID,
+		AccountID:  account1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-10, balance)
+}
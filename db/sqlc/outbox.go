@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of the domain events written to the outbox
+const (
+	EventUserCreated           = "user.created"
+	EventTransferCompleted     = "transfer.completed"
+	EventPaymentRequestCreated = "payment_request.created"
+	EventStatementRequested    = "statement.requested"
+)
+
+// UserCreatedEvent is the payload of EventUserCreated.
+type UserCreatedEvent struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// TransferCompletedEvent is the payload of EventTransferCompleted. The account
+// IDs are for routing inside the bank; anything sent out uses the numbers. Fee
+// is charged to the paying account on top of the amount, as a transfer of its
+// own booked right after this one.
+type TransferCompletedEvent struct {
+	TransferID        int64     `json:"transfer_id"`
+	FromAccountID     int64     `json:"from_account_id"`
+	ToAccountID       int64     `json:"to_account_id"`
+	FromAccountNumber string    `json:"from_account_number"`
+	ToAccountNumber   string    `json:"to_account_number"`
+	Amount            int64     `json:"amount"`
+	Fee               int64     `json:"fee"`
+	Currency          string    `json:"currency"`
+	Reference         string    `json:"reference"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// PaymentRequestCreatedEvent is the payload of EventPaymentRequestCreated.
+type PaymentRequestCreatedEvent struct {
+	PaymentRequestID uuid.UUID `json:"payment_request_id"`
+	Payer            string    `json:"payer"`
+}
+
+// StatementRequestedEvent is the payload of EventStatementRequested.
+type StatementRequestedEvent struct {
+	StatementID uuid.UUID `json:"statement_id"`
+	AccountID   int64     `json:"account_id"`
+}
+
+// addOutboxEvent records a domain event with the given queries, so it is only
+// published if the transaction that caused it commits.
+func addOutboxEvent(ctx context.Context, q *Queries, eventType string, aggregateID string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal %s event: %w", eventType, err)
+	}
+
+	eventID, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		EventID:     eventID,
+		EventType:   eventType,
+		AggregateID: aggregateID,
+		Payload:     data,
+	})
+	return err
+}
+
+// addTransferCompletedEvent records EventTransferCompleted for a transfer
+// between the given accounts, in the currency of the paying one, and the fee
+// charged for it.
+func addTransferCompletedEvent(ctx context.Context, q *Queries, transfer Transfer, fromAccount Account, toAccount Account, fee int64) error {
+	return addOutboxEvent(ctx, q, EventTransferCompleted, fmt.Sprint(transfer.ID), TransferCompletedEvent{
+		TransferID:        transfer.ID,
+		FromAccountID:     transfer.FromAccountID,
+		ToAccountID:       transfer.ToAccountID,
+		FromAccountNumber: fromAccount.AccountNumber,
+		ToAccountNumber:   toAccount.AccountNumber,
+		Amount:            transfer.Amount,
+		Fee:               fee,
+		Currency:          fromAccount.Currency,
+		Reference:         transfer.Reference,
+		CreatedAt:         transfer.CreatedAt,
+	})
+}
@@ -0,0 +1,175 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: outbox.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :one
+INSERT INTO
+    outbox_events (
+        event_id,
+        event_type,
+        aggregate_id,
+        payload
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    id, event_id, event_type, aggregate_id, payload, attempts, last_error, created_at, published_at, next_attempt_at
+`
+
+type CreateOutboxEventParams struct {
+	EventID     uuid.UUID       `json:"event_id"`
+	EventType   string          `json:"event_type"`
+	AggregateID string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, createOutboxEvent,
+		arg.EventID,
+		arg.EventType,
+		arg.AggregateID,
+		arg.Payload,
+	)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.EventType,
+		&i.AggregateID,
+		&i.Payload,
+		&i.Attempts,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.NextAttemptAt,
+	)
+	return i, err
+}
+
+const getOutboxEvent = `-- name: GetOutboxEvent :one
+SELECT id, event_id, event_type, aggregate_id, payload, attempts, last_error, created_at, published_at, next_attempt_at
+FROM outbox_events
+WHERE
+    event_type = $1
+    AND aggregate_id = $2
+`
+
+type GetOutboxEventParams struct {
+	EventType   string `json:"event_type"`
+	AggregateID string `json:"aggregate_id"`
+}
+
+func (q *Queries) GetOutboxEvent(ctx context.Context, arg GetOutboxEventParams) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, getOutboxEvent, arg.EventType, arg.AggregateID)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.EventType,
+		&i.AggregateID,
+		&i.Payload,
+		&i.Attempts,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.NextAttemptAt,
+	)
+	return i, err
+}
+
+const listPendingOutboxEvents = `-- name: ListPendingOutboxEvents :many
+SELECT id, event_id, event_type, aggregate_id, payload, attempts, last_error, created_at, published_at, next_attempt_at
+FROM outbox_events
+WHERE
+    published_at IS NULL
+    AND attempts < $1
+    AND next_attempt_at <= now()
+ORDER BY id
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type ListPendingOutboxEventsParams struct {
+	MaxAttempts int32 `json:"max_attempts"`
+	RowLimit    int32 `json:"row_limit"`
+}
+
+func (q *Queries) ListPendingOutboxEvents(ctx context.Context, arg ListPendingOutboxEventsParams) ([]OutboxEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingOutboxEvents, arg.MaxAttempts, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.EventType,
+			&i.AggregateID,
+			&i.Payload,
+			&i.Attempts,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.NextAttemptAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOutboxEventPublished = `-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET
+    attempts = attempts + 1,
+    last_error = '',
+    published_at = now()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkOutboxEventPublished(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEventPublished, id)
+	return err
+}
+
+const recordOutboxEventFailure = `-- name: RecordOutboxEventFailure :exec
+UPDATE outbox_events
+SET
+    attempts = attempts + 1,
+    last_error = $1,
+    next_attempt_at = $2
+WHERE
+    id = $3
+`
+
+type RecordOutboxEventFailureParams struct {
+	LastError     string    `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	ID            int64     `json:"id"`
+}
+
+func (q *Queries) RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) error {
+	_, err := q.db.ExecContext(ctx, recordOutboxEventFailure, arg.LastError, arg.NextAttemptAt, arg.ID)
+	return err
+}
@@ -0,0 +1,160 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+// relayAll relays every pending outbox event and returns the ones publish was
+// called with. Failed events can be retried right away.
+func relayAll(t *testing.T, store Store, maxAttempts int32, publish func(event OutboxEvent) error) []OutboxEvent {
+	var seen []OutboxEvent
+	for {
+		result, err := store.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+			Limit:       100,
+			MaxAttempts: maxAttempts,
+			RetryDelay:  func(attempts int32) time.Duration { return 0 },
+			Publish: func(event OutboxEvent) error {
+				seen = append(seen, event)
+				return publish(event)
+			},
+		})
+		require.NoError(t, err)
+		if result.Published+result.Failed < 100 {
+			return seen
+		}
+	}
+}
+
+func findEvent(events []OutboxEvent, eventType string, aggregateID string) (OutboxEvent, bool) {
+	for _, event := range events {
+		if event.EventType == eventType && event.AggregateID == aggregateID {
+			return event, true
+		}
+	}
+	return OutboxEvent{}, false
+}
+
+func TestCreateUserTxWritesOutboxEvent(t *testing.T) {
+	store := NewStore(testDB)
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	result, err := store.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       util.RandomOwner(),
+			HashedPassword: hashedPassword,
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+	})
+	require.NoError(t, err)
+
+	events := relayAll(t, store, 100, func(event OutboxEvent) error { return nil })
+	event, ok := findEvent(events, EventUserCreated, result.User.Username)
+	require.True(t, ok)
+
+	var payload UserCreatedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, result.User.Email, payload.Email)
+
+	// published events are not handed out again
+	events = relayAll(t, store, 100, func(event OutboxEvent) error { return nil })
+	_, ok = findEvent(events, EventUserCreated, result.User.Username)
+	require.False(t, ok)
+}
+
+func TestRelayOutboxTxRetriesFailedEvents(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+	aggregateID := fmt.Sprint(transfer.Transfer.ID)
+
+	failing := func(event OutboxEvent) error {
+		if event.AggregateID == aggregateID {
+			return errors.New("queue unavailable")
+		}
+		return nil
+	}
+
+	events := relayAll(t, store, 2, failing)
+	event, ok := findEvent(events, EventTransferCompleted, aggregateID)
+	require.True(t, ok)
+
+	var payload TransferCompletedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, int64(10), payload.Amount)
+	require.Equal(t, account1.Currency, payload.Currency)
+	require.Equal(t, account1.AccountNumber, payload.FromAccountNumber)
+	require.Equal(t, account2.AccountNumber, payload.ToAccountNumber)
+
+	// the failed event is retried until it runs out of attempts
+	events = relayAll(t, store, 2, failing)
+	_, ok = findEvent(events, EventTransferCompleted, aggregateID)
+	require.True(t, ok)
+
+	events = relayAll(t, store, 2, failing)
+	_, ok = findEvent(events, EventTransferCompleted, aggregateID)
+	require.False(t, ok)
+}
+
+func TestRelayOutboxTxBacksOffFailedEvents(t *testing.T) {
+	store := NewStore(testDB)
+	user, err := store.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       util.RandomOwner(),
+			HashedPassword: "secret",
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+	})
+	require.NoError(t, err)
+	aggregateID := user.User.Username
+
+	relay := func(publish func(event OutboxEvent) error) (seen bool, err error) {
+		_, err = store.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+			Limit:       100,
+			MaxAttempts: 100,
+			RetryDelay:  func(attempts int32) time.Duration { return time.Hour },
+			Publish: func(event OutboxEvent) error {
+				if event.EventType == EventUserCreated && event.AggregateID == aggregateID {
+					seen = true
+					return publish(event)
+				}
+				return nil
+			},
+		})
+		return seen, err
+	}
+
+	// while the queue is down nothing is counted, so the event stays due
+	for i := 0; i < 3; i++ {
+		_, err := relay(func(event OutboxEvent) error {
+			return fmt.Errorf("%w: connection refused", ErrOutboxUnavailable)
+		})
+		require.ErrorIs(t, err, ErrOutboxUnavailable)
+	}
+
+	// a failure of the event itself pushes its next attempt back
+	seen, err := relay(func(event OutboxEvent) error { return errors.New("bad payload") })
+	require.NoError(t, err)
+	require.True(t, seen)
+
+	seen, err = relay(func(event OutboxEvent) error { return nil })
+	require.NoError(t, err)
+	require.False(t, seen)
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+// ResolveAlias finds the user a payment alias points to. Usernames and verified
+// emails resolve straight from the users table, phone numbers and handles through
+// the aliases users registered and verified. The value is normalized before the lookup.
+func (q *Queries) ResolveAlias(ctx context.Context, aliasType string, value string) (User, error) {
+	value = util.NormalizeAlias(aliasType, value)
+
+	switch aliasType {
+	case util.AliasUsername:
+		return q.GetUser(ctx, value)
+	case util.AliasEmail:
+		return q.GetUserByVerifiedEmail(ctx, value)
+	case util.AliasPhone, util.AliasHandle:
+		alias, err := q.GetPaymentAlias(ctx, GetPaymentAliasParams{
+			AliasType: aliasType,
+			Value:     value,
+		})
+		if err != nil {
+			return User{}, err
+		}
+		return q.GetUser(ctx, alias.Username)
+	}
+
+	return User{}, fmt.Errorf("unsupported alias type %q", aliasType)
+}
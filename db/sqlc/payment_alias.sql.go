@@ -0,0 +1,269 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: payment_alias.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createPaymentAlias = `-- name: CreatePaymentAlias :one
+INSERT INTO
+    payment_aliases (
+        alias_type,
+        value,
+        username,
+        secret_code,
+        code_expired_at,
+        verified_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6
+    )
+ON CONFLICT (alias_type, value, username) DO
+UPDATE
+SET
+    secret_code = EXCLUDED.secret_code,
+    verify_attempts = 0,
+    code_expired_at = EXCLUDED.code_expired_at
+WHERE
+    payment_aliases.verified_at IS NULL
+RETURNING
+    alias_type, value, username, created_at, secret_code, verify_attempts, code_expired_at, verified_at
+`
+
+type CreatePaymentAliasParams struct {
+	AliasType     string       `json:"alias_type"`
+	Value         string       `json:"value"`
+	Username      string       `json:"username"`
+	SecretCode    string       `json:"secret_code"`
+	CodeExpiredAt time.Time    `json:"code_expired_at"`
+	VerifiedAt    sql.NullTime `json:"verified_at"`
+}
+
+func (q *Queries) CreatePaymentAlias(ctx context.Context, arg CreatePaymentAliasParams) (PaymentAlias, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentAlias,
+		arg.AliasType,
+		arg.Value,
+		arg.Username,
+		arg.SecretCode,
+		arg.CodeExpiredAt,
+		arg.VerifiedAt,
+	)
+	var i PaymentAlias
+	err := row.Scan(
+		&i.AliasType,
+		&i.Value,
+		&i.Username,
+		&i.CreatedAt,
+		&i.SecretCode,
+		&i.VerifyAttempts,
+		&i.CodeExpiredAt,
+		&i.VerifiedAt,
+	)
+	return i, err
+}
+
+const deletePaymentAlias = `-- name: DeletePaymentAlias :execrows
+DELETE FROM payment_aliases
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND username = $3
+`
+
+type DeletePaymentAliasParams struct {
+	AliasType string `json:"alias_type"`
+	Value     string `json:"value"`
+	Username  string `json:"username"`
+}
+
+func (q *Queries) DeletePaymentAlias(ctx context.Context, arg DeletePaymentAliasParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePaymentAlias, arg.AliasType, arg.Value, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getPaymentAlias = `-- name: GetPaymentAlias :one
+SELECT alias_type, value, username, created_at, secret_code, verify_attempts, code_expired_at, verified_at
+FROM payment_aliases
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND verified_at IS NOT NULL
+LIMIT 1
+`
+
+type GetPaymentAliasParams struct {
+	AliasType string `json:"alias_type"`
+	Value     string `json:"value"`
+}
+
+func (q *Queries) GetPaymentAlias(ctx context.Context, arg GetPaymentAliasParams) (PaymentAlias, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentAlias, arg.AliasType, arg.Value)
+	var i PaymentAlias
+	err := row.Scan(
+		&i.AliasType,
+		&i.Value,
+		&i.Username,
+		&i.CreatedAt,
+		&i.SecretCode,
+		&i.VerifyAttempts,
+		&i.CodeExpiredAt,
+		&i.VerifiedAt,
+	)
+	return i, err
+}
+
+const getUserPaymentAlias = `-- name: GetUserPaymentAlias :one
+SELECT alias_type, value, username, created_at, secret_code, verify_attempts, code_expired_at, verified_at
+FROM payment_aliases
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND username = $3
+LIMIT 1
+`
+
+type GetUserPaymentAliasParams struct {
+	AliasType string `json:"alias_type"`
+	Value     string `json:"value"`
+	Username  string `json:"username"`
+}
+
+func (q *Queries) GetUserPaymentAlias(ctx context.Context, arg GetUserPaymentAliasParams) (PaymentAlias, error) {
+	row := q.db.QueryRowContext(ctx, getUserPaymentAlias, arg.AliasType, arg.Value, arg.Username)
+	var i PaymentAlias
+	err := row.Scan(
+		&i.AliasType,
+		&i.Value,
+		&i.Username,
+		&i.CreatedAt,
+		&i.SecretCode,
+		&i.VerifyAttempts,
+		&i.CodeExpiredAt,
+		&i.VerifiedAt,
+	)
+	return i, err
+}
+
+const listPaymentAliases = `-- name: ListPaymentAliases :many
+SELECT alias_type, value, username, created_at, secret_code, verify_attempts, code_expired_at, verified_at
+FROM payment_aliases
+WHERE
+    username = $1
+ORDER BY alias_type, value
+`
+
+func (q *Queries) ListPaymentAliases(ctx context.Context, username string) ([]PaymentAlias, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentAliases, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentAlias{}
+	for rows.Next() {
+		var i PaymentAlias
+		if err := rows.Scan(
+			&i.AliasType,
+			&i.Value,
+			&i.Username,
+			&i.CreatedAt,
+			&i.SecretCode,
+			&i.VerifyAttempts,
+			&i.CodeExpiredAt,
+			&i.VerifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordPaymentAliasVerifyFailure = `-- name: RecordPaymentAliasVerifyFailure :exec
+UPDATE payment_aliases
+SET
+    verify_attempts = verify_attempts + 1
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND username = $3
+    AND verified_at IS NULL
+`
+
+type RecordPaymentAliasVerifyFailureParams struct {
+	AliasType string `json:"alias_type"`
+	Value     string `json:"value"`
+	Username  string `json:"username"`
+}
+
+func (q *Queries) RecordPaymentAliasVerifyFailure(ctx context.Context, arg RecordPaymentAliasVerifyFailureParams) error {
+	_, err := q.db.ExecContext(ctx, recordPaymentAliasVerifyFailure, arg.AliasType, arg.Value, arg.Username)
+	return err
+}
+
+const verifyPaymentAlias = `-- name: VerifyPaymentAlias :one
+UPDATE payment_aliases
+SET
+    secret_code = '',
+    verified_at = now()
+WHERE
+    alias_type = $1
+    AND value = $2
+    AND username = $3
+    AND secret_code = $4
+    AND secret_code <> ''
+    AND verified_at IS NULL
+    AND code_expired_at > now()
+    AND verify_attempts < $5
+RETURNING
+    alias_type, value, username, created_at, secret_code, verify_attempts, code_expired_at, verified_at
+`
+
+type VerifyPaymentAliasParams struct {
+	AliasType   string `json:"alias_type"`
+	Value       string `json:"value"`
+	Username    string `json:"username"`
+	SecretCode  string `json:"secret_code"`
+	MaxAttempts int32  `json:"max_attempts"`
+}
+
+func (q *Queries) VerifyPaymentAlias(ctx context.Context, arg VerifyPaymentAliasParams) (PaymentAlias, error) {
+	row := q.db.QueryRowContext(ctx, verifyPaymentAlias,
+		arg.AliasType,
+		arg.Value,
+		arg.Username,
+		arg.SecretCode,
+		arg.MaxAttempts,
+	)
+	var i PaymentAlias
+	err := row.Scan(
+		&i.AliasType,
+		&i.Value,
+		&i.Username,
+		&i.CreatedAt,
+		&i.SecretCode,
+		&i.VerifyAttempts,
+		&i.CodeExpiredAt,
+		&i.VerifiedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,243 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentAliases(t *testing.T) {
+	user := createRandomUser(t)
+
+	handle := "h_" + util.RandomOwner()
+	alias, err := testQueries.CreatePaymentAlias(context.Background(), CreatePaymentAliasParams{
+		AliasType:     util.AliasHandle,
+		Value:         handle,
+		Username:      user.Username,
+		CodeExpiredAt: time.Now(),
+		VerifiedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AliasHandle, alias.AliasType)
+	require.Equal(t, handle, alias.Value)
+	require.Equal(t, user.Username, alias.Username)
+	require.True(t, alias.VerifiedAt.Valid)
+	require.NotZero(t, alias.CreatedAt)
+
+	// nobody else can claim a handle that is taken
+	other := createRandomUser(t)
+	_, err = testQueries.CreatePaymentAlias(context.Background(), CreatePaymentAliasParams{
+		AliasType:     util.AliasHandle,
+		Value:         handle,
+		Username:      other.Username,
+		CodeExpiredAt: time.Now(),
+		VerifiedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	require.Error(t, err)
+
+	aliases, err := testQueries.ListPaymentAliases(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+	require.Equal(t, alias, aliases[0])
+
+	// only the user the alias belongs to can delete it
+	rows, err := testQueries.DeletePaymentAlias(context.Background(), DeletePaymentAliasParams{
+		AliasType: util.AliasHandle,
+		Value:     handle,
+		Username:  other.Username,
+	})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	rows, err = testQueries.DeletePaymentAlias(context.Background(), DeletePaymentAliasParams{
+		AliasType: util.AliasHandle,
+		Value:     handle,
+		Username:  user.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	_, err = testQueries.GetPaymentAlias(context.Background(), GetPaymentAliasParams{
+		AliasType: util.AliasHandle,
+		Value:     handle,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestVerifyPaymentAlias(t *testing.T) {
+	owner := createRandomUser(t)
+	squatter := createRandomUser(t)
+	phone := fmt.Sprintf("+849%08d", util.RandomInt(0, 99999999))
+
+	claim := func(user User) PaymentAlias {
+		alias, err := testQueries.CreatePaymentAlias(context.Background(), CreatePaymentAliasParams{
+			AliasType:     util.AliasPhone,
+			Value:         phone,
+			Username:      user.Username,
+			SecretCode:    util.NewAliasVerificationCode(),
+			CodeExpiredAt: time.Now().Add(util.AliasVerificationExpiry),
+		})
+		require.NoError(t, err)
+		require.False(t, alias.VerifiedAt.Valid)
+		return alias
+	}
+	verify := func(alias PaymentAlias, code string) (PaymentAlias, error) {
+		return testQueries.VerifyPaymentAlias(context.Background(), VerifyPaymentAliasParams{
+			AliasType:   alias.AliasType,
+			Value:       alias.Value,
+			Username:    alias.Username,
+			SecretCode:  code,
+			MaxAttempts: util.AliasMaxVerifyAttempts,
+		})
+	}
+
+	// an unverified claim neither resolves nor keeps the owner out
+	squatted := claim(squatter)
+	_, err := testQueries.ResolveAlias(context.Background(), util.AliasPhone, phone)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	claimed := claim(owner)
+	_, err = verify(claimed, "wrong")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	verified, err := verify(claimed, claimed.SecretCode)
+	require.NoError(t, err)
+	require.True(t, verified.VerifiedAt.Valid)
+	require.Empty(t, verified.SecretCode)
+
+	resolved, err := testQueries.ResolveAlias(context.Background(), util.AliasPhone, phone)
+	require.NoError(t, err)
+	require.Equal(t, owner.Username, resolved.Username)
+
+	// the number has an owner now, so the other claim can no longer be verified
+	_, err = verify(squatted, squatted.SecretCode)
+	require.Error(t, err)
+
+	// nor can a verified alias get a new code
+	_, err = testQueries.CreatePaymentAlias(context.Background(), CreatePaymentAliasParams{
+		AliasType:     util.AliasPhone,
+		Value:         phone,
+		Username:      owner.Username,
+		SecretCode:    util.NewAliasVerificationCode(),
+		CodeExpiredAt: time.Now().Add(util.AliasVerificationExpiry),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestVerifyPaymentAliasAttempts(t *testing.T) {
+	user := createRandomUser(t)
+	alias, err := testQueries.CreatePaymentAlias(context.Background(), CreatePaymentAliasParams{
+		AliasType:     util.AliasPhone,
+		Value:         fmt.Sprintf("+849%08d", util.RandomInt(0, 99999999)),
+		Username:      user.Username,
+		SecretCode:    util.NewAliasVerificationCode(),
+		CodeExpiredAt: time.Now().Add(util.AliasVerificationExpiry),
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < util.AliasMaxVerifyAttempts; i++ {
+		err = testQueries.RecordPaymentAliasVerifyFailure(context.Background(), RecordPaymentAliasVerifyFailureParams{
+			AliasType: alias.AliasType,
+			Value:     alias.Value,
+			Username:  alias.Username,
+		})
+		require.NoError(t, err)
+	}
+
+	// the right code no longer helps once too many wrong ones were tried
+	_, err = testQueries.VerifyPaymentAlias(context.Background(), VerifyPaymentAliasParams{
+		AliasType:   alias.AliasType,
+		Value:       alias.Value,
+		Username:    alias.Username,
+		SecretCode:  alias.SecretCode,
+		MaxAttempts: util.AliasMaxVerifyAttempts,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestResolveAlias(t *testing.T) {
+	user := createRandomUser(t)
+
+	handle := "h_" + util.RandomOwner()
+	_, err := testQueries.CreatePaymentAlias(context.Background(), CreatePaymentAliasParams{
+		AliasType:     util.AliasHandle,
+		Value:         handle,
+		Username:      user.Username,
+		CodeExpiredAt: time.Now(),
+		VerifiedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+
+	resolved, err := testQueries.ResolveAlias(context.Background(), util.AliasUsername, user.Username)
+	require.NoError(t, err)
+	require.Equal(t, user.Username, resolved.Username)
+
+	resolved, err = testQueries.ResolveAlias(context.Background(), util.AliasHandle, "@"+strings.ToUpper(handle))
+	require.NoError(t, err)
+	require.Equal(t, user.Username, resolved.Username)
+
+	// an email only resolves once it is verified
+	_, err = testQueries.ResolveAlias(context.Background(), util.AliasEmail, user.Email)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testDB.ExecContext(context.Background(), "UPDATE users SET is_email_verified = true WHERE username = $1", user.Username)
+	require.NoError(t, err)
+
+	resolved, err = testQueries.ResolveAlias(context.Background(), util.AliasEmail, strings.ToUpper(user.Email))
+	require.NoError(t, err)
+	require.Equal(t, user.Username, resolved.Username)
+
+	_, err = testQueries.ResolveAlias(context.Background(), util.AliasPhone, "+10000000000")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQueries.ResolveAlias(context.Background(), "iban", "VN00")
+	require.Error(t, err)
+}
+
+func TestDefaultAccounts(t *testing.T) {
+	user := createRandomUser(t)
+	store := NewStore(testDB)
+
+	openAccount := func(currency string, product string) Account {
+		result, err := store.CreateAccountTx(context.Background(), CreateAccountParams{
+			Owner:         user.Username,
+			Currency:      currency,
+			ProductCode:   product,
+			AccountNumber: util.RandomAccountNumber(),
+		})
+		require.NoError(t, err)
+		return result.Account
+	}
+
+	// a current account is preferred over an older savings account, and the
+	// oldest current account over newer ones
+	openAccount(util.USD, util.ProductSavings)
+	usd := openAccount(util.USD, util.ProductCurrent)
+	openAccount(util.USD, util.ProductCurrent)
+	eur := openAccount(util.EUR, util.ProductSavings)
+
+	account, err := testQueries.GetDefaultAccount(context.Background(), GetDefaultAccountParams{
+		Owner:    user.Username,
+		Currency: util.USD,
+	})
+	require.NoError(t, err)
+	require.Equal(t, usd.ID, account.ID)
+
+	_, err = testQueries.GetDefaultAccount(context.Background(), GetDefaultAccountParams{
+		Owner:    user.Username,
+		Currency: util.CAD,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	accounts, err := testQueries.ListDefaultAccounts(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	require.Equal(t, eur.ID, accounts[0].ID)
+	require.Equal(t, usd.ID, accounts[1].ID)
+}
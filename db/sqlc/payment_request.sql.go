@@ -0,0 +1,212 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: payment_request.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createPaymentRequest = `-- name: CreatePaymentRequest :one
+INSERT INTO
+    payment_requests (
+        id,
+        requester,
+        payee_account_id,
+        payer,
+        amount,
+        currency,
+        message,
+        expires_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+    id, requester, payee_account_id, payer, amount, currency, message, status, transfer_id, expires_at, responded_at, created_at
+`
+
+type CreatePaymentRequestParams struct {
+	ID             uuid.UUID `json:"id"`
+	Requester      string    `json:"requester"`
+	PayeeAccountID int64     `json:"payee_account_id"`
+	Payer          string    `json:"payer"`
+	Amount         int64     `json:"amount"`
+	Currency       string    `json:"currency"`
+	Message        string    `json:"message"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentRequest,
+		arg.ID,
+		arg.Requester,
+		arg.PayeeAccountID,
+		arg.Payer,
+		arg.Amount,
+		arg.Currency,
+		arg.Message,
+		arg.ExpiresAt,
+	)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.PayeeAccountID,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Message,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPaymentRequest = `-- name: GetPaymentRequest :one
+SELECT id, requester, payee_account_id, payer, amount, currency, message, status, transfer_id, expires_at, responded_at, created_at FROM payment_requests WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetPaymentRequest(ctx context.Context, id uuid.UUID) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentRequest, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.PayeeAccountID,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Message,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPaymentRequestForUpdate = `-- name: GetPaymentRequestForUpdate :one
+SELECT id, requester, payee_account_id, payer, amount, currency, message, status, transfer_id, expires_at, responded_at, created_at FROM payment_requests WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPaymentRequestForUpdate(ctx context.Context, id uuid.UUID) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentRequestForUpdate, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.PayeeAccountID,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Message,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listPaymentRequests = `-- name: ListPaymentRequests :many
+SELECT id, requester, payee_account_id, payer, amount, currency, message, status, transfer_id, expires_at, responded_at, created_at
+FROM payment_requests
+WHERE
+    requester = $1
+    OR payer = $1
+ORDER BY created_at DESC, id
+LIMIT $3
+OFFSET
+    $2
+`
+
+type ListPaymentRequestsParams struct {
+	Username  string `json:"username"`
+	RowOffset int32  `json:"row_offset"`
+	RowLimit  int32  `json:"row_limit"`
+}
+
+func (q *Queries) ListPaymentRequests(ctx context.Context, arg ListPaymentRequestsParams) ([]PaymentRequest, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentRequests, arg.Username, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.PayeeAccountID,
+			&i.Payer,
+			&i.Amount,
+			&i.Currency,
+			&i.Message,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.RespondedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const respondPaymentRequest = `-- name: RespondPaymentRequest :one
+UPDATE payment_requests
+SET
+    status = $1,
+    transfer_id = $2,
+    responded_at = now()
+WHERE
+    id = $3
+    AND status = 'pending'
+RETURNING
+    id, requester, payee_account_id, payer, amount, currency, message, status, transfer_id, expires_at, responded_at, created_at
+`
+
+type RespondPaymentRequestParams struct {
+	Status     string        `json:"status"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	ID         uuid.UUID     `json:"id"`
+}
+
+func (q *Queries) RespondPaymentRequest(ctx context.Context, arg RespondPaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, respondPaymentRequest, arg.Status, arg.TransferID, arg.ID)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.PayeeAccountID,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Message,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPaymentRequest(t *testing.T, payee Account, payer string, expiresAt time.Time) PaymentRequest {
+	arg := CreatePaymentRequestTxParams{
+		CreatePaymentRequestParams: CreatePaymentRequestParams{
+			ID:             uuid.New(),
+			Requester:      payee.Owner,
+			PayeeAccountID: payee.ID,
+			Payer:          payer,
+			Amount:         util.RandomMoney() + 1,
+			Currency:       payee.Currency,
+			Message:        util.RandomString(20),
+			ExpiresAt:      expiresAt,
+		},
+	}
+
+	result, err := NewStore(testDB).CreatePaymentRequestTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	request := result.PaymentRequest
+	require.Equal(t, arg.ID, request.ID)
+	require.Equal(t, arg.Requester, request.Requester)
+	require.Equal(t, arg.Payer, request.Payer)
+	require.Equal(t, arg.Amount, request.Amount)
+	require.Equal(t, arg.Message, request.Message)
+	require.Equal(t, PaymentRequestPending, request.Status)
+	require.False(t, request.TransferID.Valid)
+	require.WithinDuration(t, expiresAt, request.ExpiresAt, time.Second)
+
+	return request
+}
+
+func TestCreatePaymentRequestTxWritesOutboxEvent(t *testing.T) {
+	payee := createRandomAccount(t)
+	payer := createRandomUser(t)
+	request := createRandomPaymentRequest(t, payee, payer.Username, time.Now().Add(time.Hour))
+
+	event, err := testQueries.GetOutboxEvent(context.Background(), GetOutboxEventParams{
+		EventType:   EventPaymentRequestCreated,
+		AggregateID: request.ID.String(),
+	})
+	require.NoError(t, err)
+	require.False(t, event.PublishedAt.Valid)
+
+	var payload PaymentRequestCreatedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, request.ID, payload.PaymentRequestID)
+	require.Equal(t, payer.Username, payload.Payer)
+}
+
+func TestAcceptPaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+	payee := createRandomAccount(t)
+	payerAccount := createRandomAccountInCurrency(t, payee.Currency)
+	request := createRandomPaymentRequest(t, payee, payerAccount.Owner, time.Now().Add(time.Hour))
+
+	result, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		PaymentRequestID: request.ID,
+		FromAccountID:    payerAccount.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestAccepted, result.PaymentRequest.Status)
+	require.True(t, result.PaymentRequest.RespondedAt.Valid)
+	require.Equal(t, result.Transfer.Transfer.ID, result.PaymentRequest.TransferID.Int64)
+
+	transfer := result.Transfer.Transfer
+	require.Equal(t, payerAccount.ID, transfer.FromAccountID)
+	require.Equal(t, payee.ID, transfer.ToAccountID)
+	require.Equal(t, request.Amount, transfer.Amount)
+	require.Equal(t, request.Message, transfer.Description)
+	require.Equal(t, payee.Balance+request.Amount, result.Transfer.ToAccount.Balance)
+
+	// a request is paid at most once
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		PaymentRequestID: request.ID,
+		FromAccountID:    payerAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestClosed)
+}
+
+func TestAcceptPaymentRequestTxExpired(t *testing.T) {
+	payee := createRandomAccount(t)
+	payerAccount := createRandomAccountInCurrency(t, payee.Currency)
+	request := createRandomPaymentRequest(t, payee, payerAccount.Owner, time.Now().Add(-time.Minute))
+	require.Equal(t, PaymentRequestExpired, request.EffectiveStatus(time.Now()))
+
+	_, err := NewStore(testDB).AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		PaymentRequestID: request.ID,
+		FromAccountID:    payerAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestExpired)
+
+	account, err := testQueries.GetAccount(context.Background(), payerAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, payerAccount.Balance, account.Balance)
+}
+
+func TestDeclineAndListPaymentRequests(t *testing.T) {
+	payee := createRandomAccount(t)
+	payer := createRandomUser(t)
+	request1 := createRandomPaymentRequest(t, payee, payer.Username, time.Now().Add(time.Hour))
+	request2 := createRandomPaymentRequest(t, payee, payer.Username, time.Now().Add(time.Hour))
+
+	declined, err := testQueries.RespondPaymentRequest(context.Background(), RespondPaymentRequestParams{
+		ID:     request1.ID,
+		Status: PaymentRequestDeclined,
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestDeclined, declined.Status)
+	require.ErrorIs(t, declined.CheckOpen(time.Now()), ErrPaymentRequestClosed)
+
+	for _, username := range []string{payee.Owner, payer.Username} {
+		requests, err := testQueries.ListPaymentRequests(context.Background(), ListPaymentRequestsParams{
+			Username: username,
+			RowLimit: 5,
+		})
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+		require.Equal(t, request2.ID, requests[0].ID)
+		require.Equal(t, request1.ID, requests[1].ID)
+	}
+}
@@ -0,0 +1,34 @@
+package db
+
+import "context"
+
+// CombinedBalance is the balance of an account together with the money set aside in its pots.
+type CombinedBalance struct {
+	Account      Account       `json:"account"`
+	Pots         []ListPotsRow `json:"pots"`
+	PotsBalance  int64         `json:"pots_balance"`
+	TotalBalance int64         `json:"total_balance"`
+}
+
+// GetCombinedBalance loads an account with its pots and adds up their balances.
+func (q *Queries) GetCombinedBalance(ctx context.Context, accountID int64) (CombinedBalance, error) {
+	var result CombinedBalance
+	var err error
+
+	result.Account, err = q.GetAccount(ctx, accountID)
+	if err != nil {
+		return result, err
+	}
+
+	result.Pots, err = q.ListPots(ctx, accountID)
+	if err != nil {
+		return result, err
+	}
+
+	for _, pot := range result.Pots {
+		result.PotsBalance += pot.Balance
+	}
+	result.TotalBalance = result.Account.Balance + result.PotsBalance
+
+	return result, nil
+}
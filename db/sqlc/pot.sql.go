@@ -0,0 +1,116 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: pot.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createPot = `-- name: CreatePot :one
+INSERT INTO
+    pots (
+        account_id,
+        parent_account_id,
+        name,
+        target_amount,
+        target_date
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    account_id, parent_account_id, name, target_amount, target_date, created_at
+`
+
+type CreatePotParams struct {
+	AccountID       int64        `json:"account_id"`
+	ParentAccountID int64        `json:"parent_account_id"`
+	Name            string       `json:"name"`
+	TargetAmount    int64        `json:"target_amount"`
+	TargetDate      sql.NullTime `json:"target_date"`
+}
+
+func (q *Queries) CreatePot(ctx context.Context, arg CreatePotParams) (Pot, error) {
+	row := q.db.QueryRowContext(ctx, createPot,
+		arg.AccountID,
+		arg.ParentAccountID,
+		arg.Name,
+		arg.TargetAmount,
+		arg.TargetDate,
+	)
+	var i Pot
+	err := row.Scan(
+		&i.AccountID,
+		&i.ParentAccountID,
+		&i.Name,
+		&i.TargetAmount,
+		&i.TargetDate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPot = `-- name: GetPot :one
+SELECT account_id, parent_account_id, name, target_amount, target_date, created_at FROM pots WHERE account_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetPot(ctx context.Context, accountID int64) (Pot, error) {
+	row := q.db.QueryRowContext(ctx, getPot, accountID)
+	var i Pot
+	err := row.Scan(
+		&i.AccountID,
+		&i.ParentAccountID,
+		&i.Name,
+		&i.TargetAmount,
+		&i.TargetDate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listPots = `-- name: ListPots :many
+SELECT p.account_id, p.parent_account_id, p.name, p.target_amount, p.target_date, p.created_at, a.balance
+FROM pots p
+    JOIN accounts a ON a.id = p.account_id
+WHERE
+    p.parent_account_id = $1
+ORDER BY p.created_at, p.account_id
+`
+
+type ListPotsRow struct {
+	Pot     Pot   `json:"pot"`
+	Balance int64 `json:"balance"`
+}
+
+func (q *Queries) ListPots(ctx context.Context, parentAccountID int64) ([]ListPotsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPots, parentAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPotsRow{}
+	for rows.Next() {
+		var i ListPotsRow
+		if err := rows.Scan(
+			&i.Pot.AccountID,
+			&i.Pot.ParentAccountID,
+			&i.Pot.Name,
+			&i.Pot.TargetAmount,
+			&i.Pot.TargetDate,
+			&i.Pot.CreatedAt,
+			&i.Balance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
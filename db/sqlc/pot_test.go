@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPot(t *testing.T, parent Account) CreatePotTxResult {
+	store := NewStore(testDB)
+
+	arg := CreatePotTxParams{
+		ParentAccountID: parent.ID,
+		Name:            util.RandomString(8),
+		TargetAmount:    util.RandomMoney(),
+		TargetDate:      sql.NullTime{Time: time.Now().AddDate(1, 0, 0).Truncate(24 * time.Hour), Valid: true},
+		AccountNumber:   util.RandomAccountNumber(),
+	}
+
+	result, err := store.CreatePotTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, parent.Owner, result.Account.Owner)
+	require.Equal(t, parent.Currency, result.Account.Currency)
+	require.Equal(t, util.ProductPot, result.Account.ProductCode)
+	require.Zero(t, result.Account.Balance)
+
+	require.Equal(t, result.Account.ID, result.Pot.AccountID)
+	require.Equal(t, parent.ID, result.Pot.ParentAccountID)
+	require.Equal(t, arg.Name, result.Pot.Name)
+	require.Equal(t, arg.TargetAmount, result.Pot.TargetAmount)
+	require.True(t, result.Pot.TargetDate.Valid)
+
+	return result
+}
+
+func TestCreatePotTx(t *testing.T) {
+	parent := createRandomAccount(t)
+	pot := createRandomPot(t, parent)
+
+	// a pot cannot have pots of its own
+	_, err := NewStore(testDB).CreatePotTx(context.Background(), CreatePotTxParams{
+		ParentAccountID: pot.Account.ID,
+		Name:            util.RandomString(8),
+		AccountNumber:   util.RandomAccountNumber(),
+	})
+	require.ErrorIs(t, err, ErrInvalidPotParent)
+}
+
+func TestMovePotMoneyTx(t *testing.T) {
+	store := NewStore(testDB)
+	parent, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      createRandomAccount(t).ID,
+		Balance: 100,
+	})
+	require.NoError(t, err)
+	pot := createRandomPot(t, parent)
+
+	// money can only be set aside if the parent account holds it
+	_, err = store.MovePotMoneyTx(context.Background(), MovePotMoneyTxParams{
+		PotAccountID: pot.Pot.AccountID,
+		Amount:       101,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	result, err := store.MovePotMoneyTx(context.Background(), MovePotMoneyTxParams{
+		PotAccountID: pot.Pot.AccountID,
+		Amount:       30,
+	})
+	require.NoError(t, err)
+	require.Equal(t, parent.ID, result.Transfer.FromAccountID)
+	require.Equal(t, pot.Account.ID, result.Transfer.ToAccountID)
+	require.Equal(t, parent.Balance-30, result.ParentAccount.Balance)
+	require.Equal(t, int64(30), result.PotAccount.Balance)
+
+	result, err = store.MovePotMoneyTx(context.Background(), MovePotMoneyTxParams{
+		PotAccountID: pot.Pot.AccountID,
+		Amount:       10,
+		Withdraw:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pot.Account.ID, result.Transfer.FromAccountID)
+	require.Equal(t, parent.Balance-20, result.ParentAccount.Balance)
+	require.Equal(t, int64(20), result.PotAccount.Balance)
+
+	// the pot cannot go below zero, and the failed move leaves no trace
+	_, err = store.MovePotMoneyTx(context.Background(), MovePotMoneyTxParams{
+		PotAccountID: pot.Pot.AccountID,
+		Amount:       21,
+		Withdraw:     true,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	combined, err := store.GetCombinedBalance(context.Background(), parent.ID)
+	require.NoError(t, err)
+	require.Equal(t, parent.Balance-20, combined.Account.Balance)
+	require.Len(t, combined.Pots, 1)
+	require.Equal(t, pot.Pot, combined.Pots[0].Pot)
+	require.Equal(t, int64(20), combined.PotsBalance)
+	require.Equal(t, parent.Balance, combined.TotalBalance)
+}
+
+func TestGetCombinedBalanceWithoutPots(t *testing.T) {
+	account := createRandomAccount(t)
+
+	combined, err := testQueries.GetCombinedBalance(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Empty(t, combined.Pots)
+	require.Zero(t, combined.PotsBalance)
+	require.Equal(t, account.Balance, combined.TotalBalance)
+}
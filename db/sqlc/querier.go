@@ -6,31 +6,152 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	CloseTermDeposit(ctx context.Context, arg CloseTermDepositParams) (TermDeposit, error)
+	CompleteStatement(ctx context.Context, arg CompleteStatementParams) (Statement, error)
+	CountUnpaidInstalments(ctx context.Context, loanID int64) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountMember(ctx context.Context, arg CreateAccountMemberParams) (AccountMember, error)
+	CreateBeneficiary(ctx context.Context, arg CreateBeneficiaryParams) (Beneficiary, error)
+	CreateCard(ctx context.Context, arg CreateCardParams) (Card, error)
+	CreateCardAuthorization(ctx context.Context, arg CreateCardAuthorizationParams) (CardAuthorization, error)
+	CreateDailyBalances(ctx context.Context, arg CreateDailyBalancesParams) (int64, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateExternalImport(ctx context.Context, arg CreateExternalImportParams) (ExternalImport, error)
+	CreateExternalTransaction(ctx context.Context, arg CreateExternalTransactionParams) (ExternalTransaction, error)
+	CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (FeeSchedule, error)
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (int64, error)
+	CreateInterestPosting(ctx context.Context, arg CreateInterestPostingParams) (InterestPosting, error)
+	CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error)
+	CreateLoanInstalment(ctx context.Context, arg CreateLoanInstalmentParams) (LoanInstalment, error)
+	CreateLoanRepayment(ctx context.Context, arg CreateLoanRepaymentParams) (LoanRepayment, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (OutboxEvent, error)
+	CreatePaymentAlias(ctx context.Context, arg CreatePaymentAliasParams) (PaymentAlias, error)
+	CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	CreatePot(ctx context.Context, arg CreatePotParams) (Pot, error)
+	CreateReconciliationFinding(ctx context.Context, arg CreateReconciliationFindingParams) (ReconciliationFinding, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateStatement(ctx context.Context, arg CreateStatementParams) (Statement, error)
+	CreateTermDeposit(ctx context.Context, arg CreateTermDepositParams) (TermDeposit, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferBatch(ctx context.Context, arg CreateTransferBatchParams) (TransferBatch, error)
+	CreateTransferBatchLeg(ctx context.Context, arg CreateTransferBatchLegParams) (TransferBatchLeg, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
+	DeactivateFeeSchedule(ctx context.Context, id int64) (FeeSchedule, error)
+	DeactivateWebhookEndpoint(ctx context.Context, id int64) (WebhookEndpoint, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountMember(ctx context.Context, arg DeleteAccountMemberParams) error
+	DeleteBeneficiary(ctx context.Context, id int64) error
+	DeletePaymentAlias(ctx context.Context, arg DeletePaymentAliasParams) (int64, error)
+	FailStatement(ctx context.Context, arg FailStatementParams) (Statement, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountActivityHead(ctx context.Context, id int64) (GetAccountActivityHeadRow, error)
+	GetAccountByNumber(ctx context.Context, accountNumber string) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountHolderName(ctx context.Context, id int64) (string, error)
+	GetAccountMember(ctx context.Context, arg GetAccountMemberParams) (AccountMember, error)
+	GetAccountProduct(ctx context.Context, code string) (AccountProduct, error)
+	GetBalanceAfterTransfer(ctx context.Context, arg GetBalanceAfterTransferParams) (int64, error)
+	GetBeneficiary(ctx context.Context, id int64) (Beneficiary, error)
+	GetCard(ctx context.Context, id int64) (Card, error)
+	GetCardByToken(ctx context.Context, token string) (Card, error)
+	GetDefaultAccount(ctx context.Context, arg GetDefaultAccountParams) (Account, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExternalTransactionForUpdate(ctx context.Context, id int64) (ExternalTransaction, error)
+	// picks the active schedule for the currency, preferring one specific to the product
+	GetFeeSchedule(ctx context.Context, arg GetFeeScheduleParams) (FeeSchedule, error)
+	GetInternalAccount(ctx context.Context, arg GetInternalAccountParams) (Account, error)
+	GetLastInterestPosting(ctx context.Context, accountID int64) (InterestPosting, error)
+	GetLatestDailyBalance(ctx context.Context, arg GetLatestDailyBalanceParams) (DailyBalance, error)
+	GetLoan(ctx context.Context, id int64) (Loan, error)
+	GetLoanForUpdate(ctx context.Context, id int64) (Loan, error)
+	GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) (NotificationPreference, error)
+	GetOutboxEvent(ctx context.Context, arg GetOutboxEventParams) (OutboxEvent, error)
+	GetPayeeSince(ctx context.Context, arg GetPayeeSinceParams) (time.Time, error)
+	GetPaymentAlias(ctx context.Context, arg GetPaymentAliasParams) (PaymentAlias, error)
+	GetPaymentRequest(ctx context.Context, id uuid.UUID) (PaymentRequest, error)
+	GetPaymentRequestForUpdate(ctx context.Context, id uuid.UUID) (PaymentRequest, error)
+	GetPendingVerifyEmail(ctx context.Context, arg GetPendingVerifyEmailParams) (VerifyEmail, error)
+	GetPot(ctx context.Context, accountID int64) (Pot, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetStatement(ctx context.Context, id uuid.UUID) (Statement, error)
+	GetTermDeposit(ctx context.Context, accountID int64) (TermDeposit, error)
+	GetTermDepositForUpdate(ctx context.Context, accountID int64) (TermDeposit, error)
+	GetTermDepositRate(ctx context.Context, termMonths int32) (TermDepositRate, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferBatch(ctx context.Context, id uuid.UUID) (TransferBatch, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByVerifiedEmail(ctx context.Context, email string) (User, error)
+	GetUserPaymentAlias(ctx context.Context, arg GetUserPaymentAliasParams) (PaymentAlias, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	GetWebhookEndpoint(ctx context.Context, id int64) (WebhookEndpoint, error)
+	ListAccountActivity(ctx context.Context, arg ListAccountActivityParams) ([]ListAccountActivityRow, error)
+	ListAccountAlertSubscribers(ctx context.Context, arg ListAccountAlertSubscribersParams) ([]NotificationPreference, error)
+	ListAccountNumbers(ctx context.Context, ids []int64) ([]ListAccountNumbersRow, error)
+	ListAccountProducts(ctx context.Context) ([]AccountProduct, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccrualCandidates(ctx context.Context, arg ListAccrualCandidatesParams) ([]ListAccrualCandidatesRow, error)
+	ListBalanceMismatches(ctx context.Context) ([]ListBalanceMismatchesRow, error)
+	ListBeneficiaries(ctx context.Context, arg ListBeneficiariesParams) ([]Beneficiary, error)
+	ListCards(ctx context.Context, arg ListCardsParams) ([]Card, error)
+	ListDefaultAccounts(ctx context.Context, owner string) ([]Account, error)
+	ListDueInstalments(ctx context.Context, arg ListDueInstalmentsParams) ([]LoanInstalment, error)
+	ListDueLoans(ctx context.Context, asOf time.Time) ([]int64, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListEntriesBetween(ctx context.Context, arg ListEntriesBetweenParams) ([]Entry, error)
+	ListInterestAccruals(ctx context.Context, arg ListInterestAccrualsParams) ([]InterestAccrual, error)
+	ListLoanInstalments(ctx context.Context, loanID int64) ([]LoanInstalment, error)
+	ListLoans(ctx context.Context, arg ListLoansParams) ([]Loan, error)
+	ListMatchCandidates(ctx context.Context, arg ListMatchCandidatesParams) ([]Transfer, error)
+	ListMaturedTermDeposits(ctx context.Context, asOf time.Time) ([]int64, error)
+	ListNotificationPreferences(ctx context.Context, username string) ([]NotificationPreference, error)
+	ListPaymentAliases(ctx context.Context, username string) ([]PaymentAlias, error)
+	ListPaymentRequests(ctx context.Context, arg ListPaymentRequestsParams) ([]PaymentRequest, error)
+	ListPendingOutboxEvents(ctx context.Context, arg ListPendingOutboxEventsParams) ([]OutboxEvent, error)
+	ListPots(ctx context.Context, parentAccountID int64) ([]ListPotsRow, error)
+	ListReconciliationFindings(ctx context.Context, arg ListReconciliationFindingsParams) ([]ReconciliationFinding, error)
+	ListSessionOrigins(ctx context.Context, arg ListSessionOriginsParams) ([]ListSessionOriginsRow, error)
+	ListTermDepositRates(ctx context.Context) ([]TermDepositRate, error)
+	ListTermDeposits(ctx context.Context, sourceAccountID int64) ([]ListTermDepositsRow, error)
+	ListTransferBatchLegs(ctx context.Context, batchID uuid.UUID) ([]TransferBatchLeg, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListUnbalancedTransfers(ctx context.Context) ([]ListUnbalancedTransfersRow, error)
+	ListUnmatchedExternalTransactions(ctx context.Context, arg ListUnmatchedExternalTransactionsParams) ([]ExternalTransaction, error)
+	ListUnpostedAccrualAccounts(ctx context.Context, periodEnd time.Time) ([]int64, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookEndpoints(ctx context.Context, username string) ([]WebhookEndpoint, error)
+	ListWebhookEndpointsForAccounts(ctx context.Context, arg ListWebhookEndpointsForAccountsParams) ([]WebhookEndpoint, error)
+	MarkAccrualsPosted(ctx context.Context, arg MarkAccrualsPostedParams) (int64, error)
+	MarkEmailVerified(ctx context.Context, arg MarkEmailVerifiedParams) (User, error)
+	MarkOutboxEventPublished(ctx context.Context, id int64) error
+	MatchExternalTransaction(ctx context.Context, arg MatchExternalTransactionParams) (ExternalTransaction, error)
+	PayLoanInstalment(ctx context.Context, arg PayLoanInstalmentParams) (LoanInstalment, error)
+	RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) error
+	RecordPaymentAliasVerifyFailure(ctx context.Context, arg RecordPaymentAliasVerifyFailureParams) error
+	RecordWebhookAttempt(ctx context.Context, arg RecordWebhookAttemptParams) (WebhookDelivery, error)
+	RenewTermDeposit(ctx context.Context, arg RenewTermDepositParams) (TermDeposit, error)
+	ResetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	RespondPaymentRequest(ctx context.Context, arg RespondPaymentRequestParams) (PaymentRequest, error)
+	SumEntriesAfter(ctx context.Context, arg SumEntriesAfterParams) (int64, error)
+	SumEntriesBetween(ctx context.Context, arg SumEntriesBetweenParams) (int64, error)
+	SumUnpostedAccruals(ctx context.Context, arg SumUnpostedAccrualsParams) (int64, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateBeneficiary(ctx context.Context, arg UpdateBeneficiaryParams) (Beneficiary, error)
+	UpdateCardStatus(ctx context.Context, arg UpdateCardStatusParams) (Card, error)
+	UpdateLoanStatus(ctx context.Context, arg UpdateLoanStatusParams) (Loan, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) (NotificationPreference, error)
+	VerifyPaymentAlias(ctx context.Context, arg VerifyPaymentAliasParams) (PaymentAlias, error)
 }
 
 var _ Querier = (*Queries)(nil)
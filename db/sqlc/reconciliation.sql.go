@@ -0,0 +1,219 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: reconciliation.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const createReconciliationFinding = `-- name: CreateReconciliationFinding :one
+INSERT INTO
+    reconciliation_findings (
+        run_id,
+        kind,
+        account_id,
+        transfer_id,
+        expected,
+        actual,
+        details
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+    id, run_id, kind, account_id, transfer_id, expected, actual, details, created_at
+`
+
+type CreateReconciliationFindingParams struct {
+	RunID      uuid.UUID     `json:"run_id"`
+	Kind       string        `json:"kind"`
+	AccountID  sql.NullInt64 `json:"account_id"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	Expected   int64         `json:"expected"`
+	Actual     int64         `json:"actual"`
+	Details    string        `json:"details"`
+}
+
+func (q *Queries) CreateReconciliationFinding(ctx context.Context, arg CreateReconciliationFindingParams) (ReconciliationFinding, error) {
+	row := q.db.QueryRowContext(ctx, createReconciliationFinding,
+		arg.RunID,
+		arg.Kind,
+		arg.AccountID,
+		arg.TransferID,
+		arg.Expected,
+		arg.Actual,
+		arg.Details,
+	)
+	var i ReconciliationFinding
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.Kind,
+		&i.AccountID,
+		&i.TransferID,
+		&i.Expected,
+		&i.Actual,
+		&i.Details,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listBalanceMismatches = `-- name: ListBalanceMismatches :many
+SELECT
+    a.id,
+    a.balance,
+    COALESCE(SUM(e.amount), 0)::bigint AS entries_total
+FROM accounts a
+    LEFT JOIN entries e ON e.account_id = a.id
+GROUP BY
+    a.id
+HAVING
+    a.balance <> COALESCE(SUM(e.amount), 0)
+ORDER BY a.id
+`
+
+type ListBalanceMismatchesRow struct {
+	ID           int64 `json:"id"`
+	Balance      int64 `json:"balance"`
+	EntriesTotal int64 `json:"entries_total"`
+}
+
+func (q *Queries) ListBalanceMismatches(ctx context.Context) ([]ListBalanceMismatchesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBalanceMismatches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBalanceMismatchesRow{}
+	for rows.Next() {
+		var i ListBalanceMismatchesRow
+		if err := rows.Scan(&i.ID, &i.Balance, &i.EntriesTotal); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReconciliationFindings = `-- name: ListReconciliationFindings :many
+SELECT id, run_id, kind, account_id, transfer_id, expected, actual, details, created_at
+FROM reconciliation_findings
+WHERE
+    $1::uuid IS NULL
+    OR run_id = $1
+ORDER BY id DESC
+LIMIT $3
+OFFSET
+    $2
+`
+
+type ListReconciliationFindingsParams struct {
+	RunID     uuid.NullUUID `json:"run_id"`
+	RowOffset int32         `json:"row_offset"`
+	RowLimit  int32         `json:"row_limit"`
+}
+
+func (q *Queries) ListReconciliationFindings(ctx context.Context, arg ListReconciliationFindingsParams) ([]ReconciliationFinding, error) {
+	rows, err := q.db.QueryContext(ctx, listReconciliationFindings, arg.RunID, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReconciliationFinding{}
+	for rows.Next() {
+		var i ReconciliationFinding
+		if err := rows.Scan(
+			&i.ID,
+			&i.RunID,
+			&i.Kind,
+			&i.AccountID,
+			&i.TransferID,
+			&i.Expected,
+			&i.Actual,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnbalancedTransfers = `-- name: ListUnbalancedTransfers :many
+SELECT
+    t.id,
+    t.amount,
+    COUNT(e.id)::bigint AS entry_count,
+    COALESCE(SUM(e.amount), 0)::bigint AS entries_total
+FROM transfers t
+    LEFT JOIN entries e ON e.transfer_id = t.id
+GROUP BY
+    t.id
+HAVING
+    COUNT(e.id) <> 2
+    OR COALESCE(SUM(e.amount), 0) <> 0
+    OR COUNT(e.id) FILTER (
+        WHERE
+            e.account_id = t.from_account_id
+            AND e.amount = - t.amount
+    ) <> 1
+    OR COUNT(e.id) FILTER (
+        WHERE
+            e.account_id = t.to_account_id
+            AND e.amount = t.amount
+    ) <> 1
+ORDER BY t.id
+`
+
+type ListUnbalancedTransfersRow struct {
+	ID           int64 `json:"id"`
+	Amount       int64 `json:"amount"`
+	EntryCount   int64 `json:"entry_count"`
+	EntriesTotal int64 `json:"entries_total"`
+}
+
+func (q *Queries) ListUnbalancedTransfers(ctx context.Context) ([]ListUnbalancedTransfersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUnbalancedTransfers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnbalancedTransfersRow{}
+	for rows.Next() {
+		var i ListUnbalancedTransfersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.EntryCount,
+			&i.EntriesTotal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	// a random account is opened with a balance that has no backing entries
+	account := createRandomAccount(t)
+	for account.Balance == 0 {
+		account = createRandomAccount(t)
+	}
+
+	result, err := store.ReconcileTx(context.Background())
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, result.RunID)
+
+	var found bool
+	for _, finding := range result.Findings {
+		require.Equal(t, result.RunID, finding.RunID)
+		if finding.Kind == FindingBalanceMismatch && finding.AccountID.Int64 == account.ID {
+			found = true
+			require.Zero(t, finding.Expected)
+		}
+	}
+	require.True(t, found)
+
+	findings, err := testQueries.ListReconciliationFindings(context.Background(), ListReconciliationFindingsParams{
+		RunID:    uuid.NullUUID{UUID: result.RunID, Valid: true},
+		RowLimit: int32(len(result.Findings)),
+	})
+	require.NoError(t, err)
+	require.Len(t, findings, len(result.Findings))
+}
+
+func TestReconcileTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	transfers, err := testQueries.ListUnbalancedTransfers(context.Background())
+	require.NoError(t, err)
+	for _, unbalanced := range transfers {
+		require.NotEqual(t, transfer.Transfer.ID, unbalanced.ID)
+	}
+}
@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+// SearchTransactionsParams filters the entries of the accounts Username is a member of.
+// Zero values leave a filter out. Amounts are compared by absolute value, FromTime
+// is inclusive and ToTime exclusive.
+type SearchTransactionsParams struct {
+	Username              string    `json:"username"`
+	AccountID             int64     `json:"account_id"`
+	Currency              string    `json:"currency"`
+	FromTime              time.Time `json:"from_time"`
+	ToTime                time.Time `json:"to_time"`
+	MinAmount             int64     `json:"min_amount"`
+	MaxAmount             int64     `json:"max_amount"`
+	Direction             string    `json:"direction"`
+	CounterpartyAccountID int64     `json:"counterparty_account_id"`
+	Reference             string    `json:"reference"`
+	RowLimit              int32     `json:"row_limit"`
+	RowOffset             int32     `json:"row_offset"`
+}
+
+// Transaction is an entry seen from its account, with the currency of the
+// account, the account on the other side of the transfer and its metadata.
+type Transaction struct {
+	Entry
+	Currency              string          `json:"currency"`
+	CounterpartyAccountID sql.NullInt64   `json:"counterparty_account_id"`
+	Metadata              json.RawMessage `json:"metadata"`
+}
+
+const searchTransactions = `SELECT e.id, e.account_id, e.amount, e.created_at, e.transfer_id,
+    e.description, e.reference, e.counterparty_name, a.currency,
+    CASE WHEN e.amount < 0 THEN t.to_account_id ELSE t.from_account_id END AS counterparty_account_id,
+    COALESCE(t.metadata, '{}') AS metadata
+FROM entries e
+    JOIN accounts a ON a.id = e.account_id
+    LEFT JOIN transfers t ON t.id = e.transfer_id
+`
+
+// SearchTransactions lists transactions matching the given filters, newest first.
+// sqlc cannot express optional filters without defeating the indexes, so the
+// WHERE clause is assembled here; every value is passed as a bind parameter.
+func (q *Queries) SearchTransactions(ctx context.Context, arg SearchTransactionsParams) ([]Transaction, error) {
+	var conditions []string
+	var args []interface{}
+	bind := func(format string, value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf(format, len(args))
+	}
+	where := func(format string, value interface{}) {
+		conditions = append(conditions, bind(format, value))
+	}
+
+	where("EXISTS (SELECT 1 FROM account_members m WHERE m.account_id = e.account_id AND m.username = $%d)", arg.Username)
+	if arg.AccountID != 0 {
+		where("e.account_id = $%d", arg.AccountID)
+	}
+	if arg.Currency != "" {
+		where("a.currency = $%d", arg.Currency)
+	}
+	if !arg.FromTime.IsZero() {
+		where("e.created_at >= $%d", arg.FromTime)
+	}
+	if !arg.ToTime.IsZero() {
+		where("e.created_at < $%d", arg.ToTime)
+	}
+	// Amount and counterparty filters are split by direction, so they compare
+	// plain columns the indexes cover instead of expressions over them.
+	var directions []string
+	switch arg.Direction {
+	case util.DirectionDebit, util.DirectionCredit:
+		directions = []string{arg.Direction}
+	default:
+		directions = []string{util.DirectionDebit, util.DirectionCredit}
+	}
+	if arg.MinAmount != 0 || arg.MaxAmount != 0 || arg.CounterpartyAccountID != 0 || arg.Direction != "" {
+		var branches []string
+		for _, direction := range directions {
+			branch := []string{"e.amount > 0"}
+			low, high, counterparty := "e.amount >= $%d", "e.amount <= $%d", "t.from_account_id = $%d"
+			minAmount, maxAmount := arg.MinAmount, arg.MaxAmount
+			if direction == util.DirectionDebit {
+				branch = []string{"e.amount < 0"}
+				low, high, counterparty = "e.amount <= $%d", "e.amount >= $%d", "t.to_account_id = $%d"
+				minAmount, maxAmount = -minAmount, -maxAmount
+			}
+			if arg.MinAmount != 0 {
+				branch = append(branch, bind(low, minAmount))
+			}
+			if arg.MaxAmount != 0 {
+				branch = append(branch, bind(high, maxAmount))
+			}
+			if arg.CounterpartyAccountID != 0 {
+				branch = append(branch, bind(counterparty, arg.CounterpartyAccountID))
+			}
+			branches = append(branches, strings.Join(branch, " AND "))
+		}
+		conditions = append(conditions, "(("+strings.Join(branches, ") OR (")+"))")
+	}
+	if arg.Reference != "" {
+		where("e.reference ILIKE $%d", "%"+escapeLike(arg.Reference)+"%")
+	}
+
+	args = append(args, arg.RowLimit, arg.RowOffset)
+	query := searchTransactions +
+		"WHERE " + strings.Join(conditions, " AND ") + "\n" +
+		fmt.Sprintf("ORDER BY e.created_at DESC, e.id DESC\nLIMIT $%d\nOFFSET $%d", len(args)-1, len(args))
+
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.CounterpartyName,
+			&i.Currency,
+			&i.CounterpartyAccountID,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// escapeLike escapes the LIKE wildcards so user input only matches literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTransactions(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccountInCurrency(t, account1.Currency)
+	account3 := createRandomAccountInCurrency(t, account1.Currency)
+
+	transfers := []TransferTxParams{
+		{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: 10, Reference: "RENT 01"},
+		{FromAccountID: account1.ID, ToAccountID: account3.ID, Amount: 20, Reference: "RENT 02"},
+		{FromAccountID: account2.ID, ToAccountID: account1.ID, Amount: 30, Reference: "REFUND 100%"},
+		{FromAccountID: account3.ID, ToAccountID: account1.ID, Amount: 40, Reference: "REFUND 1000", Metadata: json.RawMessage(`{"order_id":"A-1"}`)},
+	}
+	for _, arg := range transfers {
+		_, _, _, err := recordTransfer(context.Background(), testQueries, arg)
+		require.NoError(t, err)
+	}
+
+	search := func(arg SearchTransactionsParams) []Transaction {
+		arg.Username = account1.Owner
+		arg.RowLimit = 10
+		transactions, err := testQueries.SearchTransactions(context.Background(), arg)
+		require.NoError(t, err)
+		return transactions
+	}
+
+	all := search(SearchTransactionsParams{})
+	require.Len(t, all, 4)
+	for i, transaction := range all {
+		require.Equal(t, account1.ID, transaction.AccountID)
+		require.Equal(t, account1.Currency, transaction.Currency)
+		require.True(t, transaction.CounterpartyAccountID.Valid)
+		if i > 0 {
+			require.False(t, transaction.CreatedAt.After(all[i-1].CreatedAt))
+		}
+	}
+	require.Equal(t, account3.ID, all[0].CounterpartyAccountID.Int64)
+	require.Equal(t, int64(40), all[0].Amount)
+	require.JSONEq(t, `{"order_id":"A-1"}`, string(all[0].Metadata))
+	require.JSONEq(t, `{}`, string(all[1].Metadata))
+
+	debits := search(SearchTransactionsParams{Direction: util.DirectionDebit})
+	require.Len(t, debits, 2)
+	for _, transaction := range debits {
+		require.Negative(t, transaction.Amount)
+	}
+
+	credits := search(SearchTransactionsParams{Direction: util.DirectionCredit, MinAmount: 35})
+	require.Len(t, credits, 1)
+	require.Equal(t, int64(40), credits[0].Amount)
+
+	ranged := search(SearchTransactionsParams{MinAmount: 15, MaxAmount: 30})
+	require.Len(t, ranged, 2)
+
+	withAccount2 := search(SearchTransactionsParams{CounterpartyAccountID: account2.ID})
+	require.Len(t, withAccount2, 2)
+	for _, transaction := range withAccount2 {
+		require.Equal(t, account2.ID, transaction.CounterpartyAccountID.Int64)
+	}
+
+	rent := search(SearchTransactionsParams{Reference: "rent"})
+	require.Len(t, rent, 2)
+
+	// wildcards in the reference filter are matched literally
+	percent := search(SearchTransactionsParams{Reference: "100%"})
+	require.Len(t, percent, 1)
+	require.Equal(t, "REFUND 100%", percent[0].Reference)
+
+	future := search(SearchTransactionsParams{FromTime: time.Now().Add(time.Hour)})
+	require.Empty(t, future)
+
+	otherCurrency := util.USD
+	if account1.Currency == util.USD {
+		otherCurrency = util.EUR
+	}
+	require.Empty(t, search(SearchTransactionsParams{Currency: otherCurrency}))
+
+	// another owner never sees the account's transactions
+	transactions, err := testQueries.SearchTransactions(context.Background(), SearchTransactionsParams{
+		Username:  account2.Owner,
+		AccountID: account1.ID,
+		RowLimit:  10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, transactions)
+}
@@ -81,3 +81,42 @@ func (q *Queries) GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	)
 	return i, err
 }
+
+const listSessionOrigins = `-- name: ListSessionOrigins :many
+SELECT DISTINCT user_agent, client_ip
+FROM sessions
+WHERE username = $1 AND created_at < $2
+`
+
+type ListSessionOriginsParams struct {
+	Username string    `json:"username"`
+	Before   time.Time `json:"before"`
+}
+
+type ListSessionOriginsRow struct {
+	UserAgent string `json:"user_agent"`
+	ClientIp  string `json:"client_ip"`
+}
+
+func (q *Queries) ListSessionOrigins(ctx context.Context, arg ListSessionOriginsParams) ([]ListSessionOriginsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionOrigins, arg.Username, arg.Before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSessionOriginsRow{}
+	for rows.Next() {
+		var i ListSessionOriginsRow
+		if err := rows.Scan(&i.UserAgent, &i.ClientIp); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
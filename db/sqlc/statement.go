@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// Constants for the status of a generated statement
+const (
+	StatementPending = "pending"
+	StatementReady   = "ready"
+	StatementFailed  = "failed"
+)
+
+// StatementLine is an entry of a statement with the balance right after it.
+type StatementLine struct {
+	Entry
+	Balance int64 `json:"balance"`
+}
+
+// AccountStatement lists the entries created after PeriodStart up to and including
+// PeriodEnd, matching the balances GetBalanceAt reports at both ends.
+type AccountStatement struct {
+	Account        Account         `json:"account"`
+	HolderName     string          `json:"holder_name"`
+	PeriodStart    time.Time       `json:"period_start"`
+	PeriodEnd      time.Time       `json:"period_end"`
+	OpeningBalance int64           `json:"opening_balance"`
+	ClosingBalance int64           `json:"closing_balance"`
+	TotalDebits    int64           `json:"total_debits"`
+	TotalCredits   int64           `json:"total_credits"`
+	Lines          []StatementLine `json:"lines"`
+	GeneratedAt    time.Time       `json:"generated_at"`
+}
+
+// BuildStatement collects the entries of an account over a period with their running
+// balance. A period starting before the account was opened starts at its opening.
+func (q *Queries) BuildStatement(ctx context.Context, accountID int64, periodStart, periodEnd time.Time) (AccountStatement, error) {
+	var statement AccountStatement
+
+	account, err := q.GetAccount(ctx, accountID)
+	if err != nil {
+		return statement, err
+	}
+
+	holderName, err := q.GetAccountHolderName(ctx, accountID)
+	if err != nil {
+		return statement, err
+	}
+
+	from := periodStart
+	if from.Before(account.CreatedAt) {
+		from = account.CreatedAt
+	}
+
+	opening, err := q.GetBalanceAt(ctx, accountID, from)
+	if err != nil {
+		return statement, err
+	}
+
+	entries, err := q.ListEntriesBetween(ctx, ListEntriesBetweenParams{
+		AccountID: accountID,
+		FromTime:  from,
+		ToTime:    periodEnd,
+	})
+	if err != nil {
+		return statement, err
+	}
+
+	statement = AccountStatement{
+		Account:        account,
+		HolderName:     holderName,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		OpeningBalance: opening,
+		Lines:          make([]StatementLine, len(entries)),
+		GeneratedAt:    time.Now(),
+	}
+
+	balance := opening
+	for i, entry := range entries {
+		balance += entry.Amount
+		if entry.Amount < 0 {
+			statement.TotalDebits -= entry.Amount
+		} else {
+			statement.TotalCredits += entry.Amount
+		}
+		statement.Lines[i] = StatementLine{Entry: entry, Balance: balance}
+	}
+	statement.ClosingBalance = balance
+
+	return statement, nil
+}
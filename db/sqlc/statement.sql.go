@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: statement.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const completeStatement = `-- name: CompleteStatement :one
+UPDATE statements
+SET
+    status = 'ready',
+    content = $2,
+    completed_at = now()
+WHERE
+    id = $1
+RETURNING
+    id, account_id, username, format, period_start, period_end, status, content, failure_reason, created_at, completed_at
+`
+
+type CompleteStatementParams struct {
+	ID      uuid.UUID `json:"id"`
+	Content []byte    `json:"content"`
+}
+
+func (q *Queries) CompleteStatement(ctx context.Context, arg CompleteStatementParams) (Statement, error) {
+	row := q.db.QueryRowContext(ctx, completeStatement, arg.ID, arg.Content)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Username,
+		&i.Format,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.Status,
+		&i.Content,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const createStatement = `-- name: CreateStatement :one
+INSERT INTO
+    statements (
+        id,
+        account_id,
+        username,
+        format,
+        period_start,
+        period_end
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    id, account_id, username, format, period_start, period_end, status, content, failure_reason, created_at, completed_at
+`
+
+type CreateStatementParams struct {
+	ID          uuid.UUID `json:"id"`
+	AccountID   int64     `json:"account_id"`
+	Username    string    `json:"username"`
+	Format      string    `json:"format"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+func (q *Queries) CreateStatement(ctx context.Context, arg CreateStatementParams) (Statement, error) {
+	row := q.db.QueryRowContext(ctx, createStatement,
+		arg.ID,
+		arg.AccountID,
+		arg.Username,
+		arg.Format,
+		arg.PeriodStart,
+		arg.PeriodEnd,
+	)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Username,
+		&i.Format,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.Status,
+		&i.Content,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const failStatement = `-- name: FailStatement :one
+UPDATE statements
+SET
+    status = 'failed',
+    failure_reason = $2,
+    completed_at = now()
+WHERE
+    id = $1
+RETURNING
+    id, account_id, username, format, period_start, period_end, status, content, failure_reason, created_at, completed_at
+`
+
+type FailStatementParams struct {
+	ID            uuid.UUID `json:"id"`
+	FailureReason string    `json:"failure_reason"`
+}
+
+func (q *Queries) FailStatement(ctx context.Context, arg FailStatementParams) (Statement, error) {
+	row := q.db.QueryRowContext(ctx, failStatement, arg.ID, arg.FailureReason)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Username,
+		&i.Format,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.Status,
+		&i.Content,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getStatement = `-- name: GetStatement :one
+SELECT id, account_id, username, format, period_start, period_end, status, content, failure_reason, created_at, completed_at FROM statements WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetStatement(ctx context.Context, id uuid.UUID) (Statement, error) {
+	row := q.db.QueryRowContext(ctx, getStatement, id)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Username,
+		&i.Format,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.Status,
+		&i.Content,
+		&i.FailureReason,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildStatement(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccountInCurrency(t, account1.Currency)
+
+	start := time.Now()
+	for _, amount := range []int64{10, 20} {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+		})
+		require.NoError(t, err)
+	}
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        5,
+	})
+	require.NoError(t, err)
+	end := time.Now()
+
+	account1, err = testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+
+	statement, err := store.BuildStatement(context.Background(), account1.ID, start, end)
+	require.NoError(t, err)
+
+	require.Equal(t, account1.ID, statement.Account.ID)
+	require.NotEmpty(t, statement.HolderName)
+	require.GreaterOrEqual(t, len(statement.Lines), 3)
+	require.Equal(t, account1.Balance, statement.ClosingBalance)
+	require.Equal(t, statement.OpeningBalance+statement.TotalCredits-statement.TotalDebits, statement.ClosingBalance)
+	require.Equal(t, int64(5), statement.TotalCredits)
+
+	balance := statement.OpeningBalance
+	for i, line := range statement.Lines {
+		balance += line.Amount
+		require.Equal(t, balance, line.Balance)
+		if i > 0 {
+			require.False(t, line.CreatedAt.Before(statement.Lines[i-1].CreatedAt))
+		}
+	}
+
+	// a period before the account was opened has no entries
+	statement, err = store.BuildStatement(context.Background(), account1.ID, start.Add(-48*time.Hour), start.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, statement.Lines)
+	require.Equal(t, statement.OpeningBalance, statement.ClosingBalance)
+}
+
+func TestStatementLifecycle(t *testing.T) {
+	account := createRandomAccount(t)
+	end := time.Now().Truncate(time.Microsecond)
+
+	arg := CreateStatementParams{
+		ID:          uuid.New(),
+		AccountID:   account.ID,
+		Username:    account.Owner,
+		Format:      util.StatementCSV,
+		PeriodStart: end.AddDate(0, -1, 0),
+		PeriodEnd:   end,
+	}
+
+	statement, err := testQueries.CreateStatement(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, statement.ID)
+	require.Equal(t, StatementPending, statement.Status)
+	require.Empty(t, statement.Content)
+	require.False(t, statement.CompletedAt.Valid)
+	require.WithinDuration(t, arg.PeriodEnd, statement.PeriodEnd, time.Microsecond)
+
+	content := []byte("date,description\n")
+	statement, err = testQueries.CompleteStatement(context.Background(), CompleteStatementParams{
+		ID:      arg.ID,
+		Content: content,
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatementReady, statement.Status)
+	require.Equal(t, content, statement.Content)
+	require.True(t, statement.CompletedAt.Valid)
+
+	arg.ID = uuid.New()
+	_, err = testQueries.CreateStatement(context.Background(), arg)
+	require.NoError(t, err)
+
+	statement, err = testQueries.FailStatement(context.Background(), FailStatementParams{
+		ID:            arg.ID,
+		FailureReason: "boom",
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatementFailed, statement.Status)
+	require.Equal(t, "boom", statement.FailureReason)
+}
+
+func TestCreateStatementTxWritesOutboxEvent(t *testing.T) {
+	account := createRandomAccount(t)
+	end := time.Now().Truncate(time.Microsecond)
+
+	result, err := NewStore(testDB).CreateStatementTx(context.Background(), CreateStatementTxParams{
+		CreateStatementParams: CreateStatementParams{
+			ID:          uuid.New(),
+			AccountID:   account.ID,
+			Username:    account.Owner,
+			Format:      util.StatementCSV,
+			PeriodStart: end.AddDate(0, -1, 0),
+			PeriodEnd:   end,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatementPending, result.Statement.Status)
+
+	event, err := testQueries.GetOutboxEvent(context.Background(), GetOutboxEventParams{
+		EventType:   EventStatementRequested,
+		AggregateID: result.Statement.ID.String(),
+	})
+	require.NoError(t, err)
+	require.False(t, event.PublishedAt.Valid)
+
+	var payload StatementRequestedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, result.Statement.ID, payload.StatementID)
+	require.Equal(t, account.ID, payload.AccountID)
+}
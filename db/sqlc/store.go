@@ -3,13 +3,43 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
 )
 
 type Store interface {
 	Querier
+	GetTransferFee(ctx context.Context, account Account, amount int64) (TransferFee, error)
+	GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error)
+	SearchTransactions(ctx context.Context, arg SearchTransactionsParams) ([]Transaction, error)
+	BuildStatement(ctx context.Context, accountID int64, periodStart, periodEnd time.Time) (AccountStatement, error)
+	GetCombinedBalance(ctx context.Context, accountID int64) (CombinedBalance, error)
+	ResolveAlias(ctx context.Context, aliasType string, value string) (User, error)
+	AddBeneficiary(ctx context.Context, arg AddBeneficiaryParams) (Beneficiary, error)
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	BatchTransferTx(ctx context.Context, arg BatchTransferTxParams) (BatchTransferTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
+	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error)
+	CreateAccountTx(ctx context.Context, arg CreateAccountParams) (CreateAccountTxResult, error)
+	CreateStatementTx(ctx context.Context, arg CreateStatementTxParams) (CreateStatementTxResult, error)
+	CreatePotTx(ctx context.Context, arg CreatePotTxParams) (CreatePotTxResult, error)
+	MovePotMoneyTx(ctx context.Context, arg MovePotMoneyTxParams) (MovePotMoneyTxResult, error)
+	CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (CreatePaymentRequestTxResult, error)
+	AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error)
+	PostInterestTx(ctx context.Context, arg PostInterestTxParams) (PostInterestTxResult, error)
+	ReconcileTx(ctx context.Context) (ReconcileTxResult, error)
+	ImportExternalTx(ctx context.Context, arg ImportExternalTxParams) (ImportExternalTxResult, error)
+	ConfirmExternalMatchTx(ctx context.Context, arg ConfirmExternalMatchTxParams) (ExternalTransaction, error)
+	AuthorizeCardTx(ctx context.Context, arg AuthorizeCardTxParams) (AuthorizeCardTxResult, error)
+	CreateLoanTx(ctx context.Context, arg CreateLoanTxParams) (CreateLoanTxResult, error)
+	CollectLoanRepaymentTx(ctx context.Context, arg CollectLoanRepaymentTxParams) (CollectLoanRepaymentTxResult, error)
+	OpenTermDepositTx(ctx context.Context, arg OpenTermDepositTxParams) (OpenTermDepositTxResult, error)
+	MatureTermDepositTx(ctx context.Context, accountID int64, asOf time.Time) (SettleTermDepositTxResult, error)
+	BreakTermDepositTx(ctx context.Context, accountID int64, brokenOn time.Time) (SettleTermDepositTxResult, error)
 }
 type SQLStore struct {
 	*Queries
@@ -43,9 +73,15 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 
 // TransferTxParams contains the input parameters of the transfer transaction
 type TransferTxParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Description   string `json:"description"`
+	Reference     string `json:"reference"`
+	// CounterpartyName is how the payer sees the payee on their statement.
+	// It defaults to the full name of the payee account owner.
+	CounterpartyName string          `json:"counterparty_name"`
+	Metadata         json.RawMessage `json:"metadata"`
 }
 
 // TransferTxResult is the result of the transfer transaction
@@ -55,51 +91,175 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	Fee         int64    `json:"fee"`
+	FeeTransfer Transfer `json:"fee_transfer"`
+	FeeEntry    Entry    `json:"fee_entry"`
 }
 
+// TransferTx moves money between two accounts. When a fee schedule applies to the
+// source account, the fee is charged in the same transaction as a separate transfer
+// into the fee revenue account of the transfer currency.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 	var result TransferTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+		result, err = transferWithFee(ctx, q, arg)
+		return err
+	})
 
-		if err != nil {
-			return err
-		}
+	return result, err
+}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
+// transferWithFee moves money like transferMoney, charges the transfer fee of
+// the source account and records EventTransferCompleted with the fee, so
+// TransferTx can be composed into larger transactions.
+func transferWithFee(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+	if fromAccount.ProductCode == util.ProductTermDeposit {
+		return TransferTxResult{}, ErrTermDepositLocked
+	}
 
-		if err != nil {
-			return err
-		}
+	fee, err := q.GetTransferFee(ctx, fromAccount, arg.Amount)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+
+	result, err := transferMoney(ctx, q, arg)
+	if err != nil {
+		return result, err
+	}
+
+	err = addTransferCompletedEvent(ctx, q, result.Transfer, result.FromAccount, result.ToAccount, fee.Amount)
+	if err != nil {
+		return result, err
+	}
+
+	if fee.Amount <= 0 {
+		return result, nil
+	}
+
+	revenue, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+		Owner:    FeeRevenueOwner,
+		Currency: fromAccount.Currency,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	feeResult, err := transferMoney(ctx, q, TransferTxParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   revenue.ID,
+		Amount:        fee.Amount,
+		Description:   FeeDescription,
+		Reference:     arg.Reference,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Fee = fee.Amount
+	result.FeeTransfer = feeResult.Transfer
+	result.FeeEntry = feeResult.FromEntry
+	result.FromAccount = feeResult.FromAccount
+	return result, nil
+}
+
+// customerTransfer moves money with transferMoney and records
+// EventTransferCompleted for it, so the customers on either side are notified.
+// Legs the bank books for itself, such as fees and interest, use transferMoney.
+func customerTransfer(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	result, err := transferMoney(ctx, q, arg)
+	if err != nil {
+		return result, err
+	}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
+	err = addTransferCompletedEvent(ctx, q, result.Transfer, result.FromAccount, result.ToAccount, 0)
+	return result, err
+}
 
+// transferMoney records a transfer with its two entries and moves the money
+// between both accounts using the given queries, so it can be composed into
+// larger transactions. The balances are updated first, so the entries are
+// inserted while both accounts are locked and the entry IDs of an account
+// grow in the order their transactions commit.
+func transferMoney(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+	var err error
+
+	if arg.FromAccountID < arg.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	result.Transfer, result.FromEntry, result.ToEntry, err = recordTransfer(ctx, q, arg)
+	return result, err
+}
+
+// recordTransfer inserts a transfer and its debit and credit entries
+// without touching the account balances. Both entries carry the transfer
+// description and reference, and the name of the other side.
+func recordTransfer(ctx context.Context, q *Queries, arg TransferTxParams) (transfer Transfer, fromEntry Entry, toEntry Entry, err error) {
+	payeeName := arg.CounterpartyName
+	if payeeName == "" {
+		payeeName, err = q.GetAccountHolderName(ctx, arg.ToAccountID)
 		if err != nil {
-			return err
+			return
 		}
+	}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
-		}
+	payerName, err := q.GetAccountHolderName(ctx, arg.FromAccountID)
+	if err != nil {
+		return
+	}
 
-		return err
+	metadata := arg.Metadata
+	if len(metadata) == 0 {
+		metadata = json.RawMessage("{}")
+	}
+
+	transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Description:   arg.Description,
+		Reference:     arg.Reference,
+		Metadata:      metadata,
 	})
+	if err != nil {
+		return
+	}
 
-	return result, err
+	transferID := sql.NullInt64{Int64: transfer.ID, Valid: true}
+
+	fromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:        arg.FromAccountID,
+		Amount:           -arg.Amount,
+		TransferID:       transferID,
+		Description:      arg.Description,
+		Reference:        arg.Reference,
+		CounterpartyName: payeeName,
+	})
+	if err != nil {
+		return
+	}
+
+	toEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:        arg.ToAccountID,
+		Amount:           arg.Amount,
+		TransferID:       transferID,
+		Description:      arg.Description,
+		Reference:        arg.Reference,
+		CounterpartyName: payerName,
+	})
+	return
 }
 
 func addMoney(
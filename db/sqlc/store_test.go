@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -109,6 +110,52 @@ func TestTransferTx(t *testing.T) {
 	require.Equal(t, account2.Balance+int64(n)*amount, updatedAccount2.Balance)
 }
 
+func TestTransferTxMetadata(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	payer, err := testQueries.GetAccountHolderName(context.Background(), account1.ID)
+	require.NoError(t, err)
+
+	payee, err := testQueries.GetAccountHolderName(context.Background(), account2.ID)
+	require.NoError(t, err)
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Description:   "Dinner",
+		Reference:     "INV-2024/001",
+		Metadata:      json.RawMessage(`{"order_id":42}`),
+	}
+
+	result, err := store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, arg.Description, result.Transfer.Description)
+	require.Equal(t, arg.Reference, result.Transfer.Reference)
+	require.JSONEq(t, string(arg.Metadata), string(result.Transfer.Metadata))
+
+	for _, entry := range []Entry{result.FromEntry, result.ToEntry} {
+		require.Equal(t, result.Transfer.ID, entry.TransferID.Int64)
+		require.Equal(t, arg.Description, entry.Description)
+		require.Equal(t, arg.Reference, entry.Reference)
+	}
+	require.Equal(t, payee, result.FromEntry.CounterpartyName)
+	require.Equal(t, payer, result.ToEntry.CounterpartyName)
+
+	// the payer can override how the payee shows up on their statement
+	arg.CounterpartyName = "Corner Bistro"
+	arg.Metadata = nil
+
+	result, err = store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(result.Transfer.Metadata))
+	require.Equal(t, arg.CounterpartyName, result.FromEntry.CounterpartyName)
+	require.Equal(t, payer, result.ToEntry.CounterpartyName)
+}
+
 func TestTransferTxDeadlock(t *testing.T) {
 	store := NewStore(testDB)
 	account1 := createRandomAccount(t)
@@ -0,0 +1,343 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: term_deposit.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const closeTermDeposit = `-- name: CloseTermDeposit :one
+UPDATE term_deposits
+SET
+    status = $1,
+    closed_at = now()
+WHERE
+    account_id = $2
+RETURNING
+    account_id, source_account_id, term_months, annual_rate_bps, early_break_penalty_bps, principal, starts_on, matures_on, on_maturity, status, created_at, closed_at
+`
+
+type CloseTermDepositParams struct {
+	Status    string `json:"status"`
+	AccountID int64  `json:"account_id"`
+}
+
+func (q *Queries) CloseTermDeposit(ctx context.Context, arg CloseTermDepositParams) (TermDeposit, error) {
+	row := q.db.QueryRowContext(ctx, closeTermDeposit, arg.Status, arg.AccountID)
+	var i TermDeposit
+	err := row.Scan(
+		&i.AccountID,
+		&i.SourceAccountID,
+		&i.TermMonths,
+		&i.AnnualRateBps,
+		&i.EarlyBreakPenaltyBps,
+		&i.Principal,
+		&i.StartsOn,
+		&i.MaturesOn,
+		&i.OnMaturity,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const createTermDeposit = `-- name: CreateTermDeposit :one
+INSERT INTO
+    term_deposits (
+        account_id,
+        source_account_id,
+        term_months,
+        annual_rate_bps,
+        early_break_penalty_bps,
+        principal,
+        starts_on,
+        matures_on,
+        on_maturity
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING
+    account_id, source_account_id, term_months, annual_rate_bps, early_break_penalty_bps, principal, starts_on, matures_on, on_maturity, status, created_at, closed_at
+`
+
+type CreateTermDepositParams struct {
+	AccountID            int64     `json:"account_id"`
+	SourceAccountID      int64     `json:"source_account_id"`
+	TermMonths           int32     `json:"term_months"`
+	AnnualRateBps        int64     `json:"annual_rate_bps"`
+	EarlyBreakPenaltyBps int64     `json:"early_break_penalty_bps"`
+	Principal            int64     `json:"principal"`
+	StartsOn             time.Time `json:"starts_on"`
+	MaturesOn            time.Time `json:"matures_on"`
+	OnMaturity           string    `json:"on_maturity"`
+}
+
+func (q *Queries) CreateTermDeposit(ctx context.Context, arg CreateTermDepositParams) (TermDeposit, error) {
+	row := q.db.QueryRowContext(ctx, createTermDeposit,
+		arg.AccountID,
+		arg.SourceAccountID,
+		arg.TermMonths,
+		arg.AnnualRateBps,
+		arg.EarlyBreakPenaltyBps,
+		arg.Principal,
+		arg.StartsOn,
+		arg.MaturesOn,
+		arg.OnMaturity,
+	)
+	var i TermDeposit
+	err := row.Scan(
+		&i.AccountID,
+		&i.SourceAccountID,
+		&i.TermMonths,
+		&i.AnnualRateBps,
+		&i.EarlyBreakPenaltyBps,
+		&i.Principal,
+		&i.StartsOn,
+		&i.MaturesOn,
+		&i.OnMaturity,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getTermDeposit = `-- name: GetTermDeposit :one
+SELECT account_id, source_account_id, term_months, annual_rate_bps, early_break_penalty_bps, principal, starts_on, matures_on, on_maturity, status, created_at, closed_at FROM term_deposits WHERE account_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTermDeposit(ctx context.Context, accountID int64) (TermDeposit, error) {
+	row := q.db.QueryRowContext(ctx, getTermDeposit, accountID)
+	var i TermDeposit
+	err := row.Scan(
+		&i.AccountID,
+		&i.SourceAccountID,
+		&i.TermMonths,
+		&i.AnnualRateBps,
+		&i.EarlyBreakPenaltyBps,
+		&i.Principal,
+		&i.StartsOn,
+		&i.MaturesOn,
+		&i.OnMaturity,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getTermDepositForUpdate = `-- name: GetTermDepositForUpdate :one
+SELECT account_id, source_account_id, term_months, annual_rate_bps, early_break_penalty_bps, principal, starts_on, matures_on, on_maturity, status, created_at, closed_at
+FROM term_deposits
+WHERE
+    account_id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTermDepositForUpdate(ctx context.Context, accountID int64) (TermDeposit, error) {
+	row := q.db.QueryRowContext(ctx, getTermDepositForUpdate, accountID)
+	var i TermDeposit
+	err := row.Scan(
+		&i.AccountID,
+		&i.SourceAccountID,
+		&i.TermMonths,
+		&i.AnnualRateBps,
+		&i.EarlyBreakPenaltyBps,
+		&i.Principal,
+		&i.StartsOn,
+		&i.MaturesOn,
+		&i.OnMaturity,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getTermDepositRate = `-- name: GetTermDepositRate :one
+SELECT term_months, annual_rate_bps, early_break_penalty_bps, created_at FROM term_deposit_rates WHERE term_months = $1 LIMIT 1
+`
+
+func (q *Queries) GetTermDepositRate(ctx context.Context, termMonths int32) (TermDepositRate, error) {
+	row := q.db.QueryRowContext(ctx, getTermDepositRate, termMonths)
+	var i TermDepositRate
+	err := row.Scan(
+		&i.TermMonths,
+		&i.AnnualRateBps,
+		&i.EarlyBreakPenaltyBps,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMaturedTermDeposits = `-- name: ListMaturedTermDeposits :many
+SELECT account_id
+FROM term_deposits
+WHERE
+    status = 'active'
+    AND matures_on <= $1
+ORDER BY account_id
+`
+
+func (q *Queries) ListMaturedTermDeposits(ctx context.Context, asOf time.Time) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listMaturedTermDeposits, asOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var account_id int64
+		if err := rows.Scan(&account_id); err != nil {
+			return nil, err
+		}
+		items = append(items, account_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTermDepositRates = `-- name: ListTermDepositRates :many
+SELECT term_months, annual_rate_bps, early_break_penalty_bps, created_at FROM term_deposit_rates ORDER BY term_months
+`
+
+func (q *Queries) ListTermDepositRates(ctx context.Context) ([]TermDepositRate, error) {
+	rows, err := q.db.QueryContext(ctx, listTermDepositRates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TermDepositRate{}
+	for rows.Next() {
+		var i TermDepositRate
+		if err := rows.Scan(
+			&i.TermMonths,
+			&i.AnnualRateBps,
+			&i.EarlyBreakPenaltyBps,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTermDeposits = `-- name: ListTermDeposits :many
+SELECT d.account_id, d.source_account_id, d.term_months, d.annual_rate_bps, d.early_break_penalty_bps, d.principal, d.starts_on, d.matures_on, d.on_maturity, d.status, d.created_at, d.closed_at, a.currency, a.balance
+FROM term_deposits d
+    JOIN accounts a ON a.id = d.account_id
+WHERE
+    d.source_account_id = $1
+ORDER BY d.created_at, d.account_id
+`
+
+type ListTermDepositsRow struct {
+	TermDeposit TermDeposit `json:"term_deposit"`
+	Currency    string      `json:"currency"`
+	Balance     int64       `json:"balance"`
+}
+
+func (q *Queries) ListTermDeposits(ctx context.Context, sourceAccountID int64) ([]ListTermDepositsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTermDeposits, sourceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTermDepositsRow{}
+	for rows.Next() {
+		var i ListTermDepositsRow
+		if err := rows.Scan(
+			&i.TermDeposit.AccountID,
+			&i.TermDeposit.SourceAccountID,
+			&i.TermDeposit.TermMonths,
+			&i.TermDeposit.AnnualRateBps,
+			&i.TermDeposit.EarlyBreakPenaltyBps,
+			&i.TermDeposit.Principal,
+			&i.TermDeposit.StartsOn,
+			&i.TermDeposit.MaturesOn,
+			&i.TermDeposit.OnMaturity,
+			&i.TermDeposit.Status,
+			&i.TermDeposit.CreatedAt,
+			&i.TermDeposit.ClosedAt,
+			&i.Currency,
+			&i.Balance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renewTermDeposit = `-- name: RenewTermDeposit :one
+UPDATE term_deposits
+SET
+    annual_rate_bps = $1,
+    early_break_penalty_bps = $2,
+    principal = $3,
+    starts_on = $4,
+    matures_on = $5
+WHERE
+    account_id = $6
+RETURNING
+    account_id, source_account_id, term_months, annual_rate_bps, early_break_penalty_bps, principal, starts_on, matures_on, on_maturity, status, created_at, closed_at
+`
+
+type RenewTermDepositParams struct {
+	AnnualRateBps        int64     `json:"annual_rate_bps"`
+	EarlyBreakPenaltyBps int64     `json:"early_break_penalty_bps"`
+	Principal            int64     `json:"principal"`
+	StartsOn             time.Time `json:"starts_on"`
+	MaturesOn            time.Time `json:"matures_on"`
+	AccountID            int64     `json:"account_id"`
+}
+
+func (q *Queries) RenewTermDeposit(ctx context.Context, arg RenewTermDepositParams) (TermDeposit, error) {
+	row := q.db.QueryRowContext(ctx, renewTermDeposit,
+		arg.AnnualRateBps,
+		arg.EarlyBreakPenaltyBps,
+		arg.Principal,
+		arg.StartsOn,
+		arg.MaturesOn,
+		arg.AccountID,
+	)
+	var i TermDeposit
+	err := row.Scan(
+		&i.AccountID,
+		&i.SourceAccountID,
+		&i.TermMonths,
+		&i.AnnualRateBps,
+		&i.EarlyBreakPenaltyBps,
+		&i.Principal,
+		&i.StartsOn,
+		&i.MaturesOn,
+		&i.OnMaturity,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestTermDeposit(t *testing.T, source Account, onMaturity string, startsOn time.Time) OpenTermDepositTxResult {
+	store := NewStore(testDB)
+
+	result, err := store.OpenTermDepositTx(context.Background(), OpenTermDepositTxParams{
+		SourceAccountID: source.ID,
+		Amount:          10_000,
+		TermMonths:      12,
+		OnMaturity:      onMaturity,
+		StartsOn:        startsOn,
+		AccountNumber:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func TestOpenTermDepositTx(t *testing.T) {
+	source := createRandomAccount(t)
+	_, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      source.ID,
+		Balance: 50_000,
+	})
+	require.NoError(t, err)
+
+	startsOn := time.Now().UTC().Truncate(24 * time.Hour)
+	result := openTestTermDeposit(t, source, util.MaturityPayout, startsOn)
+	require.Equal(t, util.ProductTermDeposit, result.Account.ProductCode)
+	require.Equal(t, source.Owner, result.Account.Owner)
+	require.Equal(t, int64(10_000), result.Account.Balance)
+	require.Equal(t, int64(40_000), result.Transfer.FromAccount.Balance)
+	require.Equal(t, TermDepositActive, result.TermDeposit.Status)
+	require.Equal(t, util.AddMonths(startsOn, 12), result.TermDeposit.MaturesOn)
+
+	store := NewStore(testDB)
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: result.Account.ID,
+		ToAccountID:   source.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrTermDepositLocked)
+
+	_, err = store.OpenTermDepositTx(context.Background(), OpenTermDepositTxParams{
+		SourceAccountID: source.ID,
+		Amount:          10_000,
+		TermMonths:      7,
+		OnMaturity:      util.MaturityPayout,
+		StartsOn:        startsOn,
+		AccountNumber:   util.RandomAccountNumber(),
+	})
+	require.ErrorIs(t, err, ErrUnsupportedTerm)
+}
+
+func TestMatureTermDepositTx(t *testing.T) {
+	store := NewStore(testDB)
+	source := createRandomAccount(t)
+	_, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      source.ID,
+		Balance: 50_000,
+	})
+	require.NoError(t, err)
+
+	startsOn := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	payout := openTestTermDeposit(t, source, util.MaturityPayout, startsOn)
+	rollover := openTestTermDeposit(t, source, util.MaturityRollover, startsOn)
+	maturesOn := payout.TermDeposit.MaturesOn
+
+	_, err = store.MatureTermDepositTx(context.Background(), payout.Account.ID, maturesOn.AddDate(0, 0, -1))
+	require.ErrorIs(t, err, ErrTermDepositNotDue)
+
+	// 5% on 10000 for 365 days
+	result, err := store.MatureTermDepositTx(context.Background(), payout.Account.ID, maturesOn)
+	require.NoError(t, err)
+	require.Equal(t, int64(500), result.Interest)
+	require.Equal(t, TermDepositPaidOut, result.TermDeposit.Status)
+	require.Equal(t, int64(10_500), result.Payout.Transfer.Amount)
+	require.Equal(t, int64(40_500), result.Payout.ToAccount.Balance)
+
+	result, err = store.MatureTermDepositTx(context.Background(), rollover.Account.ID, maturesOn)
+	require.NoError(t, err)
+	require.Equal(t, TermDepositActive, result.TermDeposit.Status)
+	require.Equal(t, int64(10_500), result.TermDeposit.Principal)
+	require.Equal(t, maturesOn, result.TermDeposit.StartsOn)
+	require.Equal(t, util.AddMonths(maturesOn, 12), result.TermDeposit.MaturesOn)
+	require.Zero(t, result.Payout.Transfer.ID)
+}
+
+func TestBreakTermDepositTx(t *testing.T) {
+	store := NewStore(testDB)
+	source := createRandomAccount(t)
+	_, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      source.ID,
+		Balance: 10_000,
+	})
+	require.NoError(t, err)
+
+	startsOn := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	deposit := openTestTermDeposit(t, source, util.MaturityRollover, startsOn)
+
+	// 73 days at 5% less the 2% penalty
+	result, err := store.BreakTermDepositTx(context.Background(), deposit.Account.ID, startsOn.AddDate(0, 0, 73))
+	require.NoError(t, err)
+	require.Equal(t, int64(60), result.Interest)
+	require.Equal(t, TermDepositBroken, result.TermDeposit.Status)
+	require.True(t, result.TermDeposit.ClosedAt.Valid)
+	require.Equal(t, int64(10_060), result.Payout.ToAccount.Balance)
+
+	_, err = store.BreakTermDepositTx(context.Background(), deposit.Account.ID, startsOn.AddDate(0, 0, 74))
+	require.ErrorIs(t, err, ErrTermDepositClosed)
+}
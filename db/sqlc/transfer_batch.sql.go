@@ -0,0 +1,141 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: transfer_batch.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createTransferBatch = `-- name: CreateTransferBatch :one
+INSERT INTO
+    transfer_batches (
+        id,
+        from_account_id,
+        total_amount,
+        total_fee,
+        leg_count
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    id, from_account_id, total_amount, total_fee, leg_count, created_at
+`
+
+type CreateTransferBatchParams struct {
+	ID            uuid.UUID `json:"id"`
+	FromAccountID int64     `json:"from_account_id"`
+	TotalAmount   int64     `json:"total_amount"`
+	TotalFee      int64     `json:"total_fee"`
+	LegCount      int32     `json:"leg_count"`
+}
+
+func (q *Queries) CreateTransferBatch(ctx context.Context, arg CreateTransferBatchParams) (TransferBatch, error) {
+	row := q.db.QueryRowContext(ctx, createTransferBatch,
+		arg.ID,
+		arg.FromAccountID,
+		arg.TotalAmount,
+		arg.TotalFee,
+		arg.LegCount,
+	)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.TotalAmount,
+		&i.TotalFee,
+		&i.LegCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createTransferBatchLeg = `-- name: CreateTransferBatchLeg :one
+INSERT INTO
+    transfer_batch_legs (
+        batch_id,
+        leg_index,
+        transfer_id,
+        fee
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    batch_id, leg_index, transfer_id, fee
+`
+
+type CreateTransferBatchLegParams struct {
+	BatchID    uuid.UUID `json:"batch_id"`
+	LegIndex   int32     `json:"leg_index"`
+	TransferID int64     `json:"transfer_id"`
+	Fee        int64     `json:"fee"`
+}
+
+func (q *Queries) CreateTransferBatchLeg(ctx context.Context, arg CreateTransferBatchLegParams) (TransferBatchLeg, error) {
+	row := q.db.QueryRowContext(ctx, createTransferBatchLeg,
+		arg.BatchID,
+		arg.LegIndex,
+		arg.TransferID,
+		arg.Fee,
+	)
+	var i TransferBatchLeg
+	err := row.Scan(
+		&i.BatchID,
+		&i.LegIndex,
+		&i.TransferID,
+		&i.Fee,
+	)
+	return i, err
+}
+
+const getTransferBatch = `-- name: GetTransferBatch :one
+SELECT id, from_account_id, total_amount, total_fee, leg_count, created_at FROM transfer_batches WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTransferBatch(ctx context.Context, id uuid.UUID) (TransferBatch, error) {
+	row := q.db.QueryRowContext(ctx, getTransferBatch, id)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.TotalAmount,
+		&i.TotalFee,
+		&i.LegCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listTransferBatchLegs = `-- name: ListTransferBatchLegs :many
+SELECT batch_id, leg_index, transfer_id, fee FROM transfer_batch_legs WHERE batch_id = $1 ORDER BY leg_index
+`
+
+func (q *Queries) ListTransferBatchLegs(ctx context.Context, batchID uuid.UUID) ([]TransferBatchLeg, error) {
+	rows, err := q.db.QueryContext(ctx, listTransferBatchLegs, batchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferBatchLeg{}
+	for rows.Next() {
+		var i TransferBatchLeg
+		if err := rows.Scan(
+			&i.BatchID,
+			&i.LegIndex,
+			&i.TransferID,
+			&i.Fee,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAccountInCurrency(t *testing.T, currency string) Account {
+	user := createRandomUser(t)
+
+	result, err := NewStore(testDB).CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:         user.Username,
+		Balance:       util.RandomMoney(),
+		Currency:      currency,
+		ProductCode:   util.ProductCurrent,
+		AccountNumber: util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+
+	return result.Account
+}
+
+func TestBatchTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      createRandomAccount(t).ID,
+		Balance: 1000,
+	})
+	require.NoError(t, err)
+
+	n := 3
+	arg := BatchTransferTxParams{FromAccountID: fromAccount.ID}
+	var total int64
+	for i := 0; i < n; i++ {
+		toAccount := createRandomAccountInCurrency(t, fromAccount.Currency)
+		amount := int64(10 * (i + 1))
+		arg.Legs = append(arg.Legs, BatchTransferLeg{ToAccountID: toAccount.ID, Amount: amount})
+		total += amount
+	}
+
+	result, err := store.BatchTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, fromAccount.ID, result.Batch.FromAccountID)
+	require.Equal(t, total, result.Batch.TotalAmount)
+	require.Equal(t, int32(n), result.Batch.LegCount)
+	require.Equal(t, fromAccount.Balance-total, result.FromAccount.Balance)
+	require.Len(t, result.Legs, n)
+
+	for i, leg := range result.Legs {
+		require.Equal(t, i, leg.Index)
+		require.Equal(t, arg.Legs[i].ToAccountID, leg.Transfer.ToAccountID)
+		require.Equal(t, arg.Legs[i].Amount, leg.Transfer.Amount)
+		require.Equal(t, -arg.Legs[i].Amount, leg.FromEntry.Amount)
+		require.Equal(t, arg.Legs[i].Amount, leg.ToEntry.Amount)
+	}
+
+	legs, err := testQueries.ListTransferBatchLegs(context.Background(), result.Batch.ID)
+	require.NoError(t, err)
+	require.Len(t, legs, n)
+}
+
+func TestBatchTransferTxRollback(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccount(t)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency)
+
+	arg := BatchTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []BatchTransferLeg{
+			{ToAccountID: toAccount.ID, Amount: 10},
+			{ToAccountID: toAccount.ID + 1_000_000, Amount: 10},
+		},
+	}
+
+	_, err := store.BatchTransferTx(context.Background(), arg)
+	var legErr *BatchLegError
+	require.True(t, errors.As(err, &legErr))
+	require.Equal(t, 1, legErr.Index)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// no leg is committed
+	updatedFrom, err := testQueries.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, fromAccount.Balance, updatedFrom.Balance)
+
+	updatedTo, err := testQueries.GetAccount(context.Background(), toAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, toAccount.Balance, updatedTo.Balance)
+}
+
+func TestBatchTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      createRandomSavingsAccount(t).ID,
+		Balance: 100,
+	})
+	require.NoError(t, err)
+	toAccount1 := createRandomAccountInCurrency(t, fromAccount.Currency)
+	toAccount2 := createRandomAccountInCurrency(t, fromAccount.Currency)
+
+	// the legs alone fit the balance, but not with their fees
+	schedule, err := testQueries.CreateFeeSchedule(context.Background(), CreateFeeScheduleParams{
+		Currency:    fromAccount.Currency,
+		ProductCode: sql.NullString{String: util.ProductSavings, Valid: true},
+		FlatFee:     1,
+	})
+	require.NoError(t, err)
+	defer testQueries.DeactivateFeeSchedule(context.Background(), schedule.ID)
+
+	_, err = store.BatchTransferTx(context.Background(), BatchTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []BatchTransferLeg{
+			{ToAccountID: toAccount1.ID, Amount: 50},
+			{ToAccountID: toAccount2.ID, Amount: 50},
+		},
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// no leg is committed
+	for _, account := range []Account{fromAccount, toAccount1, toAccount2} {
+		updated, err := testQueries.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updated.Balance)
+
+		entries, err := testQueries.ListEntries(context.Background(), ListEntriesParams{
+			AccountID: account.ID,
+			Limit:     10,
+		})
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	}
+}
@@ -0,0 +1,245 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/util"
+)
+
+// MaxBatchTransferLegs caps the number of destinations in a single batch transfer.
+const MaxBatchTransferLegs = 1000
+
+var (
+	ErrInvalidAmount    = errors.New("amount must be positive")
+	ErrSameAccount      = errors.New("cannot transfer to the source account")
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+// BatchLegError reports the leg of a batch transfer that failed validation.
+type BatchLegError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchLegError) Error() string {
+	return fmt.Sprintf("leg %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchLegError) Unwrap() error {
+	return e.Err
+}
+
+// BatchTransferLeg is a single destination of a batch transfer
+type BatchTransferLeg struct {
+	ToAccountID int64  `json:"to_account_id"`
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+	Reference   string `json:"reference"`
+}
+
+// BatchTransferTxParams contains the input parameters of the batch transfer transaction
+type BatchTransferTxParams struct {
+	FromAccountID int64              `json:"from_account_id"`
+	Legs          []BatchTransferLeg `json:"legs"`
+}
+
+// BatchTransferLegResult is the outcome of one leg of a batch transfer
+type BatchTransferLegResult struct {
+	Index     int      `json:"index"`
+	Transfer  Transfer `json:"transfer"`
+	FromEntry Entry    `json:"from_entry"`
+	ToEntry   Entry    `json:"to_entry"`
+	ToAccount Account  `json:"to_account"`
+	Fee       int64    `json:"fee"`
+}
+
+// BatchTransferTxResult is the result of the batch transfer transaction
+type BatchTransferTxResult struct {
+	Batch       TransferBatch            `json:"batch"`
+	FromAccount Account                  `json:"from_account"`
+	Legs        []BatchTransferLegResult `json:"legs"`
+}
+
+// BatchTransferTx moves money from one account to many in a single transaction,
+// so either every leg is committed or none is. It fails with ErrInsufficientFunds
+// if the source cannot cover every leg and its fee. All accounts involved are locked
+// up front in ascending ID order to avoid deadlocks with concurrent transfers.
+func (store *SQLStore) BatchTransferTx(ctx context.Context, arg BatchTransferTxParams) (BatchTransferTxResult, error) {
+	var result BatchTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+		if err != nil {
+			return err
+		}
+		if fromAccount.ProductCode == util.ProductTermDeposit {
+			return ErrTermDepositLocked
+		}
+
+		fees := make([]int64, len(arg.Legs))
+		accountIDs := []int64{fromAccount.ID}
+		destinations := map[int64]Account{}
+		var totalAmount, totalFee int64
+
+		for i, leg := range arg.Legs {
+			if leg.Amount <= 0 {
+				return &BatchLegError{Index: i, Err: ErrInvalidAmount}
+			}
+			if leg.ToAccountID == fromAccount.ID {
+				return &BatchLegError{Index: i, Err: ErrSameAccount}
+			}
+
+			if _, ok := destinations[leg.ToAccountID]; !ok {
+				toAccount, err := q.GetAccount(ctx, leg.ToAccountID)
+				if err != nil {
+					return &BatchLegError{Index: i, Err: err}
+				}
+				destinations[leg.ToAccountID] = toAccount
+				accountIDs = append(accountIDs, toAccount.ID)
+			}
+
+			if destinations[leg.ToAccountID].Currency != fromAccount.Currency {
+				return &BatchLegError{Index: i, Err: ErrCurrencyMismatch}
+			}
+
+			fee, err := q.GetTransferFee(ctx, fromAccount, leg.Amount)
+			if err != nil {
+				return err
+			}
+			fees[i] = fee.Amount
+			totalAmount += leg.Amount
+			totalFee += fee.Amount
+		}
+
+		var revenue Account
+		if totalFee > 0 {
+			revenue, err = q.GetInternalAccount(ctx, GetInternalAccountParams{
+				Owner:    FeeRevenueOwner,
+				Currency: fromAccount.Currency,
+			})
+			if err != nil {
+				return err
+			}
+			accountIDs = append(accountIDs, revenue.ID)
+		}
+
+		if err := lockAccounts(ctx, q, accountIDs); err != nil {
+			return err
+		}
+
+		batchID, err := uuid.NewRandom()
+		if err != nil {
+			return err
+		}
+
+		result.Batch, err = q.CreateTransferBatch(ctx, CreateTransferBatchParams{
+			ID:            batchID,
+			FromAccountID: fromAccount.ID,
+			TotalAmount:   totalAmount,
+			TotalFee:      totalFee,
+			LegCount:      int32(len(arg.Legs)),
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Legs = make([]BatchTransferLegResult, len(arg.Legs))
+		for i, leg := range arg.Legs {
+			legResult := &result.Legs[i]
+			legResult.Index = i
+			legResult.Fee = fees[i]
+
+			legResult.Transfer, legResult.FromEntry, legResult.ToEntry, err = recordTransfer(ctx, q, TransferTxParams{
+				FromAccountID: fromAccount.ID,
+				ToAccountID:   leg.ToAccountID,
+				Amount:        leg.Amount,
+				Description:   leg.Description,
+				Reference:     leg.Reference,
+			})
+			if err != nil {
+				return err
+			}
+
+			legResult.ToAccount, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+				ID:     leg.ToAccountID,
+				Amount: leg.Amount,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = addTransferCompletedEvent(ctx, q, legResult.Transfer, fromAccount, legResult.ToAccount, fees[i])
+			if err != nil {
+				return err
+			}
+
+			if fees[i] > 0 {
+				_, _, _, err = recordTransfer(ctx, q, TransferTxParams{
+					FromAccountID: fromAccount.ID,
+					ToAccountID:   revenue.ID,
+					Amount:        fees[i],
+					Description:   FeeDescription,
+					Reference:     leg.Reference,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err = q.CreateTransferBatchLeg(ctx, CreateTransferBatchLegParams{
+				BatchID:    batchID,
+				LegIndex:   int32(i),
+				TransferID: legResult.Transfer.ID,
+				Fee:        fees[i],
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if totalFee > 0 {
+			_, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+				ID:     revenue.ID,
+				Amount: totalFee,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result.FromAccount, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     fromAccount.ID,
+			Amount: -(totalAmount + totalFee),
+		})
+		if err != nil {
+			return err
+		}
+		if result.FromAccount.Balance < 0 {
+			return ErrInsufficientFunds
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// lockAccounts takes row locks on the given accounts in ascending ID order,
+// the same order addMoney updates them in.
+func lockAccounts(ctx context.Context, q *Queries, accountIDs []int64) error {
+	ids := append([]int64(nil), accountIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for i, id := range ids {
+		if i > 0 && ids[i-1] == id {
+			continue
+		}
+		if _, err := q.GetAccountForUpdate(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+// Constants for the status of a card
+const (
+	CardActive    = "active"
+	CardFrozen    = "frozen"
+	CardCancelled = "cancelled"
+)
+
+const (
+	// CardSettlementOwner owns the internal accounts card payments are paid into
+	// until they are settled with the card network.
+	CardSettlementOwner = "bank_card_settlement"
+	// CardPaymentDescription describes card payments on statements.
+	CardPaymentDescription = "Card payment"
+)
+
+var (
+	ErrCardFrozen         = errors.New("card is frozen")
+	ErrCardCancelled      = errors.New("card is cancelled")
+	ErrCardExpired        = errors.New("card has expired")
+	ErrCardDetailsInvalid = errors.New("card details don't match")
+)
+
+// CheckUsable returns an error unless the card can pay at the given time.
+func (card Card) CheckUsable(now time.Time) error {
+	switch card.Status {
+	case CardFrozen:
+		return ErrCardFrozen
+	case CardCancelled:
+		return ErrCardCancelled
+	}
+	if util.IsCardExpired(card.ExpiryMonth, card.ExpiryYear, now) {
+		return ErrCardExpired
+	}
+	return nil
+}
+
+// AuthorizeCardTxParams contains the input parameters of the card authorization transaction
+type AuthorizeCardTxParams struct {
+	// Token is the CardToken of the number presented by the merchant.
+	Token        string `json:"token"`
+	ExpiryMonth  int32  `json:"expiry_month"`
+	ExpiryYear   int32  `json:"expiry_year"`
+	CVV          string `json:"-"`
+	Amount       int64  `json:"amount"`
+	Currency     string `json:"currency"`
+	MerchantName string `json:"merchant_name"`
+}
+
+// AuthorizeCardTxResult is the result of the card authorization transaction
+type AuthorizeCardTxResult struct {
+	Card          Card              `json:"card"`
+	Authorization CardAuthorization `json:"authorization"`
+	Transfer      TransferTxResult  `json:"transfer"`
+}
+
+// AuthorizeCardTx checks the card details presented by a merchant and debits the
+// linked account, moving the amount into the card settlement account of its
+// currency. It returns sql.ErrNoRows if no card has the token.
+func (store *SQLStore) AuthorizeCardTx(ctx context.Context, arg AuthorizeCardTxParams) (AuthorizeCardTxResult, error) {
+	var result AuthorizeCardTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		if arg.Amount <= 0 {
+			return ErrInvalidAmount
+		}
+
+		result.Card, err = q.GetCardByToken(ctx, arg.Token)
+		if err != nil {
+			return err
+		}
+
+		if result.Card.ExpiryMonth != arg.ExpiryMonth || result.Card.ExpiryYear != arg.ExpiryYear {
+			return ErrCardDetailsInvalid
+		}
+		if err := util.CheckPassword(arg.CVV, result.Card.HashedCvv); err != nil {
+			return ErrCardDetailsInvalid
+		}
+		if err := result.Card.CheckUsable(time.Now()); err != nil {
+			return err
+		}
+
+		account, err := q.GetAccount(ctx, result.Card.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Currency != arg.Currency {
+			return ErrCurrencyMismatch
+		}
+
+		settlement, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+			Owner:    CardSettlementOwner,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Transfer, err = customerTransfer(ctx, q, TransferTxParams{
+			FromAccountID:    account.ID,
+			ToAccountID:      settlement.ID,
+			Amount:           arg.Amount,
+			Description:      CardPaymentDescription,
+			Reference:        result.Card.MaskedNumber[len(result.Card.MaskedNumber)-4:],
+			CounterpartyName: arg.MerchantName,
+		})
+		if err != nil {
+			return err
+		}
+		if result.Transfer.FromAccount.Balance < 0 {
+			return ErrInsufficientFunds
+		}
+
+		result.Authorization, err = q.CreateCardAuthorization(ctx, CreateCardAuthorizationParams{
+			CardID:       result.Card.ID,
+			TransferID:   result.Transfer.Transfer.ID,
+			Amount:       arg.Amount,
+			Currency:     arg.Currency,
+			MerchantName: arg.MerchantName,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+// CreateAccountTxResult is the result of the create account transaction
+type CreateAccountTxResult struct {
+	Account Account       `json:"account"`
+	Member  AccountMember `json:"member"`
+}
+
+// CreateAccountTx opens an account and makes its owner the first member.
+func (store *SQLStore) CreateAccountTx(ctx context.Context, arg CreateAccountParams) (CreateAccountTxResult, error) {
+	var result CreateAccountTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Account, err = q.CreateAccount(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		result.Member, err = q.CreateAccountMember(ctx, CreateAccountMemberParams{
+			AccountID: result.Account.ID,
+			Username:  result.Account.Owner,
+			Role:      util.MemberOwner,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,34 @@
+package db
+
+import "context"
+
+type CreateStatementTxParams struct {
+	CreateStatementParams
+}
+
+// CreateStatementTxResult is the result of the create statement transaction
+type CreateStatementTxResult struct {
+	Statement Statement
+}
+
+// CreateStatementTx records a pending statement and EventStatementRequested in
+// the same transaction; the outbox relay enqueues its generation once it commits.
+func (store *SQLStore) CreateStatementTx(ctx context.Context, arg CreateStatementTxParams) (CreateStatementTxResult, error) {
+	var result CreateStatementTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Statement, err = q.CreateStatement(ctx, arg.CreateStatementParams)
+		if err != nil {
+			return err
+		}
+
+		return addOutboxEvent(ctx, q, EventStatementRequested, result.Statement.ID.String(), StatementRequestedEvent{
+			StatementID: result.Statement.ID,
+			AccountID:   result.Statement.AccountID,
+		})
+	})
+
+	return result, err
+}
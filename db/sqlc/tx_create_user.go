@@ -4,14 +4,15 @@ import "context"
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
 }
 
-// CreateUserTxResult is the result of the transfer transaction
+// CreateUserTxResult is the result of the create user transaction
 type CreateUserTxResult struct {
 	User User
 }
 
+// CreateUserTx creates a user and records EventUserCreated in the same
+// transaction; the outbox relay enqueues the verification email once it commits.
 func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
 	var result CreateUserTxResult
 
@@ -22,8 +23,11 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 		if err != nil {
 			return err
 		}
-		return arg.AfterCreate(result.User)
 
+		return addOutboxEvent(ctx, q, EventUserCreated, result.User.Username, UserCreatedEvent{
+			Username: result.User.Username,
+			Email:    result.User.Email,
+		})
 	})
 
 	return result, err
@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Constants for the status of an external transaction
+const (
+	ExternalUnmatched   = "unmatched"
+	ExternalAutoMatched = "auto_matched"
+	ExternalConfirmed   = "confirmed"
+)
+
+// MatchWindow is how far the booking date at the other bank may lie from the
+// day the transfer was recorded here for the two to be matched automatically.
+const MatchWindow = 3 * 24 * time.Hour
+
+const maxMatchCandidates = 10
+
+var (
+	ErrAlreadyConfirmed = errors.New("external transaction is already confirmed")
+	ErrTransferMismatch = errors.New("transfer does not move the same amount in the same direction on the account")
+)
+
+// ExternalTransactionLine is a parsed line of an external statement.
+type ExternalTransactionLine struct {
+	LineNumber  int32     `json:"line_number"`
+	BookedOn    time.Time `json:"booked_on"`
+	Amount      int64     `json:"amount"`
+	Reference   string    `json:"reference"`
+	Description string    `json:"description"`
+}
+
+// ImportExternalTxParams contains the input parameters of the import transaction
+type ImportExternalTxParams struct {
+	AccountID  int64                     `json:"account_id"`
+	Source     string                    `json:"source"`
+	ImportedBy string                    `json:"imported_by"`
+	Lines      []ExternalTransactionLine `json:"lines"`
+}
+
+// ImportExternalTxResult is the result of the import transaction
+type ImportExternalTxResult struct {
+	Import       ExternalImport        `json:"import"`
+	Transactions []ExternalTransaction `json:"transactions"`
+	Matched      int                   `json:"matched"`
+}
+
+// ImportExternalTx stages the lines of an external statement for an account and
+// matches each line to a transfer on that account when exactly one fits.
+func (store *SQLStore) ImportExternalTx(ctx context.Context, arg ImportExternalTxParams) (ImportExternalTxResult, error) {
+	var result ImportExternalTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Import, err = q.CreateExternalImport(ctx, CreateExternalImportParams{
+			ID:         uuid.New(),
+			AccountID:  arg.AccountID,
+			Source:     arg.Source,
+			ImportedBy: arg.ImportedBy,
+			RowCount:   int32(len(arg.Lines)),
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Transactions = make([]ExternalTransaction, len(arg.Lines))
+		for i, line := range arg.Lines {
+			transaction, err := q.CreateExternalTransaction(ctx, CreateExternalTransactionParams{
+				ImportID:    result.Import.ID,
+				AccountID:   arg.AccountID,
+				LineNumber:  line.LineNumber,
+				BookedOn:    line.BookedOn,
+				Amount:      line.Amount,
+				Reference:   line.Reference,
+				Description: line.Description,
+			})
+			if err != nil {
+				return err
+			}
+
+			transaction, err = autoMatch(ctx, q, transaction)
+			if err != nil {
+				return err
+			}
+			if transaction.Status == ExternalAutoMatched {
+				result.Matched++
+			}
+			result.Transactions[i] = transaction
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// autoMatch links an external transaction to the only transfer that moved the same
+// amount in the same direction around its booking date and has not been matched yet.
+func autoMatch(ctx context.Context, q *Queries, transaction ExternalTransaction) (ExternalTransaction, error) {
+	candidates, err := q.ListMatchCandidates(ctx, ListMatchCandidatesParams{
+		Amount:    transaction.Amount,
+		AccountID: transaction.AccountID,
+		FromTime:  transaction.BookedOn.Add(-MatchWindow),
+		ToTime:    transaction.BookedOn.Add(24*time.Hour + MatchWindow),
+		RowLimit:  maxMatchCandidates,
+	})
+	if err != nil {
+		return transaction, err
+	}
+
+	transfer, ok := pickMatch(candidates, transaction.Reference)
+	if !ok {
+		return transaction, nil
+	}
+
+	return q.MatchExternalTransaction(ctx, MatchExternalTransactionParams{
+		ID:         transaction.ID,
+		Status:     ExternalAutoMatched,
+		TransferID: sql.NullInt64{Int64: transfer.ID, Valid: true},
+	})
+}
+
+// pickMatch chooses the transfer an external transaction matches. A matching
+// reference decides between candidates; without one, a single candidate only
+// matches if its own reference doesn't contradict the external one.
+func pickMatch(candidates []Transfer, reference string) (Transfer, bool) {
+	if reference != "" {
+		var matches []Transfer
+		for _, candidate := range candidates {
+			if strings.EqualFold(candidate.Reference, reference) {
+				matches = append(matches, candidate)
+			}
+		}
+		if len(matches) > 0 {
+			return matches[0], len(matches) == 1
+		}
+	}
+
+	if len(candidates) != 1 {
+		return Transfer{}, false
+	}
+	if reference != "" && candidates[0].Reference != "" {
+		return Transfer{}, false
+	}
+	return candidates[0], true
+}
+
+// ConfirmExternalMatchTxParams contains the input parameters of the confirm match transaction
+type ConfirmExternalMatchTxParams struct {
+	ExternalTransactionID int64  `json:"external_transaction_id"`
+	TransferID            int64  `json:"transfer_id"`
+	ConfirmedBy           string `json:"confirmed_by"`
+}
+
+// ConfirmExternalMatchTx records that a person checked an external transaction
+// against a transfer, either confirming an automatic match or matching it by hand.
+func (store *SQLStore) ConfirmExternalMatchTx(ctx context.Context, arg ConfirmExternalMatchTxParams) (ExternalTransaction, error) {
+	var result ExternalTransaction
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		transaction, err := q.GetExternalTransactionForUpdate(ctx, arg.ExternalTransactionID)
+		if err != nil {
+			return err
+		}
+
+		if transaction.Status == ExternalConfirmed {
+			return ErrAlreadyConfirmed
+		}
+
+		transfer, err := q.GetTransfer(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		accountID := transfer.ToAccountID
+		if transaction.Amount < 0 {
+			accountID = transfer.FromAccountID
+		}
+		if accountID != transaction.AccountID || transfer.Amount != abs(transaction.Amount) {
+			return ErrTransferMismatch
+		}
+
+		result, err = q.MatchExternalTransaction(ctx, MatchExternalTransactionParams{
+			ID:         transaction.ID,
+			Status:     ExternalConfirmed,
+			TransferID: sql.NullInt64{Int64: transfer.ID, Valid: true},
+			MatchedBy:  sql.NullString{String: arg.ConfirmedBy, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
+
+func abs(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+	return amount
+}
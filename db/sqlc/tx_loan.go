@@ -0,0 +1,268 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+// Constants for the status of a loan
+const (
+	LoanActive  = "active"
+	LoanPaidOff = "paid_off"
+)
+
+const (
+	// LoanFundingOwner owns the internal accounts loans are paid out of and repaid into.
+	LoanFundingOwner = "bank_loan_funding"
+	// LoanDisbursementDescription describes loan payouts on statements.
+	LoanDisbursementDescription = "Loan disbursement"
+	// LoanRepaymentDescription describes collected instalments on statements.
+	LoanRepaymentDescription = "Loan repayment"
+)
+
+var ErrLoanAccount = errors.New("loans can only be paid into current or savings accounts")
+
+// LoanArrears sums up the instalments of a loan that are past due and unpaid.
+type LoanArrears struct {
+	Instalments int       `json:"instalments"`
+	Amount      int64     `json:"amount"`
+	Since       time.Time `json:"since"`
+}
+
+// GetLoanArrears returns the arrears of a loan on the given day from its
+// instalments. An instalment falls into arrears the day after it is due.
+func GetLoanArrears(instalments []LoanInstalment, asOf time.Time) LoanArrears {
+	var arrears LoanArrears
+	for _, instalment := range instalments {
+		if instalment.PaidAt.Valid || !instalment.DueDate.Before(asOf) {
+			continue
+		}
+		if arrears.Instalments == 0 {
+			arrears.Since = instalment.DueDate.AddDate(0, 0, 1)
+		}
+		arrears.Instalments++
+		arrears.Amount += instalment.Amount - instalment.PaidAmount
+	}
+	return arrears
+}
+
+// OutstandingPrincipal returns the principal of a loan still to be repaid.
+// Partial payments of an instalment go to its interest first.
+func OutstandingPrincipal(instalments []LoanInstalment) int64 {
+	var outstanding int64
+	for _, instalment := range instalments {
+		repaid := max(instalment.PaidAmount-instalment.Interest, 0)
+		outstanding += instalment.Principal - min(repaid, instalment.Principal)
+	}
+	return outstanding
+}
+
+// CreateLoanTxParams contains the input parameters of the create loan transaction
+type CreateLoanTxParams struct {
+	AccountID     int64     `json:"account_id"`
+	Principal     int64     `json:"principal"`
+	AnnualRateBps int64     `json:"annual_rate_bps"`
+	TermMonths    int32     `json:"term_months"`
+	CreatedBy     string    `json:"created_by"`
+	DisbursedOn   time.Time `json:"disbursed_on"`
+}
+
+// CreateLoanTxResult is the result of the create loan transaction
+type CreateLoanTxResult struct {
+	Loan        Loan             `json:"loan"`
+	Instalments []LoanInstalment `json:"instalments"`
+	Transfer    TransferTxResult `json:"transfer"`
+}
+
+// CreateLoanTx grants a loan: it pays the principal into the account from the
+// loan funding account of its currency as a customer transfer, so the payout
+// raises the same events as TransferTx without being charged a fee, and stores
+// the annuity schedule the loan is repaid by.
+func (store *SQLStore) CreateLoanTx(ctx context.Context, arg CreateLoanTxParams) (CreateLoanTxResult, error) {
+	var result CreateLoanTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.Principal <= 0 {
+			return ErrInvalidAmount
+		}
+
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+		if !util.IsCustomerProduct(account.ProductCode) {
+			return ErrLoanAccount
+		}
+
+		funding, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+			Owner:    LoanFundingOwner,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Transfer, err = customerTransfer(ctx, q, TransferTxParams{
+			FromAccountID: funding.ID,
+			ToAccountID:   account.ID,
+			Amount:        arg.Principal,
+			Description:   LoanDisbursementDescription,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Loan, err = q.CreateLoan(ctx, CreateLoanParams{
+			AccountID:              account.ID,
+			Principal:              arg.Principal,
+			AnnualRateBps:          arg.AnnualRateBps,
+			TermMonths:             arg.TermMonths,
+			Currency:               account.Currency,
+			DisbursementTransferID: result.Transfer.Transfer.ID,
+			CreatedBy:              arg.CreatedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		schedule := util.AnnuitySchedule(arg.Principal, arg.AnnualRateBps, arg.TermMonths, arg.DisbursedOn)
+		result.Instalments = make([]LoanInstalment, len(schedule))
+		for i, instalment := range schedule {
+			result.Instalments[i], err = q.CreateLoanInstalment(ctx, CreateLoanInstalmentParams{
+				LoanID:    result.Loan.ID,
+				Number:    instalment.Number,
+				DueDate:   instalment.DueDate,
+				Principal: instalment.Principal,
+				Interest:  instalment.Interest,
+				Amount:    instalment.Amount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// CollectLoanRepaymentTxParams contains the input parameters of the loan repayment transaction
+type CollectLoanRepaymentTxParams struct {
+	LoanID int64     `json:"loan_id"`
+	AsOf   time.Time `json:"as_of"`
+}
+
+// CollectLoanRepaymentTxResult is the result of the loan repayment transaction
+type CollectLoanRepaymentTxResult struct {
+	Loan       Loan            `json:"loan"`
+	Repayments []LoanRepayment `json:"repayments"`
+	Collected  int64           `json:"collected"`
+}
+
+// CollectLoanRepaymentTx takes the instalments due by AsOf, oldest first, from
+// the account of the loan into the loan funding account. An instalment the
+// balance doesn't cover is collected in part and stays in arrears until a
+// later collection pays the rest. The loan is paid off with its last instalment.
+func (store *SQLStore) CollectLoanRepaymentTx(ctx context.Context, arg CollectLoanRepaymentTxParams) (CollectLoanRepaymentTxResult, error) {
+	var result CollectLoanRepaymentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Loan, err = q.GetLoanForUpdate(ctx, arg.LoanID)
+		if err != nil {
+			return err
+		}
+		if result.Loan.Status != LoanActive {
+			return nil
+		}
+
+		instalments, err := q.ListDueInstalments(ctx, ListDueInstalmentsParams{
+			LoanID: arg.LoanID,
+			AsOf:   arg.AsOf,
+		})
+		if err != nil {
+			return err
+		}
+
+		funding, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+			Owner:    LoanFundingOwner,
+			Currency: result.Loan.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		// lock both accounts in the order transfers lock them, then read the
+		// balance the instalments are taken from
+		err = lockAccounts(ctx, q, []int64{result.Loan.AccountID, funding.ID})
+		if err != nil {
+			return err
+		}
+
+		account, err := q.GetAccount(ctx, result.Loan.AccountID)
+		if err != nil {
+			return err
+		}
+
+		available := account.Balance
+		for _, instalment := range instalments {
+			amount := min(instalment.Amount-instalment.PaidAmount, available)
+			if amount <= 0 {
+				break
+			}
+
+			transfer, err := customerTransfer(ctx, q, TransferTxParams{
+				FromAccountID: account.ID,
+				ToAccountID:   funding.ID,
+				Amount:        amount,
+				Description:   LoanRepaymentDescription,
+				Reference:     fmt.Sprintf("LOAN %d/%d", result.Loan.ID, instalment.Number),
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = q.PayLoanInstalment(ctx, PayLoanInstalmentParams{
+				Amount: amount,
+				LoanID: instalment.LoanID,
+				Number: instalment.Number,
+			})
+			if err != nil {
+				return err
+			}
+
+			repayment, err := q.CreateLoanRepayment(ctx, CreateLoanRepaymentParams{
+				LoanID:           instalment.LoanID,
+				InstalmentNumber: instalment.Number,
+				TransferID:       transfer.Transfer.ID,
+				Amount:           amount,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Repayments = append(result.Repayments, repayment)
+			result.Collected += amount
+			available -= amount
+		}
+
+		unpaid, err := q.CountUnpaidInstalments(ctx, arg.LoanID)
+		if err != nil {
+			return err
+		}
+		if unpaid == 0 {
+			result.Loan, err = q.UpdateLoanStatus(ctx, UpdateLoanStatusParams{
+				ID:     arg.LoanID,
+				Status: LoanPaidOff,
+			})
+		}
+		return err
+	})
+
+	return result, err
+}
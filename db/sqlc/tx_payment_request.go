@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Constants for the status of a payment request
+const (
+	PaymentRequestPending  = "pending"
+	PaymentRequestAccepted = "accepted"
+	PaymentRequestDeclined = "declined"
+	// PaymentRequestExpired is never stored; see EffectiveStatus.
+	PaymentRequestExpired = "expired"
+)
+
+var (
+	ErrPaymentRequestClosed  = errors.New("payment request has already been answered")
+	ErrPaymentRequestExpired = errors.New("payment request has expired")
+)
+
+// EffectiveStatus returns the status of the request at the given time, which
+// is expired for a request still pending after it expires.
+func (request PaymentRequest) EffectiveStatus(now time.Time) string {
+	if request.Status == PaymentRequestPending && !now.Before(request.ExpiresAt) {
+		return PaymentRequestExpired
+	}
+	return request.Status
+}
+
+// CheckOpen returns an error unless the payer can still answer the request.
+func (request PaymentRequest) CheckOpen(now time.Time) error {
+	switch request.EffectiveStatus(now) {
+	case PaymentRequestPending:
+		return nil
+	case PaymentRequestExpired:
+		return ErrPaymentRequestExpired
+	}
+	return ErrPaymentRequestClosed
+}
+
+type CreatePaymentRequestTxParams struct {
+	CreatePaymentRequestParams
+}
+
+// CreatePaymentRequestTxResult is the result of the create payment request transaction
+type CreatePaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest
+}
+
+// CreatePaymentRequestTx stores a payment request and records
+// EventPaymentRequestCreated in the same transaction; the outbox relay enqueues
+// the email to the payer once it commits.
+func (store *SQLStore) CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (CreatePaymentRequestTxResult, error) {
+	var result CreatePaymentRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PaymentRequest, err = q.CreatePaymentRequest(ctx, arg.CreatePaymentRequestParams)
+		if err != nil {
+			return err
+		}
+		return addOutboxEvent(ctx, q, EventPaymentRequestCreated, result.PaymentRequest.ID.String(), PaymentRequestCreatedEvent{
+			PaymentRequestID: result.PaymentRequest.ID,
+			Payer:            result.PaymentRequest.Payer,
+		})
+	})
+
+	return result, err
+}
+
+// AcceptPaymentRequestTxParams contains the input parameters of the accept payment request transaction
+type AcceptPaymentRequestTxParams struct {
+	PaymentRequestID uuid.UUID `json:"payment_request_id"`
+	FromAccountID    int64     `json:"from_account_id"`
+}
+
+// AcceptPaymentRequestTxResult is the result of the accept payment request transaction
+type AcceptPaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest   `json:"payment_request"`
+	Transfer       TransferTxResult `json:"transfer"`
+}
+
+// AcceptPaymentRequestTx pays an open request from the given account of the payer
+// with the same transfer, fee included, that TransferTx makes.
+func (store *SQLStore) AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error) {
+	var result AcceptPaymentRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := q.GetPaymentRequestForUpdate(ctx, arg.PaymentRequestID)
+		if err != nil {
+			return err
+		}
+
+		if err := request.CheckOpen(time.Now()); err != nil {
+			return err
+		}
+
+		result.Transfer, err = transferWithFee(ctx, q, TransferTxParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   request.PayeeAccountID,
+			Amount:        request.Amount,
+			Description:   request.Message,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.PaymentRequest, err = q.RespondPaymentRequest(ctx, RespondPaymentRequestParams{
+			ID:         request.ID,
+			Status:     PaymentRequestAccepted,
+			TransferID: sql.NullInt64{Int64: result.Transfer.Transfer.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
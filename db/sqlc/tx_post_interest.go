@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+const (
+	// InterestExpenseOwner owns the internal accounts that interest is paid from.
+	InterestExpenseOwner = "bank_interest_expense"
+	// InterestDescription describes interest transfers on statements.
+	InterestDescription = "Interest"
+)
+
+// PostInterestTxParams contains the input parameters of the interest posting transaction
+type PostInterestTxParams struct {
+	AccountID int64     `json:"account_id"`
+	PeriodEnd time.Time `json:"period_end"`
+}
+
+// PostInterestTxResult is the result of the interest posting transaction.
+// Transfer is left empty when the accrued interest is below one minor unit.
+type PostInterestTxResult struct {
+	Posting  InterestPosting  `json:"posting"`
+	Transfer TransferTxResult `json:"transfer"`
+}
+
+// PostInterestTx pays all unposted interest accrued up to PeriodEnd into the account.
+// Whole minor units are transferred from the interest expense account of the same
+// currency, and the sub-unit remainder is carried forward to the next posting.
+func (store *SQLStore) PostInterestTx(ctx context.Context, arg PostInterestTxParams) (PostInterestTxResult, error) {
+	var result PostInterestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		accrued, err := q.SumUnpostedAccruals(ctx, SumUnpostedAccrualsParams{
+			AccountID: arg.AccountID,
+			PeriodEnd: arg.PeriodEnd,
+		})
+		if err != nil {
+			return err
+		}
+
+		var carry int64
+		last, err := q.GetLastInterestPosting(ctx, arg.AccountID)
+		switch {
+		case err == nil:
+			carry = last.CarryMicros
+		case err != sql.ErrNoRows:
+			return err
+		}
+
+		amount, remainder := util.SplitMicros(accrued + carry)
+
+		var transferID sql.NullInt64
+		if amount > 0 {
+			expense, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+				Owner:    InterestExpenseOwner,
+				Currency: account.Currency,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Transfer, err = transferMoney(ctx, q, TransferTxParams{
+				FromAccountID: expense.ID,
+				ToAccountID:   account.ID,
+				Amount:        amount,
+				Description:   InterestDescription,
+			})
+			if err != nil {
+				return err
+			}
+			transferID = sql.NullInt64{Int64: result.Transfer.Transfer.ID, Valid: true}
+		}
+
+		result.Posting, err = q.CreateInterestPosting(ctx, CreateInterestPostingParams{
+			AccountID:     arg.AccountID,
+			PeriodEnd:     arg.PeriodEnd,
+			AccruedMicros: accrued,
+			Amount:        amount,
+			CarryMicros:   remainder,
+			TransferID:    transferID,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.MarkAccrualsPosted(ctx, MarkAccrualsPostedParams{
+			PostingID: sql.NullInt64{Int64: result.Posting.ID, Valid: true},
+			AccountID: arg.AccountID,
+			PeriodEnd: arg.PeriodEnd,
+		})
+		return err
+	})
+
+	return result, err
+}
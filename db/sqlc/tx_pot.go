@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+var (
+	ErrInvalidPotParent  = errors.New("pots can only be added to customer accounts")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
+// CreatePotTxParams contains the input parameters of the create pot transaction
+type CreatePotTxParams struct {
+	ParentAccountID int64        `json:"parent_account_id"`
+	Name            string       `json:"name"`
+	TargetAmount    int64        `json:"target_amount"`
+	TargetDate      sql.NullTime `json:"target_date"`
+	// AccountNumber is given to the account that holds the money of the pot.
+	AccountNumber string `json:"account_number"`
+}
+
+// CreatePotTxResult is the result of the create pot transaction
+type CreatePotTxResult struct {
+	Pot     Pot     `json:"pot"`
+	Account Account `json:"account"`
+}
+
+// CreatePotTx opens an empty account for a pot in the currency of the parent
+// account, held by the same owner, and links it to the parent.
+func (store *SQLStore) CreatePotTx(ctx context.Context, arg CreatePotTxParams) (CreatePotTxResult, error) {
+	var result CreatePotTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		parent, err := q.GetAccount(ctx, arg.ParentAccountID)
+		if err != nil {
+			return err
+		}
+		if !util.IsCustomerProduct(parent.ProductCode) {
+			return ErrInvalidPotParent
+		}
+
+		result.Account, err = q.CreateAccount(ctx, CreateAccountParams{
+			Owner:         parent.Owner,
+			Currency:      parent.Currency,
+			ProductCode:   util.ProductPot,
+			AccountNumber: arg.AccountNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Pot, err = q.CreatePot(ctx, CreatePotParams{
+			AccountID:       result.Account.ID,
+			ParentAccountID: parent.ID,
+			Name:            arg.Name,
+			TargetAmount:    arg.TargetAmount,
+			TargetDate:      arg.TargetDate,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// MovePotMoneyTxParams contains the input parameters of the pot move transaction
+type MovePotMoneyTxParams struct {
+	PotAccountID int64 `json:"pot_account_id"`
+	Amount       int64 `json:"amount"`
+	// Withdraw moves the money from the pot back to its parent account.
+	Withdraw bool `json:"withdraw"`
+}
+
+// MovePotMoneyTxResult is the result of the pot move transaction
+type MovePotMoneyTxResult struct {
+	Pot           Pot      `json:"pot"`
+	Transfer      Transfer `json:"transfer"`
+	ParentAccount Account  `json:"parent_account"`
+	PotAccount    Account  `json:"pot_account"`
+}
+
+// MovePotMoneyTx moves money between a pot and its parent account. The move is
+// an ordinary transfer without fees, and it fails rather than overdraw either side.
+func (store *SQLStore) MovePotMoneyTx(ctx context.Context, arg MovePotMoneyTxParams) (MovePotMoneyTxResult, error) {
+	var result MovePotMoneyTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		if arg.Amount <= 0 {
+			return ErrInvalidAmount
+		}
+
+		result.Pot, err = q.GetPot(ctx, arg.PotAccountID)
+		if err != nil {
+			return err
+		}
+
+		transferArg := TransferTxParams{
+			FromAccountID: result.Pot.ParentAccountID,
+			ToAccountID:   result.Pot.AccountID,
+			Amount:        arg.Amount,
+			Description:   "Moved to pot " + result.Pot.Name,
+		}
+		if arg.Withdraw {
+			transferArg.FromAccountID, transferArg.ToAccountID = transferArg.ToAccountID, transferArg.FromAccountID
+			transferArg.Description = "Moved from pot " + result.Pot.Name
+		}
+
+		transfer, err := customerTransfer(ctx, q, transferArg)
+		if err != nil {
+			return err
+		}
+		if transfer.FromAccount.Balance < 0 {
+			return ErrInsufficientFunds
+		}
+
+		result.Transfer = transfer.Transfer
+		result.ParentAccount, result.PotAccount = transfer.FromAccount, transfer.ToAccount
+		if arg.Withdraw {
+			result.ParentAccount, result.PotAccount = transfer.ToAccount, transfer.FromAccount
+		}
+		return nil
+	})
+
+	return result, err
+}
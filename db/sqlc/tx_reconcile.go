@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of reconciliation findings
+const (
+	FindingBalanceMismatch    = "balance_mismatch"
+	FindingUnbalancedTransfer = "unbalanced_transfer"
+)
+
+// ReconcileTxResult is the result of the reconciliation transaction
+type ReconcileTxResult struct {
+	RunID    uuid.UUID               `json:"run_id"`
+	Findings []ReconciliationFinding `json:"findings"`
+}
+
+// ReconcileTx checks the ledger invariants and records every discrepancy as a
+// finding of a new run: each account balance must equal the sum of its entries,
+// and each transfer must have exactly one debit and one credit entry that cancel out.
+func (store *SQLStore) ReconcileTx(ctx context.Context) (ReconcileTxResult, error) {
+	var result ReconcileTxResult
+
+	runID, err := uuid.NewRandom()
+	if err != nil {
+		return result, err
+	}
+	result.RunID = runID
+
+	err = store.execTx(ctx, func(q *Queries) error {
+		mismatches, err := q.ListBalanceMismatches(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, mismatch := range mismatches {
+			finding, err := q.CreateReconciliationFinding(ctx, CreateReconciliationFindingParams{
+				RunID:     runID,
+				Kind:      FindingBalanceMismatch,
+				AccountID: sql.NullInt64{Int64: mismatch.ID, Valid: true},
+				Expected:  mismatch.EntriesTotal,
+				Actual:    mismatch.Balance,
+				Details:   fmt.Sprintf("balance differs from sum of entries by %d", mismatch.Balance-mismatch.EntriesTotal),
+			})
+			if err != nil {
+				return err
+			}
+			result.Findings = append(result.Findings, finding)
+		}
+
+		transfers, err := q.ListUnbalancedTransfers(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, transfer := range transfers {
+			finding, err := q.CreateReconciliationFinding(ctx, CreateReconciliationFindingParams{
+				RunID:      runID,
+				Kind:       FindingUnbalancedTransfer,
+				TransferID: sql.NullInt64{Int64: transfer.ID, Valid: true},
+				Expected:   0,
+				Actual:     transfer.EntriesTotal,
+				Details:    fmt.Sprintf("transfer of %d has %d entries", transfer.Amount, transfer.EntryCount),
+			})
+			if err != nil {
+				return err
+			}
+			result.Findings = append(result.Findings, finding)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrOutboxUnavailable is returned by Publish, wrapped, when the events cannot
+// be handed on at all, e.g. because the queue is down. Such a failure says
+// nothing about the event, so it is not counted against it.
+var ErrOutboxUnavailable = errors.New("outbox queue is unavailable")
+
+type RelayOutboxTxParams struct {
+	// Limit caps the number of events published per call.
+	Limit int32
+	// MaxAttempts leaves events that failed this many times for an operator to look at.
+	MaxAttempts int32
+	// RetryDelay returns how long an event that failed to publish for the
+	// given number of times waits before it is retried.
+	RetryDelay func(attempts int32) time.Duration
+	Publish    func(event OutboxEvent) error
+}
+
+// RelayOutboxTxResult is the result of the relay outbox transaction
+type RelayOutboxTxResult struct {
+	Published int
+	Failed    int
+}
+
+// RelayOutboxTx hands the oldest pending outbox events to Publish and marks the
+// ones it accepted as published. An event Publish rejects is retried after
+// RetryDelay. When Publish returns ErrOutboxUnavailable the relay stops, keeps
+// what it published so far and returns the error, leaving the other events
+// untouched. Events are locked with SKIP LOCKED, so several relays can run side
+// by side. Publish may see an event again if the transaction fails to commit
+// after it returned, so delivery is at least once and consumers drop duplicates
+// by event ID.
+func (store *SQLStore) RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error) {
+	var result RelayOutboxTxResult
+	var unavailable error
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		events, err := q.ListPendingOutboxEvents(ctx, ListPendingOutboxEventsParams{
+			MaxAttempts: arg.MaxAttempts,
+			RowLimit:    arg.Limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if publishErr := arg.Publish(event); publishErr != nil {
+				if errors.Is(publishErr, ErrOutboxUnavailable) {
+					unavailable = publishErr
+					return nil
+				}
+				err = q.RecordOutboxEventFailure(ctx, RecordOutboxEventFailureParams{
+					ID:            event.ID,
+					LastError:     publishErr.Error(),
+					NextAttemptAt: time.Now().Add(arg.RetryDelay(event.Attempts + 1)),
+				})
+				result.Failed++
+			} else {
+				err = q.MarkOutboxEventPublished(ctx, event.ID)
+				result.Published++
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	return result, unavailable
+}
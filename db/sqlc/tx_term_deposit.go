@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+// Constants for the status of a term deposit
+const (
+	TermDepositActive  = "active"
+	TermDepositPaidOut = "paid_out"
+	TermDepositBroken  = "broken"
+)
+
+const (
+	// TermDepositDescription describes the funding of a term deposit on statements.
+	TermDepositDescription = "Term deposit"
+	// TermDepositPayoutDescription describes the payout of a term deposit on statements.
+	TermDepositPayoutDescription = "Term deposit payout"
+)
+
+var (
+	ErrInvalidDepositSource = errors.New("term deposits can only be funded from customer accounts")
+	ErrUnsupportedTerm      = errors.New("no term deposit is offered for this term")
+	ErrTermDepositLocked    = errors.New("money in a term deposit is locked until it matures")
+	ErrTermDepositClosed    = errors.New("term deposit has already been closed")
+	ErrTermDepositNotDue    = errors.New("term deposit has not matured yet")
+	ErrTermDepositMatured   = errors.New("term deposit has matured and can no longer be broken")
+)
+
+// OpenTermDepositTxParams contains the input parameters of the open term deposit transaction
+type OpenTermDepositTxParams struct {
+	SourceAccountID int64     `json:"source_account_id"`
+	Amount          int64     `json:"amount"`
+	TermMonths      int32     `json:"term_months"`
+	OnMaturity      string    `json:"on_maturity"`
+	StartsOn        time.Time `json:"starts_on"`
+	// AccountNumber is given to the account that holds the money of the deposit.
+	AccountNumber string `json:"account_number"`
+}
+
+// OpenTermDepositTxResult is the result of the open term deposit transaction
+type OpenTermDepositTxResult struct {
+	TermDeposit TermDeposit      `json:"term_deposit"`
+	Account     Account          `json:"account"`
+	Transfer    TransferTxResult `json:"transfer"`
+}
+
+// OpenTermDepositTx opens an account for a term deposit, held by the owner of
+// the source account, and moves the amount into it at the current rate of the
+// term. Like pots, the account has no members, so nobody can send money out of
+// it; the deposit is only ever paid back to the source account.
+func (store *SQLStore) OpenTermDepositTx(ctx context.Context, arg OpenTermDepositTxParams) (OpenTermDepositTxResult, error) {
+	var result OpenTermDepositTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.Amount <= 0 {
+			return ErrInvalidAmount
+		}
+
+		source, err := q.GetAccount(ctx, arg.SourceAccountID)
+		if err != nil {
+			return err
+		}
+		if !util.IsCustomerProduct(source.ProductCode) {
+			return ErrInvalidDepositSource
+		}
+
+		rate, err := q.GetTermDepositRate(ctx, arg.TermMonths)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrUnsupportedTerm
+			}
+			return err
+		}
+
+		result.Account, err = q.CreateAccount(ctx, CreateAccountParams{
+			Owner:         source.Owner,
+			Currency:      source.Currency,
+			ProductCode:   util.ProductTermDeposit,
+			AccountNumber: arg.AccountNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Transfer, err = customerTransfer(ctx, q, TransferTxParams{
+			FromAccountID: source.ID,
+			ToAccountID:   result.Account.ID,
+			Amount:        arg.Amount,
+			Description:   TermDepositDescription,
+		})
+		if err != nil {
+			return err
+		}
+		if result.Transfer.FromAccount.Balance < 0 {
+			return ErrInsufficientFunds
+		}
+		result.Account = result.Transfer.ToAccount
+
+		result.TermDeposit, err = q.CreateTermDeposit(ctx, CreateTermDepositParams{
+			AccountID:            result.Account.ID,
+			SourceAccountID:      source.ID,
+			TermMonths:           rate.TermMonths,
+			AnnualRateBps:        rate.AnnualRateBps,
+			EarlyBreakPenaltyBps: rate.EarlyBreakPenaltyBps,
+			Principal:            arg.Amount,
+			StartsOn:             arg.StartsOn,
+			MaturesOn:            util.AddMonths(arg.StartsOn, int(rate.TermMonths)),
+			OnMaturity:           arg.OnMaturity,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// SettleTermDepositTxResult is the result of maturing or breaking a term deposit.
+// Payout is left empty when a matured deposit is rolled over.
+type SettleTermDepositTxResult struct {
+	TermDeposit TermDeposit      `json:"term_deposit"`
+	Interest    int64            `json:"interest"`
+	Payout      TransferTxResult `json:"payout"`
+}
+
+// MatureTermDepositTx pays the interest of a term deposit that matured by asOf
+// into it, then either pays everything out to the source account or rolls it
+// over for another term at the current rate, compounding the interest. A
+// deposit that is already closed is returned unchanged.
+func (store *SQLStore) MatureTermDepositTx(ctx context.Context, accountID int64, asOf time.Time) (SettleTermDepositTxResult, error) {
+	var result SettleTermDepositTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.TermDeposit, err = q.GetTermDepositForUpdate(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		deposit := result.TermDeposit
+		if deposit.Status != TermDepositActive {
+			return nil
+		}
+		if deposit.MaturesOn.After(asOf) {
+			return ErrTermDepositNotDue
+		}
+
+		result.Interest = util.TermInterest(deposit.Principal, deposit.AnnualRateBps, deposit.StartsOn, deposit.MaturesOn)
+		account, err := payDepositInterest(ctx, q, deposit, result.Interest)
+		if err != nil {
+			return err
+		}
+
+		if deposit.OnMaturity != util.MaturityRollover {
+			result.Payout, result.TermDeposit, err = payOutDeposit(ctx, q, deposit, account.Balance, TermDepositPaidOut)
+			return err
+		}
+
+		rate, err := q.GetTermDepositRate(ctx, deposit.TermMonths)
+		if err != nil {
+			return err
+		}
+
+		result.TermDeposit, err = q.RenewTermDeposit(ctx, RenewTermDepositParams{
+			AccountID:            deposit.AccountID,
+			AnnualRateBps:        rate.AnnualRateBps,
+			EarlyBreakPenaltyBps: rate.EarlyBreakPenaltyBps,
+			Principal:            account.Balance,
+			StartsOn:             deposit.MaturesOn,
+			MaturesOn:            util.AddMonths(deposit.MaturesOn, int(deposit.TermMonths)),
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// BreakTermDepositTx closes a term deposit before it matures and pays it out to
+// the source account. Interest for the days it ran is paid at the rate of the
+// deposit less its early break penalty.
+func (store *SQLStore) BreakTermDepositTx(ctx context.Context, accountID int64, brokenOn time.Time) (SettleTermDepositTxResult, error) {
+	var result SettleTermDepositTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		deposit, err := q.GetTermDepositForUpdate(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if deposit.Status != TermDepositActive {
+			return ErrTermDepositClosed
+		}
+		if !brokenOn.Before(deposit.MaturesOn) {
+			return ErrTermDepositMatured
+		}
+
+		rate := max(deposit.AnnualRateBps-deposit.EarlyBreakPenaltyBps, 0)
+		result.Interest = util.TermInterest(deposit.Principal, rate, deposit.StartsOn, brokenOn)
+		account, err := payDepositInterest(ctx, q, deposit, result.Interest)
+		if err != nil {
+			return err
+		}
+
+		result.Payout, result.TermDeposit, err = payOutDeposit(ctx, q, deposit, account.Balance, TermDepositBroken)
+		return err
+	})
+
+	return result, err
+}
+
+// payDepositInterest moves the interest of a term deposit from the interest
+// expense account of its currency into it and returns the deposit account.
+func payDepositInterest(ctx context.Context, q *Queries, deposit TermDeposit, interest int64) (Account, error) {
+	account, err := q.GetAccount(ctx, deposit.AccountID)
+	if err != nil || interest <= 0 {
+		return account, err
+	}
+
+	expense, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+		Owner:    InterestExpenseOwner,
+		Currency: account.Currency,
+	})
+	if err != nil {
+		return account, err
+	}
+
+	transfer, err := transferMoney(ctx, q, TransferTxParams{
+		FromAccountID: expense.ID,
+		ToAccountID:   account.ID,
+		Amount:        interest,
+		Description:   InterestDescription,
+	})
+	return transfer.ToAccount, err
+}
+
+// payOutDeposit moves the whole balance of a term deposit back to its source
+// account and closes the deposit with the given status.
+func payOutDeposit(ctx context.Context, q *Queries, deposit TermDeposit, balance int64, status string) (TransferTxResult, TermDeposit, error) {
+	var transfer TransferTxResult
+	var err error
+
+	if balance > 0 {
+		transfer, err = customerTransfer(ctx, q, TransferTxParams{
+			FromAccountID: deposit.AccountID,
+			ToAccountID:   deposit.SourceAccountID,
+			Amount:        balance,
+			Description:   TermDepositPayoutDescription,
+		})
+		if err != nil {
+			return transfer, deposit, err
+		}
+	}
+
+	deposit, err = q.CloseTermDeposit(ctx, CloseTermDepositParams{
+		AccountID: deposit.AccountID,
+		Status:    status,
+	})
+	return transfer, deposit, err
+}
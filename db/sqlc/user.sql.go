@@ -20,7 +20,7 @@ INSERT INTO
     )
 VALUES ($1, $2, $3, $4)
 RETURNING
-    username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified
+    username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, language
 `
 
 type CreateUserParams struct {
@@ -46,12 +46,14 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.Language,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified FROM users WHERE username = $1 LIMIT 1
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, language FROM users WHERE username = $1 LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
@@ -65,6 +67,67 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.Language,
+	)
+	return i, err
+}
+
+const getUserByVerifiedEmail = `-- name: GetUserByVerifiedEmail :one
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, language
+FROM users
+WHERE
+    lower(email) = lower($1)
+    AND is_email_verified
+LIMIT 1
+`
+
+func (q *Queries) GetUserByVerifiedEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByVerifiedEmail, email)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.Language,
+	)
+	return i, err
+}
+
+const markEmailVerified = `-- name: MarkEmailVerified :one
+UPDATE users
+SET
+    is_email_verified = TRUE
+WHERE
+    username = $1
+    AND email = $2
+RETURNING
+    username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, language
+`
+
+type MarkEmailVerifiedParams struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+func (q *Queries) MarkEmailVerified(ctx context.Context, arg MarkEmailVerifiedParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, markEmailVerified, arg.Username, arg.Email)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.Language,
 	)
 	return i, err
 }
@@ -84,15 +147,18 @@ SET
         $3,
         full_name
     ),
-    email = COALESCE($4, email)
-    -- is_email_verified = COALESCE(
-    --     sqlc.narg (is_email_verified),
-    --     is_email_verified
-    -- )
+    email = COALESCE($4, email),
+    language = COALESCE($5, language),
+    -- a new address has to be verified again
+    is_email_verified = CASE
+        WHEN $4 IS NULL
+        OR $4 = email THEN is_email_verified
+        ELSE FALSE
+    END
 WHERE
-    username = $5
+    username = $6
 RETURNING
-    username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified
+    username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, language
 `
 
 type UpdateUserParams struct {
@@ -100,6 +166,7 @@ type UpdateUserParams struct {
 	PasswordChangedAt sql.NullTime   `json:"password_changed_at"`
 	FullName          sql.NullString `json:"full_name"`
 	Email             sql.NullString `json:"email"`
+	Language          sql.NullString `json:"language"`
 	Username          string         `json:"username"`
 }
 
@@ -109,6 +176,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.PasswordChangedAt,
 		arg.FullName,
 		arg.Email,
+		arg.Language,
 		arg.Username,
 	)
 	var i User
@@ -120,6 +188,8 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.Language,
 	)
 	return i, err
 }
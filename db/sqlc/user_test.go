@@ -2,9 +2,12 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/nhat195/simple_bank/util"
 	"github.com/stretchr/testify/require"
 )
@@ -50,3 +53,94 @@ func TestGetUser(t *testing.T) {
 	require.WithinDuration(t, user1.PasswordChangedAt, user2.PasswordChangedAt, time.Second)
 	require.WithinDuration(t, user1.CreatedAt, user2.CreatedAt, time.Second)
 }
+
+func TestVerifyEmailTx(t *testing.T) {
+	user := createRandomUser(t)
+	store := NewStore(testDB)
+
+	verifyEmail, err := testQueries.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		Username:   user.Username,
+		Email:      user.Email,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	pending, err := testQueries.GetPendingVerifyEmail(context.Background(), GetPendingVerifyEmailParams{
+		Username: user.Username,
+		Email:    user.Email,
+	})
+	require.NoError(t, err)
+	require.Equal(t, verifyEmail.ID, pending.ID)
+
+	_, err = store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: util.RandomString(32),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	result, err := store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+	require.True(t, result.User.IsEmailVerified)
+	require.True(t, result.VerifyEmail.IsUsed)
+
+	// the link only works once, and is no longer pending
+	_, err = store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQueries.GetPendingVerifyEmail(context.Background(), GetPendingVerifyEmailParams{
+		Username: user.Username,
+		Email:    user.Email,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestUpdateUserEmailResetsVerification(t *testing.T) {
+	user := createRandomUser(t)
+
+	user, err := testQueries.MarkEmailVerified(context.Background(), MarkEmailVerifiedParams{
+		Username: user.Username,
+		Email:    user.Email,
+	})
+	require.NoError(t, err)
+	require.True(t, user.IsEmailVerified)
+
+	// other changes, or the same address again, keep it verified
+	user, err = testQueries.UpdateUser(context.Background(), UpdateUserParams{
+		Username: user.Username,
+		FullName: sql.NullString{String: util.RandomOwner(), Valid: true},
+		Email:    sql.NullString{String: user.Email, Valid: true},
+	})
+	require.NoError(t, err)
+	require.True(t, user.IsEmailVerified)
+
+	newEmail := util.RandomEmail()
+	user, err = testQueries.UpdateUser(context.Background(), UpdateUserParams{
+		Username: user.Username,
+		Email:    sql.NullString{String: newEmail, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, newEmail, user.Email)
+	require.False(t, user.IsEmailVerified)
+}
+
+func TestCreateUserEmailIsUniqueIgnoringCase(t *testing.T) {
+	user := createRandomUser(t)
+
+	_, err := testQueries.CreateUser(context.Background(), CreateUserParams{
+		Username:       util.RandomOwner(),
+		HashedPassword: user.HashedPassword,
+		FullName:       util.RandomOwner(),
+		Email:          strings.ToUpper(user.Email),
+	})
+	require.Error(t, err)
+
+	var pqErr *pq.Error
+	require.ErrorAs(t, err, &pqErr)
+	require.Equal(t, "unique_violation", string(pqErr.Code.Name()))
+}
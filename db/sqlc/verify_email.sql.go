@@ -40,6 +40,38 @@ func (q *Queries) CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailPa
 	return i, err
 }
 
+const getPendingVerifyEmail = `-- name: GetPendingVerifyEmail :one
+SELECT id, username, email, secret_code, is_used, created_at, expired_at
+FROM verify_emails
+WHERE
+    username = $1
+    AND email = $2
+    AND is_used = FALSE
+    AND expired_at > now()
+ORDER BY id DESC
+LIMIT 1
+`
+
+type GetPendingVerifyEmailParams struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+func (q *Queries) GetPendingVerifyEmail(ctx context.Context, arg GetPendingVerifyEmailParams) (VerifyEmail, error) {
+	row := q.db.QueryRowContext(ctx, getPendingVerifyEmail, arg.Username, arg.Email)
+	var i VerifyEmail
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
 const updateVerifyEmail = `-- name: UpdateVerifyEmail :one
 UPDATE verify_emails
 SET
@@ -0,0 +1,11 @@
+package db
+
+// Constants for the status of a webhook delivery
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookEventTypes are the outbox events partners can subscribe webhook endpoints to.
+var WebhookEventTypes = []string{EventTransferCompleted}
@@ -0,0 +1,389 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: webhook.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO
+    webhook_deliveries (
+        endpoint_id,
+        event_id,
+        event_type,
+        payload
+    )
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (endpoint_id, event_id) DO UPDATE
+SET
+    endpoint_id = EXCLUDED.endpoint_id
+RETURNING
+    id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, last_error, created_at, delivered_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	EndpointID int64           `json:"endpoint_id"`
+	EventID    uuid.UUID       `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, createWebhookDelivery,
+		arg.EndpointID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const createWebhookEndpoint = `-- name: CreateWebhookEndpoint :one
+INSERT INTO
+    webhook_endpoints (
+        username,
+        url,
+        secret,
+        event_types
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    id, username, url, secret, event_types, active, created_at
+`
+
+type CreateWebhookEndpointParams struct {
+	Username   string   `json:"username"`
+	Url        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
+
+func (q *Queries) CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, createWebhookEndpoint,
+		arg.Username,
+		arg.Url,
+		arg.Secret,
+		pq.Array(arg.EventTypes),
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.EventTypes),
+		&i.Active,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deactivateWebhookEndpoint = `-- name: DeactivateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET
+    active = false
+WHERE
+    id = $1
+RETURNING
+    id, username, url, secret, event_types, active, created_at
+`
+
+func (q *Queries) DeactivateWebhookEndpoint(ctx context.Context, id int64) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, deactivateWebhookEndpoint, id)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.EventTypes),
+		&i.Active,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, last_error, created_at, delivered_at FROM webhook_deliveries WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDelivery, id)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const getWebhookEndpoint = `-- name: GetWebhookEndpoint :one
+SELECT id, username, url, secret, event_types, active, created_at FROM webhook_endpoints WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetWebhookEndpoint(ctx context.Context, id int64) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookEndpoint, id)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.EventTypes),
+		&i.Active,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, last_error, created_at, delivered_at
+FROM webhook_deliveries
+WHERE
+    endpoint_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	EndpointID int64 `json:"endpoint_id"`
+	Limit      int32 `json:"limit"`
+	Offset     int32 `json:"offset"`
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveries, arg.EndpointID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookEndpoints = `-- name: ListWebhookEndpoints :many
+SELECT id, username, url, secret, event_types, active, created_at
+FROM webhook_endpoints
+WHERE
+    username = $1
+    AND active
+ORDER BY id
+`
+
+func (q *Queries) ListWebhookEndpoints(ctx context.Context, username string) ([]WebhookEndpoint, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookEndpoints, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookEndpoint{}
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Url,
+			&i.Secret,
+			pq.Array(&i.EventTypes),
+			&i.Active,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookEndpointsForAccounts = `-- name: ListWebhookEndpointsForAccounts :many
+SELECT id, username, url, secret, event_types, active, created_at
+FROM webhook_endpoints
+WHERE
+    active
+    AND $1::varchar = ANY (event_types)
+    AND username IN (
+        SELECT m.username
+        FROM account_members m
+        WHERE
+            m.account_id = ANY ($2::bigint[])
+    )
+ORDER BY id
+`
+
+type ListWebhookEndpointsForAccountsParams struct {
+	EventType  string  `json:"event_type"`
+	AccountIds []int64 `json:"account_ids"`
+}
+
+func (q *Queries) ListWebhookEndpointsForAccounts(ctx context.Context, arg ListWebhookEndpointsForAccountsParams) ([]WebhookEndpoint, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookEndpointsForAccounts, arg.EventType, pq.Array(arg.AccountIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookEndpoint{}
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Url,
+			&i.Secret,
+			pq.Array(&i.EventTypes),
+			&i.Active,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordWebhookAttempt = `-- name: RecordWebhookAttempt :one
+UPDATE webhook_deliveries
+SET
+    attempts = attempts + 1,
+    status = $1,
+    response_status = $2,
+    last_error = $3,
+    delivered_at = CASE
+        WHEN $1 = 'succeeded' THEN now()
+        ELSE delivered_at
+    END
+WHERE
+    id = $4
+RETURNING
+    id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, last_error, created_at, delivered_at
+`
+
+type RecordWebhookAttemptParams struct {
+	Status         string `json:"status"`
+	ResponseStatus int32  `json:"response_status"`
+	LastError      string `json:"last_error"`
+	ID             int64  `json:"id"`
+}
+
+func (q *Queries) RecordWebhookAttempt(ctx context.Context, arg RecordWebhookAttemptParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, recordWebhookAttempt,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.ID,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const resetWebhookDelivery = `-- name: ResetWebhookDelivery :one
+UPDATE webhook_deliveries
+SET
+    status = 'pending',
+    last_error = ''
+WHERE
+    id = $1
+RETURNING
+    id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, last_error, created_at, delivered_at
+`
+
+func (q *Queries) ResetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, resetWebhookDelivery, id)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
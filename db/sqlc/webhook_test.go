@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomWebhookEndpoint(t *testing.T, username string) WebhookEndpoint {
+	arg := CreateWebhookEndpointParams{
+		Username:   username,
+		Url:        "https://example.com/" + util.RandomString(8),
+		Secret:     util.NewWebhookSecret(),
+		EventTypes: []string{EventTransferCompleted},
+	}
+
+	endpoint, err := testQueries.CreateWebhookEndpoint(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Url, endpoint.Url)
+	require.Equal(t, arg.EventTypes, endpoint.EventTypes)
+	require.True(t, endpoint.Active)
+	return endpoint
+}
+
+func TestListWebhookEndpointsForAccounts(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	endpoint1 := createRandomWebhookEndpoint(t, account1.Owner)
+	endpoint2 := createRandomWebhookEndpoint(t, account2.Owner)
+
+	_, err := testQueries.CreateWebhookEndpoint(context.Background(), CreateWebhookEndpointParams{
+		Username:   account1.Owner,
+		Url:        "https://example.com/users",
+		Secret:     util.NewWebhookSecret(),
+		EventTypes: []string{EventUserCreated},
+	})
+	require.NoError(t, err)
+
+	endpoints, err := testQueries.ListWebhookEndpointsForAccounts(context.Background(), ListWebhookEndpointsForAccountsParams{
+		EventType:  EventTransferCompleted,
+		AccountIds: []int64{account1.ID, account2.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []WebhookEndpoint{endpoint1, endpoint2}, endpoints)
+
+	_, err = testQueries.DeactivateWebhookEndpoint(context.Background(), endpoint2.ID)
+	require.NoError(t, err)
+
+	endpoints, err = testQueries.ListWebhookEndpointsForAccounts(context.Background(), ListWebhookEndpointsForAccountsParams{
+		EventType:  EventTransferCompleted,
+		AccountIds: []int64{account1.ID, account2.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []WebhookEndpoint{endpoint1}, endpoints)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	account := createRandomAccount(t)
+	endpoint := createRandomWebhookEndpoint(t, account.Owner)
+
+	arg := CreateWebhookDeliveryParams{
+		EndpointID: endpoint.ID,
+		EventID:    uuid.New(),
+		EventType:  EventTransferCompleted,
+		Payload:    json.RawMessage(`{"transfer_id": 1}`),
+	}
+	delivery, err := testQueries.CreateWebhookDelivery(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, WebhookDeliveryPending, delivery.Status)
+
+	// the same event is only delivered once per endpoint
+	again, err := testQueries.CreateWebhookDelivery(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, delivery.ID, again.ID)
+
+	delivery, err = testQueries.RecordWebhookAttempt(context.Background(), RecordWebhookAttemptParams{
+		ID:             delivery.ID,
+		Status:         WebhookDeliveryPending,
+		ResponseStatus: 500,
+		LastError:      "endpoint responded with 500 Internal Server Error",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), delivery.Attempts)
+	require.False(t, delivery.DeliveredAt.Valid)
+
+	delivery, err = testQueries.RecordWebhookAttempt(context.Background(), RecordWebhookAttemptParams{
+		ID:             delivery.ID,
+		Status:         WebhookDeliverySucceeded,
+		ResponseStatus: 200,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), delivery.Attempts)
+	require.True(t, delivery.DeliveredAt.Valid)
+	require.Empty(t, delivery.LastError)
+
+	delivery, err = testQueries.ResetWebhookDelivery(context.Background(), delivery.ID)
+	require.NoError(t, err)
+	require.Equal(t, WebhookDeliveryPending, delivery.Status)
+
+	deliveries, err := testQueries.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		EndpointID: endpoint.ID,
+		Limit:      5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []WebhookDelivery{delivery}, deliveries)
+}
@@ -4,10 +4,12 @@
 
 CREATE TABLE "users" (
   "username" varchar PRIMARY KEY,
+  "role" varchar NOT NULL DEFAULT 'depositor',
   "hashed_password" varchar NOT NULL,
   "full_name" varchar NOT NULL,
   "email" varchar UNIQUE NOT NULL,
   "is_email_verified" bool NOT NULL DEFAULT false,
+  "language" varchar NOT NULL DEFAULT 'en',
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
@@ -27,6 +29,8 @@ CREATE TABLE "accounts" (
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
+  "product_code" varchar NOT NULL DEFAULT 'current',
+  "account_number" varchar UNIQUE NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -34,6 +38,10 @@ CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "transfer_id" bigint,
+  "description" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "counterparty_name" varchar NOT NULL DEFAULT '',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -42,6 +50,9 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "description" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "metadata" jsonb NOT NULL DEFAULT '{}',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -56,22 +67,489 @@ CREATE TABLE "sessions" (
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
-CREATE INDEX ON "accounts" ("owner");
+CREATE TABLE "account_products" (
+  "code" varchar PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "annual_rate_bps" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "annual_rate_bps" bigint NOT NULL,
+  "amount_micros" bigint NOT NULL,
+  "posting_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_postings" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "period_end" date NOT NULL,
+  "accrued_micros" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "carry_micros" bigint NOT NULL,
+  "transfer_id" bigint,
+  "description" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "counterparty_name" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_schedules" (
+  "id" bigserial PRIMARY KEY,
+  "currency" varchar NOT NULL,
+  "product_code" varchar,
+  "flat_fee" bigint NOT NULL DEFAULT 0,
+  "percentage_bps" bigint NOT NULL DEFAULT 0,
+  "min_fee" bigint NOT NULL DEFAULT 0,
+  "max_fee" bigint,
+  "is_active" bool NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "transfer_batches" (
+  "id" uuid PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "total_amount" bigint NOT NULL,
+  "total_fee" bigint NOT NULL DEFAULT 0,
+  "leg_count" int NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "transfer_batch_legs" (
+  "batch_id" uuid NOT NULL,
+  "leg_index" int NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "fee" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("batch_id", "leg_index")
+);
+
+CREATE TABLE "reconciliation_findings" (
+  "id" bigserial PRIMARY KEY,
+  "run_id" uuid NOT NULL,
+  "kind" varchar NOT NULL,
+  "account_id" bigint,
+  "transfer_id" bigint,
+  "expected" bigint NOT NULL,
+  "actual" bigint NOT NULL,
+  "details" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "daily_balances" (
+  "account_id" bigint NOT NULL,
+  "balance_date" date NOT NULL,
+  "as_of" timestamptz NOT NULL,
+  "balance" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "balance_date")
+);
+
+CREATE TABLE "statements" (
+  "id" uuid PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "format" varchar NOT NULL,
+  "period_start" timestamptz NOT NULL,
+  "period_end" timestamptz NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "content" bytea,
+  "failure_reason" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "completed_at" timestamptz
+);
+
+CREATE TABLE "external_imports" (
+  "id" uuid PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "source" varchar NOT NULL,
+  "imported_by" varchar NOT NULL,
+  "row_count" int NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "external_transactions" (
+  "id" bigserial PRIMARY KEY,
+  "import_id" uuid NOT NULL,
+  "account_id" bigint NOT NULL,
+  "line_number" int NOT NULL,
+  "booked_on" date NOT NULL,
+  "amount" bigint NOT NULL,
+  "reference" varchar NOT NULL DEFAULT '',
+  "description" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'unmatched',
+  "transfer_id" bigint,
+  "matched_by" varchar,
+  "matched_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_members" (
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "added_by" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "username")
+);
+
+CREATE TABLE "pots" (
+  "account_id" bigint PRIMARY KEY,
+  "parent_account_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "target_amount" bigint NOT NULL DEFAULT 0,
+  "target_date" date,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "payment_requests" (
+  "id" uuid PRIMARY KEY,
+  "requester" varchar NOT NULL,
+  "payee_account_id" bigint NOT NULL,
+  "payer" varchar NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "message" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "transfer_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "responded_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
 
-CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
+CREATE TABLE "payment_aliases" (
+  "alias_type" varchar NOT NULL,
+  "value" varchar NOT NULL,
+  "username" varchar NOT NULL,
+  "secret_code" varchar NOT NULL DEFAULT '',
+  "verify_attempts" int NOT NULL DEFAULT 0,
+  "code_expired_at" timestamptz NOT NULL DEFAULT (now()),
+  "verified_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("alias_type", "value", "username")
+);
+
+CREATE TABLE "beneficiaries" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "nickname" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "is_verified" bool NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "cards" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "cardholder" varchar NOT NULL,
+  "token" varchar UNIQUE NOT NULL,
+  "masked_number" varchar NOT NULL,
+  "expiry_month" int NOT NULL,
+  "expiry_year" int NOT NULL,
+  "hashed_cvv" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "card_authorizations" (
+  "id" bigserial PRIMARY KEY,
+  "card_id" bigint NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "merchant_name" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loans" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "principal" bigint NOT NULL,
+  "annual_rate_bps" bigint NOT NULL,
+  "term_months" int NOT NULL,
+  "currency" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "disbursement_transfer_id" bigint NOT NULL,
+  "created_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loan_instalments" (
+  "loan_id" bigint NOT NULL,
+  "number" int NOT NULL,
+  "due_date" date NOT NULL,
+  "principal" bigint NOT NULL,
+  "interest" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "paid_amount" bigint NOT NULL DEFAULT 0,
+  "paid_at" timestamptz,
+  PRIMARY KEY ("loan_id", "number")
+);
+
+CREATE TABLE "loan_repayments" (
+  "id" bigserial PRIMARY KEY,
+  "loan_id" bigint NOT NULL,
+  "instalment_number" int NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "term_deposit_rates" (
+  "term_months" int PRIMARY KEY,
+  "annual_rate_bps" bigint NOT NULL,
+  "early_break_penalty_bps" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "term_deposits" (
+  "account_id" bigint PRIMARY KEY,
+  "source_account_id" bigint NOT NULL,
+  "term_months" int NOT NULL,
+  "annual_rate_bps" bigint NOT NULL,
+  "early_break_penalty_bps" bigint NOT NULL,
+  "principal" bigint NOT NULL,
+  "starts_on" date NOT NULL,
+  "matures_on" date NOT NULL,
+  "on_maturity" varchar NOT NULL DEFAULT 'payout',
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "closed_at" timestamptz
+);
+
+CREATE TABLE "outbox_events" (
+  "id" bigserial PRIMARY KEY,
+  "event_id" uuid UNIQUE NOT NULL,
+  "event_type" varchar NOT NULL,
+  "aggregate_id" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "published_at" timestamptz,
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_endpoints" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "url" varchar NOT NULL,
+  "secret" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "endpoint_id" bigint NOT NULL,
+  "event_id" uuid NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "attempts" int NOT NULL DEFAULT 0,
+  "response_status" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "delivered_at" timestamptz
+);
+
+CREATE TABLE "notification_preferences" (
+  "username" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "channels" varchar[] NOT NULL DEFAULT '{}',
+  "threshold" bigint NOT NULL DEFAULT 0,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("username", "event_type")
+);
+
+CREATE INDEX ON "accounts" ("owner");
 
 CREATE INDEX ON "entries" ("account_id");
 
+CREATE INDEX ON "entries" ("transfer_id");
+
 CREATE INDEX ON "transfers" ("from_account_id");
 
 CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+CREATE INDEX ON "transfers" ("to_account_id", "created_at");
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE UNIQUE INDEX ON "interest_postings" ("account_id", "period_end");
+
+CREATE INDEX ON "fee_schedules" ("currency", "product_code");
+
+CREATE INDEX ON "transfer_batches" ("from_account_id");
+
+CREATE INDEX ON "reconciliation_findings" ("run_id");
+
+CREATE INDEX ON "reconciliation_findings" ("created_at");
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+CREATE INDEX ON "entries" ("account_id", "amount");
+
+CREATE INDEX ON "entries" USING GIN ("reference" gin_trgm_ops);
+
+CREATE INDEX ON "daily_balances" ("account_id", "as_of");
+
+CREATE INDEX ON "statements" ("account_id");
+
+CREATE INDEX ON "external_imports" ("account_id");
+
+CREATE UNIQUE INDEX ON "external_transactions" ("import_id", "line_number");
+
+CREATE UNIQUE INDEX ON "external_transactions" ("transfer_id");
+
+CREATE INDEX ON "external_transactions" ("account_id", "status");
+
+CREATE INDEX ON "account_members" ("username");
+
+CREATE INDEX ON "pots" ("parent_account_id");
+
+CREATE UNIQUE INDEX ON "pots" ("parent_account_id", "name");
+
+CREATE INDEX ON "payment_requests" ("requester", "created_at");
+
+CREATE INDEX ON "payment_requests" ("payer", "created_at");
+
+CREATE INDEX ON "payment_aliases" ("username");
+
+CREATE UNIQUE INDEX ON "users" (lower("email"));
+
+CREATE UNIQUE INDEX ON "beneficiaries" ("username", "account_id");
+
+CREATE INDEX ON "cards" ("account_id");
+
+CREATE INDEX ON "card_authorizations" ("card_id", "created_at");
+
+CREATE INDEX ON "loans" ("account_id");
+
+CREATE INDEX ON "loan_instalments" ("due_date");
+
+CREATE INDEX ON "loan_repayments" ("loan_id");
+
+CREATE INDEX ON "term_deposits" ("source_account_id");
+
+CREATE INDEX ON "term_deposits" ("status", "matures_on");
+
+CREATE INDEX ON "outbox_events" ("published_at", "id");
+
+CREATE INDEX ON "outbox_events" ("next_attempt_at", "id");
+
+CREATE INDEX ON "webhook_endpoints" ("username");
+
+CREATE UNIQUE INDEX ON "webhook_deliveries" ("endpoint_id", "event_id");
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative or positive';
 
+COMMENT ON COLUMN "entries"."counterparty_name" IS 'display name of the other side of the transfer';
+
 COMMENT ON COLUMN "transfers"."amount" IS 'must be positive';
 
+COMMENT ON COLUMN "transfers"."reference" IS 'end-to-end reference supplied by the payer';
+
+COMMENT ON COLUMN "accounts"."account_number" IS 'IBAN-style number with mod-97 check digits given to customers instead of the id';
+
+COMMENT ON COLUMN "account_products"."annual_rate_bps" IS 'annual interest rate in basis points';
+
+COMMENT ON COLUMN "interest_accruals"."amount_micros" IS 'interest in millionths of the minor currency unit';
+
+COMMENT ON COLUMN "interest_postings"."carry_micros" IS 'sub-unit remainder carried into the next posting';
+
+COMMENT ON COLUMN "fee_schedules"."product_code" IS 'null applies to every product';
+
+COMMENT ON COLUMN "fee_schedules"."max_fee" IS 'null means uncapped';
+
+COMMENT ON COLUMN "reconciliation_findings"."kind" IS 'balance_mismatch or unbalanced_transfer';
+
+COMMENT ON COLUMN "daily_balances"."as_of" IS 'end of balance_date; the balance excludes entries created at or after it';
+
+COMMENT ON COLUMN "statements"."status" IS 'pending, ready or failed';
+
+COMMENT ON COLUMN "external_transactions"."amount" IS 'signed from the view of the account: negative leaves it';
+
+COMMENT ON COLUMN "external_transactions"."status" IS 'unmatched, auto_matched or confirmed';
+
+COMMENT ON COLUMN "account_members"."role" IS 'owner, co_owner or viewer';
+
+COMMENT ON COLUMN "pots"."account_id" IS 'the account holding the money of the pot';
+
+COMMENT ON COLUMN "pots"."target_amount" IS 'zero means no target';
+
+COMMENT ON COLUMN "payment_requests"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "payment_requests"."status" IS 'pending, accepted or declined; a pending request past expires_at is expired';
+
+COMMENT ON COLUMN "payment_aliases"."alias_type" IS 'phone or handle; usernames and verified emails resolve without a row';
+
+COMMENT ON COLUMN "payment_aliases"."value" IS 'normalized: E.164 phone number or lowercase handle';
+
+COMMENT ON COLUMN "payment_aliases"."secret_code" IS 'sent to the phone number; cleared once verified';
+
+COMMENT ON COLUMN "payment_aliases"."verified_at" IS 'null until the user proves the alias is theirs; only verified aliases resolve';
+
+COMMENT ON COLUMN "beneficiaries"."is_verified" IS 'the holder name given when the payee was added matched the account';
+
+COMMENT ON COLUMN "cards"."token" IS 'HMAC of the card number, which is never stored';
+
+COMMENT ON COLUMN "cards"."status" IS 'active, frozen or cancelled';
+
+COMMENT ON COLUMN "loans"."account_id" IS 'the account the loan is paid into and repaid from';
+
+COMMENT ON COLUMN "loans"."status" IS 'active or paid_off';
+
+COMMENT ON COLUMN "loan_instalments"."paid_at" IS 'set once paid_amount reaches amount; an unpaid instalment past due_date is in arrears';
+
+COMMENT ON COLUMN "term_deposit_rates"."early_break_penalty_bps" IS 'taken off the annual rate when a deposit is broken before maturity';
+
+COMMENT ON COLUMN "term_deposits"."account_id" IS 'the account holding the money of the deposit';
+
+COMMENT ON COLUMN "term_deposits"."source_account_id" IS 'the account the deposit was funded from and is paid out to';
+
+COMMENT ON COLUMN "term_deposits"."annual_rate_bps" IS 'the rate of the term when the deposit was opened or last rolled over';
+
+COMMENT ON COLUMN "term_deposits"."on_maturity" IS 'payout or rollover';
+
+COMMENT ON COLUMN "term_deposits"."status" IS 'active, paid_out or broken';
+
+COMMENT ON COLUMN "outbox_events"."event_id" IS 'sent along with the event so consumers can drop duplicates';
+
+COMMENT ON COLUMN "outbox_events"."aggregate_id" IS 'the user, transfer, ... the event is about';
+
+COMMENT ON COLUMN "outbox_events"."published_at" IS 'null until the relay has handed the event to the queue';
+
+COMMENT ON COLUMN "outbox_events"."next_attempt_at" IS 'an event that failed to publish waits until then before it is retried';
+
+COMMENT ON COLUMN "webhook_endpoints"."secret" IS 'deliveries are signed with HMAC-SHA256 under this secret';
+
+COMMENT ON COLUMN "webhook_endpoints"."event_types" IS 'the outbox event types delivered to the endpoint';
+
+COMMENT ON COLUMN "webhook_deliveries"."event_id" IS 'the outbox event delivered; one delivery per endpoint and event';
+
+COMMENT ON COLUMN "webhook_deliveries"."status" IS 'pending, succeeded or failed';
+
+COMMENT ON COLUMN "webhook_deliveries"."response_status" IS 'HTTP status of the last attempt, 0 if no response was received';
+
+COMMENT ON COLUMN "notification_preferences"."event_type" IS 'incoming_transfer, low_balance, large_debit or new_login';
+
+COMMENT ON COLUMN "notification_preferences"."channels" IS 'where the alert is sent; empty turns it off';
+
+COMMENT ON COLUMN "notification_preferences"."threshold" IS 'low_balance: balance to fall below, large_debit: smallest debit, in the minor unit of the account';
+
+COMMENT ON COLUMN "users"."language" IS 'language emails and alerts are written in';
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
@@ -83,3 +561,101 @@ ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("posting_id") REFERENCES "interest_postings" ("id");
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "fee_schedules" ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+ALTER TABLE "transfer_batches" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_batch_legs" ADD FOREIGN KEY ("batch_id") REFERENCES "transfer_batches" ("id");
+
+ALTER TABLE "transfer_batch_legs" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "reconciliation_findings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "reconciliation_findings" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "daily_balances" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "statements" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "statements" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "external_imports" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "external_imports" ADD FOREIGN KEY ("imported_by") REFERENCES "users" ("username");
+
+ALTER TABLE "external_transactions" ADD FOREIGN KEY ("import_id") REFERENCES "external_imports" ("id");
+
+ALTER TABLE "external_transactions" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "external_transactions" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "external_transactions" ADD FOREIGN KEY ("matched_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("added_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "pots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pots" ADD FOREIGN KEY ("parent_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payee_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payer") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "payment_aliases" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "beneficiaries" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "beneficiaries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "cards" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "cards" ADD FOREIGN KEY ("cardholder") REFERENCES "users" ("username");
+
+ALTER TABLE "card_authorizations" ADD FOREIGN KEY ("card_id") REFERENCES "cards" ("id");
+
+ALTER TABLE "card_authorizations" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursement_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("created_by") REFERENCES "users" ("username");
+
+ALTER TABLE "loan_instalments" ADD FOREIGN KEY ("loan_id") REFERENCES "loans" ("id");
+
+ALTER TABLE "loan_repayments" ADD FOREIGN KEY ("loan_id", "instalment_number") REFERENCES "loan_instalments" ("loan_id", "number");
+
+ALTER TABLE "loan_repayments" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "term_deposits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "term_deposits" ADD FOREIGN KEY ("source_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "term_deposits" ADD FOREIGN KEY ("term_months") REFERENCES "term_deposit_rates" ("term_months");
+
+ALTER TABLE "webhook_endpoints" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("endpoint_id") REFERENCES "webhook_endpoints" ("id");
+
+ALTER TABLE "notification_preferences" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
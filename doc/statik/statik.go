@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x92\x18BY\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00simple_bank.swagger.jsonUT\x05\x00\x01D\xb8\xfcf\xecXM\x8f\xdb6\x10\xbd\xfbW\x10l\x8f[\xcbv\x91C|\x8a\x1b\x14h\x80 \x08\x9a\xe6T\x04\x01M\x8d$&\x12\xc9\x90\xc3\xfd@\xe1\xff^\x90\x96,J\xb6\xba\xae\xe46k`u\xda%g\x1e\xdf|<q\xe4\xbff\x84P{\xc7\xf2\x1c\x0c]\x13\xba\x9a/\xe8\x8d_\x132StM\xfc>!\x14\x05\x96\xe0\xf7?\x88J\x97@~a\xf2+\xd9\xbc\x7f\x13l	\xa1\xb7`\xacP\xd2[,\xe7\xabf\x95+\x89\x8c\xe3\x01\x86\x10*Y\x15p~S2'\xef\n\x86\xe4-\xd4\xe6\x84PgJ\xbfY j\xbbN\x92\\`\xe1\xb6s\xae\xaa\xa4P2\x97\x05\xc3\xe5\xcb\x17\xad9TL\xec\x1d\xea\xddy	\xcb\x97\x8b\x17\x8b\xe5\xab\xdcoyO\x1a\x8cw3Bv!.d\xb9\xa5k\xf2gX>\xa2\xb5\x0f\xcfG\xd7\xfa}\n~\\I\xeb*h})\xd3\xba\x14\x9c\xa1P2\xf9b\x95\xa4\x07[mT\xea\xf8\x99\xb6\x0c\x0b\xdb&:\xb9]&\xdc\x00C\xf8\xecl(\xc9\x81\xa3V6N%!Ti0\x01\xf3M\xda%\xff\xf9u\x80\xf8\xe8\x11nZ{\x03V+i\xc1v`\x08\xa1\xab\xc5\xa2\xb7D\x08M\xc1r#4\xd6u\xdd\x10\xeb8\x07k3W\x92\x06i\x1e\xc1\x07'\xcb\x0b\xa8\xd8\x11\x18!\xf4G\x03\x99\xc7\xf9!I!\x13Rx\\\x9b\xe8mK\xf5\xf7\x1a\x94v\\w\xd1\x7f\xbb\xf84\x9aB\xc6\\\x89\x8f3\x97\xc4I\xb8\xd7\xc0\x11R\x02\xc6(s\xb9\x00\x8c\xe6\x1f\x90\xa1\xb3\xff\xc0\xfa\xf0w\xc4\x9fjfX\x05\x08\xa6m\x93\xfd\xd3\x0b\xa6i\xcd\xadJ\x1f\xfad\x85\x1c\xda1\xf0\xcd	\x03\xbe/\xd08\x98\x18d\xb7J\xdf\x1cX<'\xdcOQ\xb8\x1d\xdd\xd5k}\xb5\x05\x9fY\x8cR',\xc8\xa2T\xb9\x90\x93T\xf1\xd6#\\\x85(\x0eL\x9f5\x11\x9e'\xa9\x89\xa8H\xdfI\x12N\xa7'o\n\x86\xbc8W\x14\x1f\x03\xc6U\xa8\xa2\xa5\xfa,\x8b\xf0<IY\xc4U\xfa?uq\x18\xf1\":\xed`u\xea\n\x8b$\x83\x0f:\xa4Nm\xbf\x00\xc7v\xc4\xd4\xc6+\x07EO\x08\xd4K\xaeNwG\x1e\x0d\x8eE#dNO\x963se\xf9n\xa4o3\xf2\xfekG\xcd\xac\xbdS&=\xdb\xb7\x9b\xdc\x9bSY\xac581\x8d}F\x83}\xe5m\x1f\xe5w\xf4R\x9e\xc8nl\x91/\x95\xef\xe3I`b@#\xd3\x1d\x85f\xc1\xda\xe6&9/\xb6\xc8\x99\x85\x8b\xe1\x0f\xf5\x15\xe4\x18w\x03\x99\x01[\x8c\xf6\x8f\x8e\xff\xf5^\x0b\x03v\xd3\xbf\x15\xfa8\x9d\x8b$S\xa6b\xde\x83\xfa\x97\xdcO(*x\x9c\xe8\x7fp\xd2@\xb7\x1c\xbf{'v\xcb\xd8\xf6\xbf\xeaw\xdc\x899cb\x1aG\x8an\x88_o\xde\x1b\xc3\xe8*\x0b\xfb\xba`2\x87\xf4\x92B\x8aN\xd9\xff\xeerQ\xf4\xd3\x054\n\xd5\xd6e\x1b\xf90\xa5\x8a\xafj\x87\xf3\xb2\xd909\xc0\xb24\x0dm\xc7\xca\xf7\x9d\x03\xba\\\xdb\x99u\x02S\xae\xd2A\xa2B\"\xe4\x9do\x8fNR\x85\xc4\x9fW\xa7\xcbU\x81\xb5,??\x03\x91k\n\xc8Dy\xf4m\xd3\xb82cXwL\xa6\x02\xa1\xea\xdb\x0fg\xa2\xde\x1dPyT\xfe\xc8~7\xd45~\xae\x9d\xedf\x7f\x07\x00\x00\xff\xffPK\x07\x08\xd5\x05\xb8\xf85\x03\x00\x00\xaa\x15\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x92\x18BY\xd5\x05\xb8\xf85\x03\x00\x00\xaa\x15\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00simple_bank.swagger.jsonUT\x05\x00\x01D\xb8\xfcfPK\x05\x06\x00\x00\x00\x00\x01\x00\x01\x00O\x00\x00\x00\x84\x03\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00{\x98S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00simple_bank.swagger.jsonUT\x05\x00\x01\x9ai\xd6j\xec]_\x93\x9b8\x12\x7f\x9fOAq\xf7\xe8\x8d\x93\xec\xedUm\x9enf\x92\xecMU\x92\xcbM\x92\xdb\xba\xba\xdar\xc9\xd0\xb6\xb51\x12\x11b\x12g\xca\xdf\xfdJ\xfc1\x02da0\x0c\"\xa3yJl\xab\xf9!\xf5\xaf\xffHMs\x7f\xe18n\xf4\x15\xad\xd7\xc0\xdc\x17\x8e\xfb\xfc\xc9Sw&>\xc3dE\xdd\x17\x8e\xf8\xdeq\\\x8e\xf9\x16\xc4\xf7\x1fp\x10n\xc1\xb9B\xe4\xb3s\xf9\xfe&\xf9\xad\xe3\xb8w\xc0\"L\x89\xf8\xc5\xb3'\xcf\xf3O=J8\xf2\xf8A\x8c\xe3\xb8\x04\x05\x89\x9c\x7fR\xb2v\xdem\x10w\xde@\xf6s\xc7qc\xb6\x15_n8\x0f\xa3\x17\xf3\xf9\x1a\xf3M\xbc|\xe2\xd1`\xbe\xa1dM6\x88?\xfb\xf5\x97\xe2\xe7\x10 \x9c\x0e\xc8\xbe}\xb2\x85g\xbf>\xfd\xe5\xe9\xb3\x7f\xac\xc5Wb\xa4\x9b\xdc\xc0\xfe\xc2q\xf6b\x9c\xcb\xd1:r_8\xffK>\xae\xc1JoO\xdc]1\xee\x8fd\x9cGI\x14\x07P\x8cuQ\x18n\xb1\x878\xa6d\xfegD\x89\x18\x91\xfe6d\xd4\x8f\xbd\x13\x7f\x8b\xf8&:\xcc\x90;\xbf{6G\x9eGc\xc2\xa3\xf9r\xb7 q\xb0\x046\xbf\xcf>{\x97\xfcw?_\xa2-\"\x1e\x1c\xc69\x8e\xbb\x06y\xa2\x1d\xc7\xa5!\xb0\xe4\x8a7~\xb1r\xe2\xd6\x16\xbf\x01\xbfJ\x05\\\xf2|\xb1\xc4\x9f\xcb \n)\x89\xa0\x00\x94\xc9z\xfe\xf4i\xe5#\xc7q}\x88<\x86C\x9e-\xfc\xa5\x13\xc5\x9e\x07Q\xb4\x8a\xb7N.\xe9\x89$^\xfc\xb9\x91\xb7\x81\x00\xd5\x849\x8e\xfbW\x06+!\xe7/s\x1fV\x98`!7\x9a\x87K\x19\xedm&\xd6-	\xddK\xff\xdb\xcb\xd7s}X\xa1x[\x9e\x18%v\xe2\xc4\x04\xbe\x85\xe0q\xf0\x1d`\x8c\xb2\xfen\x81\x85\xde\x07\x8ex\x1ciP_(\xf0\xbb!b(\x00\x0e\xac\xd0\xa4\xf4\xaf|\xe1\x83\xf6\x96\x94\xa4:\xf18\xb9Q\xa1n\xd5o\x18|\x891\x03\xa1%\x9c\xc5P\xf9\x96\xefB\xa1gn\xc4\x19&k\xf9\x16\xf6\xb3\x93!\xdd\xf8j8_b`;\x0d\x9e\x15\xdaF\x0d\x80*\xdf\xae(\x0b\x90Xp\x17\x13\xfe\xf7\xbf\xb5\xc6\xcb\xab\x02\x87\x07\xea#\x0e?q\x1c\x80\xab\xd4\x88?\n@e\xeb\x95\xa1\xa8\xda,\xf1\xf7G\xf6\xaf\xfd\x85\xb4P\xa7\x1a\x17\x0f1?\xeahZ\xde\xe0\x88_\x8b\xf1\xe6\xdb\x95\x03TkT\xacQ\x19\xd4\xa8\x84h\x0dC\x80\xc5\x84\xc3\x1a\x98\xd6\x04\xfe\xfc\xbcdUN\x98]\x81\xf6\x03\xfe^\x84e\xbdMn\x07\xbc#ZA\x1a,1\x01\x7fqv\xacu\x9dI\xcab\xaeID\\\x15\xcc\xd6DZ\x13\xd9\xddD\x8e\x17\xca\x04 B\xe1R0\x13\xd2\xe8\xe4h\xe6\x86\xdca\x0e\x97\xa9\xcc\xb7\x89,\xf3\xd9\xab\x00m\xe9;1\xfa.\xa9_s\xb2\x98\x1c\xfbF\x8f\xa4m\xbaZ\x90P\xa1IW\x02\xd8	\xeb\xf1\xe0IK\xc6\xf4\xf9}\x1c\x01\x13\xa1\xcc^\"\xa0\xeb\xc3\x168\x9c\x9a\xc4\xdcB@\xef\xa6F{\x05hK\xfb\x89\xd1>\xd7]3\xd0\xd8\x18\"\x89!B\xca;\x07\x10\xd7\x0c\x10\x87\xf7t\x02\xdb\xac\x07\xa8\xd6jL\xccj\x98\x11,\x1c\xf4\xc7\xd8\x10!\xe2\x88C\x00\xa4;\x9d\x7f\x03\"\x98\x0e\x1frI\xe6\xd3\xba\x06\xd9\xd2\xdb\xd2\xbb\x03\xbdkzd,\xcd9\xb0`\xe1CH#\xcc\xa3\x8e'\xa4\xe2l\xe0#\xb0\xe0e&\xc6|\x9eW\x11[\x9aO\x8c\xe6\x8f*\xda\xce9{\xe3\xf7Y\xcb`<Im)C\xcbR\x86\x1b\x7f\xa02\x86\x16\\\x98\x9d\x80\xd6\x98\xaa\x81S\xc06\xdb\xbe\x1ep\x8feMz)^0\xde\x90\xd8\xda\x856\xb5\x0bS\xb1\"\x0fN\xccY\xf3\x14\xda\xda\x05ck\x17\xe4 j\xb0j\x05\xe3Ma\x1d\xb2M}NH}\xacM<b\x13\x87>\xc2\x94Y\xdb\x7fy\x82\xf1t\xb5\xd5	\xdd\xaa\x13&\xc2W3\xce\"\xcc+\\P\xb0~\xa8R\x05\xe3M\x80\xadT\xe8V\xa90\x11\x13`d\x11\xc3\x0f\xba\xdb\xd1Km\x82\xf1\x06\xc3\x96&\xb4)M\xb0\x91B\x8bH\xc1\x94\xaa\x05\x99\xd4\x03\xd4)\x18Oq[\xa6\xd0\xa5L\xc1R\xbd\x05\xd5M\xab`\x90)?D\xcd\x82\xf1\x9c\xb7%\x0b\x1dJ\x16&B\xf9\x1f&\xec^\x8a\"\x83\x83G^\xe0 \xa4\xac\xbbc\xbeI\x86\x0b\xba\x1e\xac\x90\xf14U`\xb6\xceY\xe7\x9c\xc7twG\x96\xebK\x0c\x11\xd7\xac\xd6@9\xeb\x12qo\xb3\xe0\x0c\x91h\x05\xac+i\xae\x84\x94\x8f\xb9\x10ia\x8c\xa4K	\xad%\x8a\xb9D\xa9,\xd4X\x14\x01\x02+\xeca\xc40D\x1dk\xe0D uU\x92c:Ij\x88-QtD\xb1E\x18#\x17a\xcc\xba\xf8\xadto\xa9 \xe6\xcex\xdfUCli\xa9\xa3\xe5\xb8\x81\x9eb\xb1L\xf0a\xf3{\xec\xef;\x16`\x8a\xfa\xe8	\xd1\xa5\x0c\xd7rE\xc7\x15l\xc0\xbeE?\xea?\xeb\xd6T\xe0e\xf2\xeb)\xa9w\x0d\xb1\xd5\xf0G\xa6\xe1\xa1H\x91NM\xd3?\x85>\x9a\x96\x82\xd7\x10[\x057]\xc1g\xcd0\xc7\x8c\xca\n:\xd4tk\x9c\xd3&\xf18\xcc\x02\xc5|C\x19\xfe\x9e\xf0\xb6\xb4\xc9\xd0&\x9d\xb9\xcc\xa4\x80\xe8\xef\xf9\x1e\xed&\xb1y\xad\x02mY\xaec\xf9\x98\xf49\xb6^#\xe55\xb5g\xc9\xda\xd0\xe5&\x8a\xe2\x84*\xc6g\xfd\x07\xa4\x96\x18\xe6\x12CZ\xa41\xd9\x90d\xf7sO<b\xb4\xed\xca\x8c\xebd\xf4$\xa8Q@\xb5\xdc\xd0q\xc3\x84\xec~\xd6\x0csL\xdfV\x84\x86\x85R\x8d\x17\x13fD^1\x80\xef\xd0\xd5\xc5\xbdNFO\x82\xc8\x05TKdK\xe4\x9e\x88\\(\xd5\xe8D\x8e\xc9yT\xfeDV\xd3!\xb3\x0c\xd6\xd2\xd9\xd2\xb9':\xcbj5\x12\xa1\x93#\xbd\x85x\x82\xaa\xabONO\x05?E\xc0\x8c\xa7q\x01\xd5\x92XG\xe21\xd9\x91\xf7\x85MWi\xa4\xcc\x13\xbeq\xd1\xd3{\x9bV\x12\"/U\xa0\x98\x04\xe2x\"1X]\x0e\x9bE\x0d\xd2\xa7\\\xc6\xab\xec\x12\x1f\xa5+\x18O\xa0\xc6;\xb0\xbc\xd2\xf1\xcaVUu\xae\xaa\x9a5\xcfn\xc3\xf3\n=T\xff\xb78\xf9?\x01oZ\xd9? \xdc\x96x~\x98'(\xd4\xd6\xfb\x1e\xea\x16Wt\x83\x0c*G\xce\xed\x82\x1fJV\x98\x05\xb91\x7f\x9b\xc82\xdd\x8a\xab@[\xc3\xad3\xdcJ\xd5\x19\xbf\xbch\xd6\x8c|\xccP\xae`\xadJ\xe3\xc6Ix\xb6\x14u?\x90N\xf3\x877\x14\x11\xe3#\xb5\x02\xaae\xb6\x8e\xd9c\xf2#Ou\xd2U\x1a)\xd5I\x08qf	\xed$\x18\x91\xe1\xb4t\xd0\xd1\xe1\xc7)\x9aUX\xfe5&g\xedt\xbd\x11\x12&\xb1\xd1u@j\xb5]\xa7\xed\xe3\x1a\x7fi\x91F\xb2\xfd\x84r\xbc\xc2^\xa2\xed\x8b\x90\xc1\n\x18\x10\x0f\xa23\xf6\xb7\xdeI\"\xdfK\x12\xa5U22'\xd2`\x7f\\\x1c\x1aN\xab\xe6\xf7p\x07\x84\x7f\xdc\x85\xe5W%v\xa8\xf9Vk\x99\xf1J\xa6\x03o-\xb5\xceR\x1f4g\xa0gz$\xa1\xfbY3\x9c1\x1dGAF\x9d:\x8d\x93]\x87i\x91\xf6\x02m1\x8a\xce\xf2#Y\xb9\xf7e&HB\x99\xf3\xd1(f\xd7![\xaf\xa1\xf5\x1a\xb3N\xbb\xadI\xba,\xab\x86\xf1\x8aQ\x87l\x0d\xbd\xce\xd0\x8fiY\xc3e]\xc1F{\xc4\xb9bJ\xe7\xf7\x89MM\x82\xa7\xec\xdf\xa5 \xaa\xcb\xa3\xa1\x93\"R\x1d\xb2%\x92\x8eH\x07u\x19\xe8)hI\xe8)\x11\x13\xaa(\xd9PP\x06\xca\x94O`\xe3\xfc\x0e\x18^\xeddR\xb6\xf1m\xffIFO\x8a\x92u\xc8\x96\x92\x96\x925J\xce\x9agfL\xaf_\x18\x82\xba>\x8f\x9bE	{\x0c\xd1Y\xedY3{\x92E1\xe6\x9b\x14\x05fkSt6\xc5\x96\x94u.)\xebgS}\xd6\xc5\xd9\x97\xb2\xc2L\xd1\x8dw\xf7*\xd0\x96\x9c:r\x8e\xe9\xd6\xc2\xa5J\xc9r]+\xc9\xd8_\xa8\xfe\xdd\xefQS\xd5\xa7%\x15\x07\xe2m\x04\x10\xf2\xae!\xf3e2\xba\xec\xe2\x8cg\x91\n\xb4e\x91\x8eE\x83\xd5$H\xb8\xa7\x13\xa4\xaa\xf4\xc7\x8c05\xa5\xb4\x0f\xde\x16\x93\xce\x8f\x05\xbeL\x87O\x8c\xd4J\xd4\x96\xd5\x96\xd5\xa7\xb2Z\xa9@#\xd1\x9a\xf2h\xbe\xdc-H\xf2\x02\xb3\xf9}H\xf9\xa5\xfcd\xc0~\x9e\xbd6\xa4\xab\xdb\xce\xde\xbe\xf1\x91\xbe\xa7\xfc\xb9\xf1\xfe\xfa-\xbd\x83\xf7\x94\xbf\xa5\x04l\xb76m\xb7\xb6\xaa\xa2\x98\xb1\xcbe\n\xbf\x0b\x9d7\x95\xd6_1\xdf\xf8\x0c}\xed\xca\xeb\xdf\xb3\xf1\xaf\x19\x0d,\xb5-\xb5\x1f	\xb5+j?\"\xbb\x85\xab\xbe\xf1{\xf5\xcf\xd6=\xffH\xeey\"\xaf\xf2\xb2.[r\xd99\xa9{\xf6\xce\x96\xd8\x96\xd8\x8f\x94\xd8F8\xec/1\xe5p\xf6\x8b\xca\xfe-\xa4\xe4o\x942\x9e\xd2%\xb4\x96\xd3:N\x8f\xc9\x94\xdaB\x8dtd\xc4\xc0\xc3!\x16o\xa5\x9e3\x88\xe8\xf6\x0e:\x16B\xdc\xa6\xa3osy\xc6\x13\xa5\n\xd8rE\xc7\x95\x86J\xc7\x9e{\x96\xccN\x044\xe5\x06*\x0c<J<\xbc\xc5	\x9f\x16+L|L\xd6QG\xfa\x89\x9a\x9e\xdb\x92\xc4\xd7\xb9@\xd3\x89x\x1c\xba\xa5\xa4\x8e\x92\xb6*\xa9sU\xd2\xacyvYLn\xfc\x071v\x03\xd9\x17\xf9\xe5\xf3\x0b\x868\x9ccZ\xa47\xd0\xdf&\xa2L\xf7\xee*\xd0\x8f\xcb\x9c\x9c\xef\xa1d\x0d\x8a\xban\x89\xfc+\x04\"i\x8f\xf1\x8aS\xc1k]\x90\xce\x05\x8d\x9bA\xd5\x96j\xa4\x1c\xaa\xc4\x13\xf9\xf8\xaf\xd4\xeco?_2@\x9f\xbb\xf2\xe8J\x0c\x96\x88d\xfe\xa1~\x15\xb1\xa5\x92\x8eJ'4\x86|\xe8gW\xc6dw\xe1\xa9\xaaj4\xce1A\x99\xe39\xb3o\xfc\x9eYmI\xfd#\x92\xda\x1e	\xb68\x124\x84\xefr\xc7\xf0n[2\x1f\x001o3\xa9\xd6\xe3u\xc8\xd6i\xeb\x9c\xb6\xdd\x82\x19r\x0b\xe6\xe0d\xfb\x07\x9c\x959\xea\xf0V\xde2>k\xd6\x06/f\xa2	\xd7\xeeA\xdaz\x9f\x80g\xc5h\xf0\x11\x07\xc3m\xd9\x1f]n\xf1\xca\xe8\x9f\xb8\xb8tK\xc8\x9cN\x0cp\x80\xc9e \xb4\xf4\xe11\xd7\xdf\x84\x7f\n^\xf4mRx}\xcc \xf1D\x83\xcdoK@I@\x07,D\x8c\xef.\xf5\xe1\xdd\x90\n\xdbi\xf1\x0fm\x02\x07\xc3\xdb\x12\x10j\xaew\xeda\x12[\x82R\xac\xf0\x03\x02\x1c(\x9e\x8d\x936v\xf5\xce\xbc\xed\xdbBN\xa27o\x01\xd5\x06\xb0\xba\x00v\xcc\x94\xaf\xbcJ#\xed\xdd\xa6\xfd\x84\x16\x10 \xbc\xed\x98\xe7\xa5\x1dM^%\x12\xa4\xa502\xc1\x93\xb0Zb\xe8\x88\x91(\xc4\x80\x07\xc0\xfdFI\x11x\x0c\xf85\xf5\x1f\xc6\xb3\x0f\xc4\xc5\xaf\xb0\xdcP\xfay\xe1\xc3\x16\x0b^B\xf6\xb43\x83p\x8b:\xf7\xfc\xbaMF\xff\x9e\xca~\x99\x8a\xde\x19OT%jKY\x1de\x07ka\xd0oF3\xa6\xcb-\xf8\xa8\xd4\xafq\x8eVr\xda\x03\xf1C\x8a\xc9Y\xad\xb82\x9a\xbf:\x88\x92\x90\xe6\xec4\x8a\xe7*\xd0\x8f\x8b\xe6\xadc\xb8Y\x97\xe3\xf3\xb4\xafRE=\x8c\xd7\x0e%j\xeb\x05t^`L\xf3\x1a.\x95j\x96u\x9a\xd0\xac\xd7@\xc9M\xcd\xb2\xce\xef\xf3\x7f\x8a\x93\xeb\"\xce\xea\x98\xf9H\xc6+\x0b\xac\xf0DJ\x03k\xa8-\xa9t\xa4*\xb4f\xa0\xca\x94~7\x8d\xed\xb1\\\xe7c\xb9\x81\xb6Y\x14\x96\x08\xfbg\xf7V\x9f\x9a;W\xa2\xb6\x96GgyLH\xea\xfa\xdcz\xbc\xc8\xa6\xc3\x95|\xd2\x81\x06\x92\x86\x1f\xed@\x97\xffV\xba#\xba\xfc\x13\xbcB\xfb\xdd\x90	\xe2\xf0\xb2_\x17v\x8a\xd1\xa08\xb2*\x045\xedO\x1d7\xc7\xfb\x99Rzv\\\xa2\xbfB!D\xb99\xdbP\x10T\xc8n=\x0dEY\x81\x1e\xe0\x89S\xa0\xdcZ.\xd0_#\xe2\xc1\xf6\x1a1\xbf\x01\xf7\xb1\xf1\xc7\xde\xea{\\R\xa3\"\xe4OD?\xd4\x14$Aq\xde\xe1\xe1\x0c\xdc=\xaf\xdc\xec\xa2jnts\xa1\x1c\xc7\x11[\x03\xcfN\xd4\xf5\xe3\xdb\x83J\x85\xbfD\x15\xb7X\x17]\x8cV\x9es\x9c\xd2\x01\xaf\xc0^5+GEU\x9am\x1d\x97\xd0\xa8\x8fis\x05\xf9\xa3\xfa=\xb6\x9f>\xd4\xe3\xaa(\xcf\x8f\x8ay}\xcd\x00\xbeCw\x92\xff\x06D\xecj\x81\xe8e\x01\xc2\xe2\x1bf\xe3f\x17\xf5\x1f\xe9\xc4*G\x86\xc00\xf5?p\xc4:REQU$#K\xe5\xbf\"\x1d\xad\x83J\xba\xf2\xd8\xb0X\xf6\x1br\x879dN\xef-\x88\xca}s\x17N\x1c\xfdgQU\xeb\xa5ct\xdb\x9b\x0d:\xbe\xf3\\\\\xa0\x1a\xda\x1c\x11\xf5\x89\xac\xce$^Z'p\x05\x04V\xd8\xc3\xa8\x11H\xa3)#\xd8\xfb\xdcj\x96\x95'\xd3U\x80\x9a\xd7\x0f\x9e\x81\xd5\xdb B`[\xfeT\x92\x83\x18C\xe5T\xd9\xc5\x1c\x82\xea\xef\xebFU\xfa2\xbf\xbf\xc3\xbd\x89?\x97o\x18D\x1b\xba\xed\xc9\x91+O\xf7\x1b_9R\\\xba\xf5*K\xe7\xad\xfa\x1b\xc8\x045cT\xf5\xcb9\x03\xe0D=jC\xe7\xf6s&\xa4\xd4\xf4\xb7z\x0b\xc7\x12\xf62\x0ei5\xf3\xeb	\xb5\x84&\xd3\xd8>\x1c]\xa2\xadH\x1b\xfa\x91\xab\xac}If\xbap[\xd2\x95Z\x9b\xbc\x07s,\xd2\xa4#\xdf\x07\xffj\xd70A\xca\xa1^\x92\x8d\xf8\x97\xc3\x04!\xa8\xbf\x0c8\\^\xc6|C\x19N\x1d\\E\x17\x0b\xf0\xad\x17\xccC\xcco\x99\xa2KS\x0f\xdfB\xccvo)\xe1\x9b\x92X	\x88j\xdf\xb1l\xbe\x7f~\xae\x13\xfe_@\xac\x7f\xd9\xde\xdd]\x17\x15\xed\xd3\xe8\xc9h\xf2\x87\x05:@\n\x80	\xdf\xcd\xdf\x1da\xdd\xff\xd9\xbb\x9a\xe68R\xa4}\xf7\xaf tz\xdf\x08\x85c\xf6:sY\x8d\xd6\x9eu\x84<\xd6\xca\x96\xe7\xb0\xdeP\xd0]t7!\x1a:\x80\x92\xa6\x0f\xfe\xef\x1bPT\x17U\x0dU\xc5\x97\xba\xb5\xe3\x9b%\x0b*I\x92$?\x9eL\x9cKrB\xe0|2fB\x92\x1dm\xc1\x86\x104\xb2\xdb\xe5\xea\xbb\xff<^f\x04\xff \xaf\xe6\xaaq\xe5\x98\xb99\xf9\"\x91\x90\xdd\xe2W\x15\xb5i\x9b\xb2\xdd\xa0\xb5Ex0c%3\x9a\xbb\x00S\x0b\xc9z\x1fK1F\xb2\x93\xaa\x0e\xb6\x1e1X\xb2\xabC\xeb\xaa\xf1\xe1\xbd-\x1e\xc0?$k\x9e\xdb~Pc\x00\xa4\x15h\x7f\x03vp\x0f\xe4\x06\x01\xd3\x0f\x13\x98\x1b\x00\xb0\x95\xfe\xf5\xa1\xc1\x1a\xc0\xf4\x1bU\xbfY(a\x00\xed\xf9\x07\x98\n\x89`\xa5\xff\x9e=\x98\xd1\x0f\xb8z;\xba\x9e\x18V,:7'Mv.\xdf\xf8\x812\xd6G\x1ep\xa5\xb8#\x00\x04\x02>!\xfd\x03B\x11\x0b\xcey\xa7\x0e\x8f\xe2\x1d\x12\xfd\xd7\x03\x82]>L+\xf4g\xfe\xab0\xb7j*\x7f\xccKX\xc2\x07m\x97#\xa70\xd8\xfd\x16\x97\xd1\xb17x\xef\xcb\xa6TRl\x04\x82\xd6\xc2\xc7\xadp\xc7~\x10\xfe\x98p\x9b\x86g\xac\xa3\xb0\xdb\x99\xfeJ->\x16\xd9\xe8t{Fk\xed\xc9=v.N2	I\xa9\x1c\x81\x9a\xfb}\x81\x83\x97\xd5\x05=S\xb94\xba\xff@\x9cO:\xcb\xba\x8c\xf9e\xbf\xbb\x82\xad\xa9\x82%\x1eO	\xbbg\xb9#B\x15\x1c\x1d\xcd\xa4\x0c\xb1\xd0\x85/\x18y\x97\xb4`\x8c H=\x9ff\x8c`\xba\xfe\xb4Z\xddS\x89I\x11)xEa	o\x0f\x98\x8e/\xbe\x03\xeaU\xaf\xb2k)\xd3\xfb\x8f\x114\x8dE\x81{\xdb\x94\xed\xc5\x91\x88\xe4\xe8P\x8c=\xcc\xe8\xf2\xfb9n\x99\x04\xaf\xd6K\x1f\xafR6\xa6\x80\x16P\x8bT\xc1\x7f\xc4'd\xdd9z\x0b\xc5#\xfa+\xc6\xafD\xf3\xc0X\xc4\x92_\x91nq\xc1]r\x1c,\xab\x9f\xcf\xdc\xf3\xd5Bn\xac\x0e>3\xe8\xd7\xac\xb6n\xe0\x0cN\xc6\xa9nM\x13\x8bHsl\xc6b\x05_6\xa8\x8d	\x08\xb0\xc6O\x88\x82\xc5\xbe\x0d\xa0<\xe0\xea\xf2\xf0\xef\xe6A\xb5K\xc08\x18\xc4a\xf4\x8f\x9eXI\xd7\xb4|\xb0\xe5\x83\x05\xf8\x07\xc7\x0clT[Pl\xd6\x1a\x9d\xf74\x1dKc\xfaQ\xb2\"<s\xaf*\x8b\x02\x8b\xd9>\x15\xa0\x89\xbea\x90f8;Yd8\xe3FY;\xbd\xe3\x98.\xf1\x0eF\xda\x93#\x17,\xa4\xb4\x86D\xf5<\xfeu7%\xc2\xe1\xb3+SM\xa7\x7fD\xa6\xfc\x8f\xef*\xb0\xe4 ]j	\x83\xb35\xbf\x92=7gU\xec\x12\x12\x95#9YpE\x11\xf7\xe1@FGf\xc7\xc8\x91\xdb\xd5~\xad^\xc7\x91s\x1c\xb1\x97\xd3\xb2>\xa3\xc1\xb1\xact\x89q\x92\xe6\x13\x19\xfb\xe3\x81\x04\x9b-\xc8\xb0\x13\xfa.\xbd\xcaqk\x9b/\x1d\x98|\x98>\xcad/\x14oN\x89\x08l\x91\x10p\x8d&hr\xaeF'\xc0\x91(\x04 \xb0\xf70\x93I\xed\x14\xb6\xf4\xf3\x91\x19R3N=\xeb\xeadRHfr\xf6\x81f\xb3\x89\xb3\x1b\xd8$\x10\x97\x82\xa3Y\xd5\x84\xc4Z\x9c\xc3\xde7\xb3\x0f\xc2\x0e\n\xf1\xccx5{\xac\xcf\xe7\xb3\xb8\x98.\x96\x83\x16W\xa3\x9b\xacvn\xee.\x0f\x8a\xcd2\xa8\xeb\x9aO\x99~N\xb6\xa3'D\xe5\x97\xfd\x0e	\xdf\xe8\xcc\xc0M\x9f\xab\xeefK\xfa\x0e\xb6E\x82swq@\x82\x9bk\x0dds\x82\xdfc\x89\xed\xae\xc2\x19@\x8e\x80\xc0k\x8a*\xa0^\x0c\x05r\x83\x05h>\xf0\x16|\x90\xca\x9be\x94\xec\x01zB\xea\xadKYs\xf5\xa7\x1b\xc4\x91\xed\xa8\xfa\" \xe2\xe9\x06\xeeY-SX\xa8J\xbb\xb7X\xc6]\xd7\xe2\x11\xef\xee\xd8\xb3\xc8\x0f\xefR\x91\xa4kF\xea-\xf5	~<tL\xcd\xfd\xbe\xbde\x83\xd7\xdc\x98(\xa5h[rT\xe1b\xb3WhQn\xf2\x03\xf8\xa6\x1c\xf5\x07\xa4M\xb9O,\xf1\x16\x92k\xb6\xddB\x1f\x87\x8e\x13@o\x06\x9c\x1eb\x82\x0e\x07\x15\x08\xb8\x17\xe0Y\x9do\x00\xc1\x02\xd2G\xb0\xab\xa5\x00\x08.7`\x85\x11\xa9\x00\xa6\x00K\x01\xae?\x7f\x05\xe8\xcf\x1d\xe3\xf2-h\x16\xab\x95\xc97\xda\xc4\xb7P\x05T2\x10\xfcM#\x8c~\x02[\x04\xa9\xd0(\xa2f\x10\xd8@\x01(S\xadh6`\xa9\xc7\x1b\x85b\xc4\xe1B\xd5\x18;J\xbc\xb2\xd8N/b\xee5%\xd2a\x11+\xe7\x14}'wr\xf9\xce9\xc2\xef\xb6\xc14\xae\x10r\xc2\x16\x14\xc8\xc5\xe0\xad\x92\xc68\\\x83\x92\xb3q\x17%^\xed,\x18{D\xd5'\x1aCW!w3	\x86\xd8\xd7\x1d\xc1\xac\x8e\xcf\xfd\x14\x84\x91m\x15$&\x16\x86o\x06\xbf\x06\x18~W\xdd\x99C\x8f\xe6O\xf1\x1eU\x8f\xe6 S\xb4\xa5\xa8s\xad\xf0\xc3\xd7\xad\x1d\xf1$\xf9~C\xf2\xd7\x06^t\x95\x85\xd6RX\xa5\x94\x00\x13\x94q\xe4\xbc\xa4`\xab}\x08\xbai\xa7w\xa2\x9bn\xae\x8c[\x14\xcc\xa2\xf8\x9am\x17\x98\xa2\xcaHP\x0e\xf9I\xd9\xe7R\xb2\xb7cR\x98%N\x90\x15>\xb7\x06\x0d\x96\x9a\\\x11\x9e/01\x10\xbc	}\xd9\x0b\xdauR\xd4'0k\x86\xf57$\x7fd\xa8b2T\x16\xc9\x90s\x04\xf9\xf0\xf3\xa3\xd3_\x99!\xce\xf9X-\x85\x84\xfa\xf5\xeb\xdb\x1c9\xd6\xb1r\x87\xc3\x07\x80\x90\x98\x10 \x19X\xa8\xa2\x8f\x1d\xec\x174x4\xf0\x07m~\xab\x02`\xeb\xf2N\x0e,\x96Kz\x0bV\xf3I\x9d\xe1\xdcd2\x8ci\x8d\x1e\xe4.\x08\xe6\x9cl\xc9\xa8T\x96\xc6@b\x06\x87\xd7\xb3\xbc\xc5^\xbe\xc4\xe5\xea\xdc\xda\xf4\xbb*\xc5a\xe3\xec\xf9z\xcc5\x8aw\xd7\x8c5_h\xf6\x9a\x9a\xf9\xf3\x05\xbc\xc34\xda(\x14\xac\x13\x81\x11a8\xee\x14\x92\xc3p\xd9\x0e\x8b\xb7GW\xd1\xfb\xbc\xc5i\x8fu\xf8A\x88\xdax=g\xac\x91\xf2\x9e\xd9n\xc9\xe9\x9b\x93\xe0\xe8]\xf6\xdd\xc5Y\xd6\xfeXQ\x9e\x02\xda\xa9\x14!P4\x81&\xd8\xa8c\x8c\xd7_\xbf\xaa\x00\xe4\x9ctEd%\xb5\xc7\xf5P\xadc;\xcb\xdfn\xc0\xdaq,\x98\xe3\x9d\xf71\xfc\xaf\xa4\xe4X\x98\xaep\xfa3-\x03\xa6X\xa2\x8e[\x16V\xa8\x8d>\x19\x0b\xfa\xd2<w\xed\xee&7Y\xb8\xb1;\xc4\xeeN\xc6\x13\xf7\xea\xc2%\xc4\x8ek\xe7a\x8e\xc69\x9d\x8e1\xf6\x82\xa2\xd9a\xae\xa8,\xfc\xe8':N\xcd\x97\xf6\xf2\x0d>Nwh\xc9\xe8\x12\x13\xac;0\xbc\xc7\xda\x1f\xca\xc2\xa0\x95\x99\xebT\x9cq\xae,\x9cAV\xfd\x90\x82\xcafa\x8dj\xe2w2\xbe\x0c\x16\x94\xc4\x91,\xdc\xb0\x8a\xba\xce\x81)\xe1\x0c\xb9o\x1d\x0f\x87\x03\x90\x87C]m\xc9\xc98\x94\xec\xdc8\x9f\x01\xc8\xc1\x1d\xe7\x03\x05/z9\xf5\x97e\x05\xa8\x03\xf9r\xfc\xb6K\x82\xd0\x1c\x9a\xa8[\x93\x9c\x82+\xed\xa2\x02\xb9\xd2\x07\xe2\x07\x1f\x99\x02I\xf0\x1f\x95\x18\xa6\x12\xe32ON\xe4/Q\xa9h\x87\xa1;b\x83o\x80\x19\x15%\xf1\x9bX\x08	!p`s\xc2\x01rJ\x85#*\xd36I\x15\xcb		j\xaa\"\xe6\xa0\xe3\x06X!B\x00\xa6\x92\x01\x93\x1f\xf8\x05\xa0\xedN\xee5\xe4R\xa1\xae\xcc\xafgD\xd9\x07%3	\xbbEG#1\xf1\x1bU\xd5(\xa8\xcf\xf7\x8b(\xaf\xbc\xf5\xf3\xe5\xc5RIP\xa16+z\xea\xc8i]\x19}\x9f\xc5\xc0\xd6\x98\x9eA9A.d\xbf\xb5\x9et\xb3'\x01\xd8\xdf\xed\xcc\x85@B\xcc\xe9H\xe8\x1c\x0c\xf5\x13\x80_\xd8#\x8a\x02\xa6q\xb4R}\x8f\xa3\xc7[\x9f\x7fW\xb4\x08\xc9&\xb4\xc0\x97<\xd2\xf2\x91=\xa9W\">2\x8a\xba\xa7N;6\x07\x0bLt\xb5\xcf\xcb\xc0\xe7\x8c5\x92\x15\x87\xe19\x87\x9e\xe0c\x02o\x0fE(=\x96O\xd0=0\x030]\xb2-\xa6\xeb\x87\x96\xc9\x97\x80\xb0\xe7\x07\x83\xa8\xb9\x04D\xbd\x7f\xf1\xa0\xd1\xed\xaa\xf0\x9f\xa2\xe7\x07\xa2\x14\x8a}\xe3_\xbep;\xf31\xb3\xe6\xda\x10\xa0\x11\xdb\x90 \xae+A\x84\xea\xfd(7\x9c\xd5\xebMk\xc2\xa8\x8a\x10\x01\xd4\xb2V\xab\xb7\x17\xe5Z\xa4\x8fQk\xb1\xba!VC\xd8)\x80\xa0\xe1\xb8\x84\x8fH\xb5n4\xdb\x01\x16\x88\xb0g\x80\xe5`_\xe87\xaa\xff^\xa8\x06\x96P\x02\x82\xa0\x90\x00\xabB\x18\xaa\x19\xb1\xc5\x94qPS|h\x89i\x04\xdf\xb3\xf0Z\xb7\xe4\xcf\n\x0c\xf5\xdcN\x9fv\x88\xda1\xb3\xf4;\xb7\x81G\x98<k\x01\xc4mo\xfe@\x0c`y\xb7 {c\x00\x8bdF?BYs,\xa7\x1c\xd21\xbf\xe3\x8f\x0dT5\x14\xbb\x1dRu\x17\x12l\xcd\x94?\x83\x9dF\xa7\x80\xff\xb3\x1a\xb9\xfe\xbf\xd29\x9c\x11\xc2\x9e\x10\xb7e\xd5c\xeb\x1cIS\xba\xc5S\xa2)\x16\x8c\x12\x1e\x8f}\xd7\xcb\xdd$,\xf44\x8dZ\xca\xb6Ez2\x1d\xe7\xd2\x15Y7\xa9\x1a\x8c\xa5~\x8b\xe5\xa2\xa6\x02)\xbfYbb.\x1c\x0c\x85\xbap\xda\x0f\xff\xd2d\xf5\xdb\x1f\x81\xc9\xf3\xa9\x8e\xc4\x08?\xe9\xce;j\xf3l\x10\x9d\xc7\x8c\xef'\xd9R6z2x\xe7<\xfc\xea\xb9K$\"\xab\x19\x7ft-\xd0]\x0b\xce\xb2\x82\xa4l+\x05\xaeUpU\xec\x84\x97\xd5\x1f\xf9\x1bA\xdc\xf6\xfc\xa1\xe0\xb3\x1b\xfbx\xd49\xa2\xeb\xcf\xed\xdd\xbe\x17\x93\xaa\xb8\xeb\xdf\"o\x07\xb9j\xb2\x135\x8d'J\xf4\xaf\x9aI\xd4\xb6\xe5\xcd`\x85[\x0du\x0b\xd8\xe0\xaf\xf0\xfd\x85\x94\x13\x18\xdf\x9d\xd8\xc5\xb3\xc0)<a\xc5\x81\xc0\xa4\x1b\xda\x85\xf8^\xa6!\xbe\x84\xe4\x1f\xca\xe3\x9e\xd8\x88\xf0\xa9\x93\xc4\x04\xa1\xcf\n\xa1]\x13\x94\xeb\xccyb\xcaw\xed\x83\x1a\xe6\x18Z\x1f\x0b\xde\xf7\x94\x15gu\xa4\xdc\x90\xa6\x84\x95\x15\xc8\x92\xf3:2j\xfe\x88i\xd4\xb8\x82\xb1\xd7\xf6]\xf4\xecL\x82KY\x17\xe8\xe8X!	1\x11\x13\xf3\xbe\x1a{\xc2\x93.\xbaC[\xf6\xd4\x86\xb7fWI\x1cM\xe2x_4G:\xc1\xa0\x91\xf6ssP^\xec\x90\x7f\xf9\x82\x91'tPp9\x88>Md\xa5\xc2BmBb\x0f\xdc\x93A\x92\x8f\xae\x18k\xd8\xf7\xc9m\xfc\x8c 7\x8f\xcc\xfc\x8f!\x05\xa3\x11\x82]o\x80nM\xc1\x1c\x88\x8b\x1d\x1d4Y\xf0\xc8W\xf8^u\x96x\xcf\nbRst\x87\xa0`t\x826\xe7\xa7+\xf6L	\x83\xd5-\x94\x9b\x18\x02\xce\xd1\x03\xf6\x08\xb6\x95>\xb2\xa6\xf2\x1d/\xff\xe1.\x989)\xdb\x0c\x1aAN\xf6\xfaA\x90[D!\x91\xfb\x12\x1d\xa7\xcbA\x9cR\x1c\x00\x93\x18\x9d\x18\x1a\xceR\xa1^\xc8\x17q\xad\x8ftF\x0bE\x0e\x9e\x9dc\xf3\x91}\x96\xcfS,	\x13g\xa6L2et=\x97\xad\xa5\x93\xee\xfa\x98B\xdf\xb5\xffC/\x05\xe8%\x8f\x89c\xdbF\xddb\x829^\xc0o/\xe8F\x9fa\xbc\xb2\xc2\x1c\x0d\x1b\xed\xb9\xa8r\xb2J[\x06\x88\xef \x97\xd1\xceK\x1f\xe4\x14<<\xa9\xcb\xdc\x99\xe7\x0el\xf6\xc6\xa9<\x8b\x9a-\x92\xb0\x82\xd2\xdb\xd0\xd3\xe1\xda\x0c\x01h\x1f\xcd\x14:\x7f\xae\xf2m\x1c@)\xa1\x8ac\xaaF.\xea\xb7\xed\xe1\x99\x01\x00\xb9\xa7\xab3o\x14w\xaf\xa1UV\x81z\x8e\x08C\xe9\x96_\x0d\xd1n\x04c\x0e\xfa\xbb\xda\xf0\xb9\xe4\xbbi\x99\xbb\x92\x1f\xdd\xe2gv\x8boY\xa0\x9b\x07\xd1u\x1d\xf2\\\xc3\xa8,\x9do\xa7\xf9\xfb>\xc4=\x8a\xa2\xd84\xfdI\x85BAg\xd7\xc5.\xae\xb2\xd7\xe2L\xe1\x1c\xbb\x88n\xcc\x14@3R\xe8\xd6,\x06\x93\xab\xba\xb3<s,%\xa2\xa0\x0f}\xf6\xe8K\xfd\xd2\xe9\xfe\x9d\x9a(\x87z\x8cy:u\x942\x1b3\x98\x83\xc0\x82\xcf\xf4\xfc\xa1\xbaN\x19C%\x07\xa9\x11\x8f\x10\xdan\xc5\x98\x04\xbd\xd3\xb8r\xdc@\xaeW\x98\x0b	\xcc\x137\x97\xe0y\x83\x97\x9b\x06\x0c\xb8\x84\\\x95S\xeb\xbfj\xacl\xd9\x02\xbcm\xd1\xca\x1b\xe5\x18\xa3\xdbT?\x0c\xa0\xe1\x80\xe3\xf5F\x02\xb8\x92\x88wfX\xc3\x06\x0f\x9d\x04\n\xf9\x8eJ\xbe\xcf\xe5\xe6x\xcc\x91aV\xa7;\x14\xc1\xf7G\x01_\xaf-\x0d/\xe0\xeb\xe9\x8a\x8f\xc9y\xfdCc\x93P\xf1\x81l(\xa5\xaa\x18-\x10\xbb\xe0F\x19|\x1e\xa5mVld\xcc?\xf9\xe7\x97/\xb7\xa0Y\x7f{@\x94\x98\x03\xb3\xb0K\xf0\x13\xc0+\xf5 @K\x0fx\x86\x07|o\xaf\xfdd\xf7\x95\xe6\xa0p\xce\xe6\xc7\x99\xac\xc1eoR\x93a\xcd;\xbf\xc7\xad\x18\xf60\xe8\xbe\x17l\x07\x168\xc7\xaf\xe5i\xa0\xb2\xa2\xe1\xf6\x089\x93lQ\xaf\xaeh\x92\xf2\xfd\xbbY\xdf\x18\xa9\xc7\x94\xb4\xeb\xbd\x80U\xa5\xefhHn{\x1fp\xd3\xfa{M\xc8WHj\xa7\xbb1\xe0\xcb\x05\xa2\xf5\xf6\xe2g\xf0o\xf33\x00\x17\xbf\xdf\xdf\xdc<|\xbd\xba\xb9\x7f\xd7\x92\xf4\x9f\x03!\xa6Z\xe5\xe2\xe7\xe3?3\xd4^\xf0\xdd\xf2HQ\x05;;KV\xa1$Eg>\xd0\x17\xd8\x84g\xf8& 1\x85\xdb\x96YBh}\xbd\x95\xd8\xee\x8c\x18yx\x03\xc0\xf77\xdf\xdf\xfcw\x00PK\x07\x08\xd7\xfcK\n\xea\x19\x00\x00\x98\x8d\x01\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x98S]\xd7\xfcK\n\xea\x19\x00\x00\x98\x8d\x01\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00simple_bank.swagger.jsonUT\x05\x00\x01\x9ai\xd6jPK\x05\x06\x00\x00\x00\x00\x01\x00\x01\x00O\x00\x00\x009\x1a\x00\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
@@ -0,0 +1,136 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newAccountNumber generates the number of an account the server opens.
+func (server *Server) newAccountNumber() string {
+	return util.NewAccountNumber(server.config.AccountNumberCountry, server.config.AccountNumberBankPrefix)
+}
+
+// resolveAccountID returns the ID of an account a request gives either by ID or
+// by account number, turning lookup failures into gRPC errors.
+func (server *Server) resolveAccountID(ctx context.Context, accountID int64, accountNumber string) (int64, error) {
+	if accountNumber == "" {
+		return accountID, nil
+	}
+
+	account, err := server.store.GetAccountByNumber(ctx, util.NormalizeAccountNumber(accountNumber))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, status.Errorf(codes.NotFound, "account %s not found", accountNumber)
+		}
+		return 0, status.Errorf(codes.Internal, "Failed to get account: %v", err)
+	}
+
+	return account.ID, nil
+}
+
+// validateAccountRef checks that a request gives an account by exactly one of
+// its ID and its account number.
+func validateAccountRef(idField string, numberField string, accountID int64, accountNumber string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if accountNumber == "" {
+		if err := val.ValidateID(accountID); err != nil {
+			violations = append(violations, fieldViolation(idField, err))
+		}
+		return violations
+	}
+
+	if accountID != 0 {
+		violations = append(violations, fieldViolation(idField, fmt.Errorf("must not be set together with %s", numberField)))
+	}
+	if err := val.ValidateAccountNumber(util.NormalizeAccountNumber(accountNumber)); err != nil {
+		violations = append(violations, fieldViolation(numberField, err))
+	}
+	return violations
+}
+
+// getAccount loads an account, turning lookup failures into gRPC errors.
+func (server *Server) getAccount(ctx context.Context, accountID int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		return account, status.Errorf(codes.Internal, "Failed to get account: %v", err)
+	}
+
+	return account, nil
+}
+
+// getAuthorizedAccount loads an account like getAccount and also checks that
+// username is a member whose role grants the permission.
+func (server *Server) getAuthorizedAccount(ctx context.Context, username string, accountID int64, permission authz.Permission) (db.Account, error) {
+	account, _, err := authz.AuthorizeAccount(ctx, server.store, username, accountID, permission)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		case errors.Is(err, authz.ErrNotMember):
+			return account, status.Errorf(codes.PermissionDenied, "account [%d] doesn't belong to the authenticated user", accountID)
+		case errors.Is(err, authz.ErrNotPermitted):
+			return account, status.Errorf(codes.PermissionDenied, "role on account [%d] doesn't allow this", accountID)
+		}
+		return account, status.Errorf(codes.Internal, "Failed to authorize account: %v", err)
+	}
+
+	return account, nil
+}
+
+// accountNumbers maps the given account IDs to their account numbers, which
+// responses identify accounts by instead of their internal IDs.
+func (server *Server) accountNumbers(ctx context.Context, accountIDs ...int64) (map[int64]string, error) {
+	rows, err := server.store.ListAccountNumbers(ctx, accountIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get account numbers: %v", err)
+	}
+
+	numbers := make(map[int64]string, len(rows))
+	for _, row := range rows {
+		numbers[row.ID] = row.AccountNumber
+	}
+	return numbers, nil
+}
+
+// accountNumbersOf maps accounts already loaded to their account numbers, like
+// accountNumbers.
+func accountNumbersOf(accounts ...db.Account) map[int64]string {
+	numbers := make(map[int64]string, len(accounts))
+	for _, account := range accounts {
+		numbers[account.ID] = account.AccountNumber
+	}
+	return numbers
+}
+
+// getPot loads a pot, turning lookup failures into gRPC errors.
+func (server *Server) getPot(ctx context.Context, potID int64) (db.Pot, error) {
+	pot, err := server.store.GetPot(ctx, potID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return pot, status.Errorf(codes.NotFound, "pot [%d] not found", potID)
+		}
+		return pot, status.Errorf(codes.Internal, "Failed to get pot: %v", err)
+	}
+
+	return pot, nil
+}
+
+// checkCurrency checks that an account holds the requested currency.
+func checkCurrency(account db.Account, currency string) error {
+	if account.Currency != currency {
+		return status.Errorf(codes.InvalidArgument, "account %s currency mismatch: %s vs %s", account.AccountNumber, account.Currency, currency)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	accountActivityBatchSize = 100
+	// accountActivityPollInterval bounds how late an entry can be sent when its
+	// notification is lost.
+	accountActivityPollInterval = 30 * time.Second
+)
+
+// accountActivityWatch says who an account is watched for and where its
+// activity goes. WatchAccount and the SSE endpoint only differ in these.
+type accountActivityWatch struct {
+	accountID int64
+	// afterEntryID resumes the watch after an entry sent before. Without it
+	// the watch starts with the current balance.
+	afterEntryID int64
+	// authorize checks again that the caller may still view the account.
+	authorize func(ctx context.Context) error
+	send      func(rsp *pb.WatchAccountResponse) error
+	// heartbeat, if set, is called when nothing was sent for heartbeatInterval.
+	heartbeat         func() error
+	heartbeatInterval time.Duration
+}
+
+// watchAccountActivity sends the entries of an account, each with the balance
+// right after it, as their transactions commit, until the context is done.
+// Entry IDs of an account grow in commit order, so resuming after the last
+// entry sent never skips one. The caller is authorized again before every
+// batch, so the watch ends once their token expires or their access is revoked.
+func (server *Server) watchAccountActivity(ctx context.Context, watch accountActivityWatch) error {
+	// subscribe before reading, so entries committed in between still wake us
+	wake, unsubscribe := server.entryFeed.subscribe(watch.accountID)
+	defer unsubscribe()
+
+	cursor := watch.afterEntryID
+	if cursor == 0 {
+		head, err := server.store.GetAccountActivityHead(ctx, watch.accountID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Failed to get account balance: %v", err)
+		}
+
+		err = watch.send(&pb.WatchAccountResponse{
+			Balance:     head.Balance,
+			LastEntryId: head.LastEntryID,
+		})
+		if err != nil {
+			return err
+		}
+		cursor = head.LastEntryID
+	}
+
+	poll := time.NewTicker(accountActivityPollInterval)
+	defer poll.Stop()
+
+	var heartbeats <-chan time.Time
+	if watch.heartbeat != nil {
+		ticker := time.NewTicker(watch.heartbeatInterval)
+		defer ticker.Stop()
+		heartbeats = ticker.C
+	}
+
+	for {
+		err := watch.authorize(ctx)
+		if err != nil {
+			return err
+		}
+
+		cursor, err = server.sendAccountActivity(ctx, watch, cursor)
+		if err != nil {
+			return err
+		}
+
+	wait:
+		for {
+			select {
+			case <-wake:
+				break wait
+			case <-poll.C:
+				break wait
+			case <-heartbeats:
+				if err := watch.heartbeat(); err != nil {
+					return err
+				}
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// sendAccountActivity sends the entries of the watched account after the
+// cursor and returns the ID of the last entry sent.
+func (server *Server) sendAccountActivity(ctx context.Context, watch accountActivityWatch, cursor int64) (int64, error) {
+	for {
+		activity, err := server.store.ListAccountActivity(ctx, db.ListAccountActivityParams{
+			AccountID: watch.accountID,
+			AfterID:   cursor,
+			RowLimit:  accountActivityBatchSize,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return cursor, nil
+			}
+			return cursor, status.Errorf(codes.Internal, "Failed to list account activity: %v", err)
+		}
+
+		accountIDs := []int64{watch.accountID}
+		for _, row := range activity {
+			accountIDs = append(accountIDs, row.CounterpartyAccountID)
+		}
+		numbers, err := server.accountNumbers(ctx, accountIDs...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return cursor, nil
+			}
+			return cursor, err
+		}
+
+		for _, row := range activity {
+			err = watch.send(&pb.WatchAccountResponse{
+				Transaction: convertTransaction(db.Transaction{
+					Entry:    row.Entry,
+					Currency: row.Currency,
+					CounterpartyAccountID: sql.NullInt64{
+						Int64: row.CounterpartyAccountID,
+						Valid: row.CounterpartyAccountID != 0,
+					},
+					Metadata: row.Metadata,
+				}, numbers),
+				Balance:     row.BalanceAfter,
+				LastEntryId: row.Entry.ID,
+			})
+			if err != nil {
+				return cursor, err
+			}
+			cursor = row.Entry.ID
+		}
+
+		if len(activity) < accountActivityBatchSize {
+			return cursor, nil
+		}
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"strings"
 
 	"github.com/nhat195/simple_bank/token"
+	"github.com/nhat195/simple_bank/util"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -24,7 +27,11 @@ func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error)
 		return nil, fmt.Errorf("authorization token is not provided")
 	}
 
-	authHeader := value[0]
+	return server.verifyAuthorizationHeader(value[0])
+}
+
+// verifyAuthorizationHeader verifies the bearer access token of an authorization header.
+func (server *Server) verifyAuthorizationHeader(authHeader string) (*token.Payload, error) {
 	field := strings.Fields(authHeader)
 	if len(field) < 2 || strings.ToLower(field[0]) != authorizationBearer {
 		return nil, fmt.Errorf("authorization token is not provided in bearer format")
@@ -40,3 +47,23 @@ func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error)
 	return payload, nil
 
 }
+
+// authorizeBanker authorizes the request like authorizeUser and also
+// requires the authenticated user to have the banker role.
+func (server *Server) authorizeBanker(ctx context.Context) (*token.Payload, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	user, err := server.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		return nil, unauthenticatedError(fmt.Errorf("cannot load user: %v", err))
+	}
+
+	if user.Role != util.BankerRole {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getBeneficiary loads a payee from the address book of the user. Payees saved
+// by other users are reported as not found.
+func (server *Server) getBeneficiary(ctx context.Context, username string, id int64) (db.Beneficiary, error) {
+	beneficiary, err := server.store.GetBeneficiary(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return beneficiary, status.Errorf(codes.NotFound, "beneficiary [%d] not found", id)
+		}
+		return beneficiary, status.Errorf(codes.Internal, "Failed to get beneficiary: %v", err)
+	}
+
+	if beneficiary.Username != username {
+		return db.Beneficiary{}, status.Errorf(codes.NotFound, "beneficiary [%d] not found", id)
+	}
+
+	return beneficiary, nil
+}
+
+// checkPayeeCoolingOff checks that the user has known the payee long enough to
+// send it the amount, whichever way the payee was given.
+func (server *Server) checkPayeeCoolingOff(ctx context.Context, username string, toAccountID int64, amount int64) error {
+	if amount <= db.CoolingOffTransferLimit {
+		return nil
+	}
+
+	payeeSince, err := server.store.GetPayeeSince(ctx, db.GetPayeeSinceParams{
+		ToAccountID: toAccountID,
+		Username:    username,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to get payee: %v", err)
+	}
+
+	if err := db.CheckPayeeTransfer(payeeSince, amount, time.Now()); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%v until %s", err, db.PayeeCoolingOffUntil(payeeSince).Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getAuthorizedCard loads a card and checks that username may use the permission
+// on the account the card is linked to.
+func (server *Server) getAuthorizedCard(ctx context.Context, username string, id int64, permission authz.Permission) (db.Card, error) {
+	card, err := server.store.GetCard(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return card, status.Errorf(codes.NotFound, "card [%d] not found", id)
+		}
+		return card, status.Errorf(codes.Internal, "Failed to get card: %v", err)
+	}
+
+	if _, err := server.getAuthorizedAccount(ctx, username, card.AccountID, permission); err != nil {
+		return card, err
+	}
+
+	return card, nil
+}
+
+// setCardStatus freezes, unfreezes or cancels a card of an account username can
+// move money from. A cancelled card stays cancelled.
+func (server *Server) setCardStatus(ctx context.Context, username string, id int64, cardStatus string) (db.Card, error) {
+	card, err := server.getAuthorizedCard(ctx, username, id, authz.MoveMoney)
+	if err != nil {
+		return card, err
+	}
+
+	if card.Status == db.CardCancelled {
+		return card, status.Errorf(codes.FailedPrecondition, "card [%d] is cancelled", id)
+	}
+
+	card, err = server.store.UpdateCardStatus(ctx, db.UpdateCardStatusParams{
+		ID:     id,
+		Status: cardStatus,
+	})
+	if err != nil {
+		return card, status.Errorf(codes.Internal, "Failed to update card: %v", err)
+	}
+
+	return card, nil
+}
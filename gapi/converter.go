@@ -1,8 +1,14 @@
 package gapi
 
 import (
+	"encoding/json"
+	"time"
+
 	db "github.com/nhat195/simple_bank/db/sqlc"
 	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -13,5 +19,276 @@ func convertUser(user db.User) *pb.User {
 		Email:             user.Email,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
+		Language:          user.Language,
+	}
+}
+
+func convertReconciliationFinding(finding db.ReconciliationFinding, accountNumbers map[int64]string) *pb.ReconciliationFinding {
+	return &pb.ReconciliationFinding{
+		Id:            finding.ID,
+		RunId:         finding.RunID.String(),
+		Kind:          finding.Kind,
+		AccountNumber: accountNumbers[finding.AccountID.Int64],
+		TransferId:    finding.TransferID.Int64,
+		Expected:      finding.Expected,
+		Actual:        finding.Actual,
+		Details:       finding.Details,
+		CreatedAt:     timestamppb.New(finding.CreatedAt),
+	}
+}
+
+func convertTransaction(transaction db.Transaction, accountNumbers map[int64]string) *pb.Transaction {
+	direction := util.DirectionCredit
+	if transaction.Amount < 0 {
+		direction = util.DirectionDebit
+	}
+
+	return &pb.Transaction{
+		Id:                        transaction.ID,
+		AccountNumber:             accountNumbers[transaction.AccountID],
+		TransferId:                transaction.TransferID.Int64,
+		Amount:                    transaction.Amount,
+		Currency:                  transaction.Currency,
+		Direction:                 direction,
+		CounterpartyAccountNumber: accountNumbers[transaction.CounterpartyAccountID.Int64],
+		CounterpartyName:          transaction.CounterpartyName,
+		Description:               transaction.Description,
+		Reference:                 transaction.Reference,
+		CreatedAt:                 timestamppb.New(transaction.CreatedAt),
+		Metadata:                  convertMetadata(transaction.Metadata),
+	}
+}
+
+// convertMetadata converts the metadata of a transfer, which is always stored
+// as a JSON object, leaving it out if there is none.
+func convertMetadata(metadata json.RawMessage) *structpb.Struct {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	var result structpb.Struct
+	if err := protojson.Unmarshal(metadata, &result); err != nil {
+		return nil
+	}
+	return &result
+}
+
+func convertStatement(statement db.Statement, accountNumbers map[int64]string) *pb.Statement {
+	return &pb.Statement{
+		Id:            statement.ID.String(),
+		AccountNumber: accountNumbers[statement.AccountID],
+		Format:        statement.Format,
+		PeriodStart:   timestamppb.New(statement.PeriodStart),
+		PeriodEnd:     timestamppb.New(statement.PeriodEnd),
+		Status:        statement.Status,
+		FailureReason: statement.FailureReason,
+		DownloadPath:  statementDownloadPath(statement.ID),
+		CreatedAt:     timestamppb.New(statement.CreatedAt),
+	}
+}
+
+func convertExternalTransaction(transaction db.ExternalTransaction, accountNumbers map[int64]string) *pb.ExternalTransaction {
+	rsp := &pb.ExternalTransaction{
+		Id:            transaction.ID,
+		ImportId:      transaction.ImportID.String(),
+		AccountNumber: accountNumbers[transaction.AccountID],
+		LineNumber:    transaction.LineNumber,
+		BookedOn:      transaction.BookedOn.Format("2006-01-02"),
+		Amount:        transaction.Amount,
+		Reference:     transaction.Reference,
+		Description:   transaction.Description,
+		Status:        transaction.Status,
+		TransferId:    transaction.TransferID.Int64,
+		MatchedBy:     transaction.MatchedBy.String,
+	}
+	if transaction.MatchedAt.Valid {
+		rsp.MatchedAt = timestamppb.New(transaction.MatchedAt.Time)
+	}
+	return rsp
+}
+
+func convertAccountMember(member db.AccountMember, accountNumbers map[int64]string) *pb.AccountMember {
+	return &pb.AccountMember{
+		AccountNumber: accountNumbers[member.AccountID],
+		Username:      member.Username,
+		Role:          member.Role,
+		AddedBy:       member.AddedBy.String,
+		CreatedAt:     timestamppb.New(member.CreatedAt),
+	}
+}
+
+func convertPot(pot db.Pot, currency string, balance int64, accountNumbers map[int64]string) *pb.Pot {
+	rsp := &pb.Pot{
+		AccountNumber:       accountNumbers[pot.AccountID],
+		ParentAccountNumber: accountNumbers[pot.ParentAccountID],
+		Name:                pot.Name,
+		Currency:            currency,
+		Balance:             balance,
+		TargetAmount:        pot.TargetAmount,
+		CreatedAt:           timestamppb.New(pot.CreatedAt),
+	}
+	if pot.TargetDate.Valid {
+		rsp.TargetDate = pot.TargetDate.Time.Format(potDateLayout)
+	}
+	return rsp
+}
+
+func convertPaymentRequest(request db.PaymentRequest, accountNumbers map[int64]string) *pb.PaymentRequest {
+	rsp := &pb.PaymentRequest{
+		Id:                 request.ID.String(),
+		Requester:          request.Requester,
+		PayeeAccountNumber: accountNumbers[request.PayeeAccountID],
+		Payer:              request.Payer,
+		Amount:             request.Amount,
+		Currency:           request.Currency,
+		Message:            request.Message,
+		Status:             request.EffectiveStatus(time.Now()),
+		TransferId:         request.TransferID.Int64,
+		ExpiresAt:          timestamppb.New(request.ExpiresAt),
+		CreatedAt:          timestamppb.New(request.CreatedAt),
+	}
+	if request.RespondedAt.Valid {
+		rsp.RespondedAt = timestamppb.New(request.RespondedAt.Time)
+	}
+	return rsp
+}
+
+func convertPaymentAlias(alias db.PaymentAlias) *pb.PaymentAlias {
+	rsp := &pb.PaymentAlias{
+		AliasType: alias.AliasType,
+		Alias:     alias.Value,
+		CreatedAt: timestamppb.New(alias.CreatedAt),
+	}
+	if alias.VerifiedAt.Valid {
+		rsp.VerifiedAt = timestamppb.New(alias.VerifiedAt.Time)
+	}
+	return rsp
+}
+
+func convertBeneficiary(beneficiary db.Beneficiary, accountNumbers map[int64]string) *pb.Beneficiary {
+	return &pb.Beneficiary{
+		Id:              beneficiary.ID,
+		Nickname:        beneficiary.Nickname,
+		AccountNumber:   accountNumbers[beneficiary.AccountID],
+		Currency:        beneficiary.Currency,
+		IsVerified:      beneficiary.IsVerified,
+		CoolingOffUntil: timestamppb.New(beneficiary.CoolingOffUntil()),
+		CreatedAt:       timestamppb.New(beneficiary.CreatedAt),
+	}
+}
+
+func convertCard(card db.Card, accountNumbers map[int64]string) *pb.Card {
+	return &pb.Card{
+		Id:            card.ID,
+		AccountNumber: accountNumbers[card.AccountID],
+		Cardholder:    card.Cardholder,
+		MaskedNumber:  card.MaskedNumber,
+		ExpiryMonth:   card.ExpiryMonth,
+		ExpiryYear:    card.ExpiryYear,
+		Status:        card.Status,
+		CreatedAt:     timestamppb.New(card.CreatedAt),
+	}
+}
+
+func convertLoan(loan db.Loan, accountNumbers map[int64]string) *pb.Loan {
+	return &pb.Loan{
+		Id:            loan.ID,
+		AccountNumber: accountNumbers[loan.AccountID],
+		Principal:     loan.Principal,
+		AnnualRateBps: loan.AnnualRateBps,
+		TermMonths:    loan.TermMonths,
+		Currency:      loan.Currency,
+		Status:        loan.Status,
+		CreatedAt:     timestamppb.New(loan.CreatedAt),
+	}
+}
+
+func convertLoanInstalments(instalments []db.LoanInstalment) []*pb.LoanInstalment {
+	rsp := make([]*pb.LoanInstalment, len(instalments))
+	for i, instalment := range instalments {
+		rsp[i] = &pb.LoanInstalment{
+			Number:     instalment.Number,
+			DueDate:    instalment.DueDate.Format(loanDateLayout),
+			Principal:  instalment.Principal,
+			Interest:   instalment.Interest,
+			Amount:     instalment.Amount,
+			PaidAmount: instalment.PaidAmount,
+		}
+		if instalment.PaidAt.Valid {
+			rsp[i].PaidAt = timestamppb.New(instalment.PaidAt.Time)
+		}
+	}
+	return rsp
+}
+
+func convertLoanArrears(arrears db.LoanArrears) *pb.LoanArrears {
+	rsp := &pb.LoanArrears{
+		Instalments: int32(arrears.Instalments),
+		Amount:      arrears.Amount,
+	}
+	if arrears.Instalments > 0 {
+		rsp.Since = arrears.Since.Format(loanDateLayout)
+	}
+	return rsp
+}
+
+func convertTermDeposit(deposit db.TermDeposit, currency string, balance int64, accountNumbers map[int64]string) *pb.TermDeposit {
+	rsp := &pb.TermDeposit{
+		AccountNumber:        accountNumbers[deposit.AccountID],
+		SourceAccountNumber:  accountNumbers[deposit.SourceAccountID],
+		TermMonths:           deposit.TermMonths,
+		AnnualRateBps:        deposit.AnnualRateBps,
+		EarlyBreakPenaltyBps: deposit.EarlyBreakPenaltyBps,
+		Principal:            deposit.Principal,
+		Currency:             currency,
+		Balance:              balance,
+		StartsOn:             deposit.StartsOn.Format(termDepositDateLayout),
+		MaturesOn:            deposit.MaturesOn.Format(termDepositDateLayout),
+		OnMaturity:           deposit.OnMaturity,
+		Status:               deposit.Status,
+		CreatedAt:            timestamppb.New(deposit.CreatedAt),
+	}
+	if deposit.ClosedAt.Valid {
+		rsp.ClosedAt = timestamppb.New(deposit.ClosedAt.Time)
+	}
+	return rsp
+}
+
+func convertWebhookEndpoint(endpoint db.WebhookEndpoint) *pb.WebhookEndpoint {
+	return &pb.WebhookEndpoint{
+		Id:         endpoint.ID,
+		Url:        endpoint.Url,
+		EventTypes: endpoint.EventTypes,
+		CreatedAt:  timestamppb.New(endpoint.CreatedAt),
+	}
+}
+
+func convertWebhookDelivery(delivery db.WebhookDelivery) *pb.WebhookDelivery {
+	rsp := &pb.WebhookDelivery{
+		Id:             delivery.ID,
+		EndpointId:     delivery.EndpointID,
+		EventId:        delivery.EventID.String(),
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.DeliveredAt.Valid {
+		rsp.DeliveredAt = timestamppb.New(delivery.DeliveredAt.Time)
+	}
+	return rsp
+}
+
+func convertNotificationPreference(preference db.NotificationPreference) *pb.NotificationPreference {
+	rsp := &pb.NotificationPreference{
+		EventType: preference.EventType,
+		Channels:  preference.Channels,
+		Threshold: preference.Threshold,
+	}
+	if !preference.UpdatedAt.IsZero() {
+		rsp.UpdatedAt = timestamppb.New(preference.UpdatedAt)
 	}
+	return rsp
 }
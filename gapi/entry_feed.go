@@ -0,0 +1,128 @@
+package gapi
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const entryFeedPingInterval = 90 * time.Second
+
+// entryFeed listens for the entry notifications Postgres sends on commit and
+// wakes the streams watching the accounts they are for. A wake-up only tells
+// a stream to read the entries past its cursor again, so a missed or merged
+// notification never loses an entry.
+type entryFeed struct {
+	dbSource string
+	once     sync.Once
+
+	mu          sync.Mutex
+	subscribers map[int64]map[chan struct{}]struct{}
+}
+
+func newEntryFeed(dbSource string) *entryFeed {
+	return &entryFeed{
+		dbSource:    dbSource,
+		subscribers: make(map[int64]map[chan struct{}]struct{}),
+	}
+}
+
+// subscribe returns a channel that receives a value whenever new entries may
+// have been committed for the account, and a function to stop receiving them.
+// The listener connection is opened by the first subscriber.
+func (feed *entryFeed) subscribe(accountID int64) (<-chan struct{}, func()) {
+	feed.once.Do(feed.start)
+
+	wake := make(chan struct{}, 1)
+
+	feed.mu.Lock()
+	if feed.subscribers[accountID] == nil {
+		feed.subscribers[accountID] = make(map[chan struct{}]struct{})
+	}
+	feed.subscribers[accountID][wake] = struct{}{}
+	feed.mu.Unlock()
+
+	unsubscribe := func() {
+		feed.mu.Lock()
+		defer feed.mu.Unlock()
+
+		delete(feed.subscribers[accountID], wake)
+		if len(feed.subscribers[accountID]) == 0 {
+			delete(feed.subscribers, accountID)
+		}
+	}
+
+	return wake, unsubscribe
+}
+
+func (feed *entryFeed) start() {
+	listener := pq.NewListener(feed.dbSource, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Error().Err(err).Msg("entry feed listener")
+		}
+	})
+
+	err := listener.Listen(db.AccountEntriesChannel)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot listen for entry notifications")
+	}
+
+	go feed.run(listener)
+}
+
+func (feed *entryFeed) run(listener *pq.Listener) {
+	ticker := time.NewTicker(entryFeedPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case notification := <-listener.Notify:
+			// nil is sent after a reconnect, when notifications may have been lost
+			if notification == nil {
+				feed.wakeAll()
+				continue
+			}
+
+			var payload db.EntryNotification
+			if err := json.Unmarshal([]byte(notification.Extra), &payload); err != nil {
+				log.Error().Err(err).Str("payload", notification.Extra).Msg("invalid entry notification")
+				continue
+			}
+			feed.wake(payload.AccountID)
+		case <-ticker.C:
+			go listener.Ping()
+		}
+	}
+}
+
+func (feed *entryFeed) wake(accountID int64) {
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	for wake := range feed.subscribers[accountID] {
+		notify(wake)
+	}
+}
+
+func (feed *entryFeed) wakeAll() {
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	for _, subscribers := range feed.subscribers {
+		for wake := range subscribers {
+			notify(wake)
+		}
+	}
+}
+
+// notify wakes a subscriber without blocking; one pending wake-up is enough.
+func notify(wake chan struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
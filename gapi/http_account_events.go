@@ -0,0 +1,162 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/nhat195/simple_bank/authz"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// AccountEventsPattern and AccountEventsByNumberPattern are the gateway routes
+// streaming the activity of an account, by its ID or its account number.
+const (
+	AccountEventsPattern         = "/v1/accounts/{id}/events"
+	AccountEventsByNumberPattern = "/v1/accounts/by_number/{account_number}/events"
+)
+
+const (
+	lastEventIDHeader    = "Last-Event-ID"
+	sseHeartbeatInterval = 15 * time.Second
+	// accessTokenParam names the cookie and query parameter an event stream
+	// takes the access token from, since EventSource cannot set headers.
+	accessTokenParam = "access_token"
+)
+
+// Names of the events sent by AccountEvents
+const (
+	sseBalanceEvent     = "balance"
+	sseTransactionEvent = "transaction"
+	sseErrorEvent       = "error"
+)
+
+// AccountEvents streams the activity of an account as Server-Sent Events, for
+// browsers that cannot use WatchAccount through the gateway. Each event carries
+// a WatchAccountResponse as JSON and the ID of its last entry, so a client that
+// reconnects with Last-Event-ID gets every entry it missed. A comment is sent
+// when the stream is idle to keep proxies from closing it.
+func (server *Server) AccountEvents(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	authHeader := eventStreamAuthorization(r)
+	authPayload, err := server.verifyAuthorizationHeader(authHeader)
+	if err != nil {
+		writeHTTPError(w, unauthenticatedError(err))
+		return
+	}
+
+	var accountID int64
+	if id, ok := pathParams["id"]; ok {
+		accountID, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			writeHTTPError(w, status.Errorf(codes.InvalidArgument, "invalid id: %s", id))
+			return
+		}
+	}
+	accountNumber := pathParams["account_number"]
+	if violations := validateAccountRef("id", "account_number", accountID, accountNumber); len(violations) > 0 {
+		writeHTTPError(w, invalidArgumentError(violations))
+		return
+	}
+
+	accountID, err = server.resolveAccountID(r.Context(), accountID, accountNumber)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	var afterEntryID int64
+	if lastEventID := r.Header.Get(lastEventIDHeader); lastEventID != "" {
+		afterEntryID, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || afterEntryID < 0 {
+			writeHTTPError(w, status.Errorf(codes.InvalidArgument, "invalid %s: %s", lastEventIDHeader, lastEventID))
+			return
+		}
+	}
+
+	if _, err := server.getAuthorizedAccount(r.Context(), authPayload.Username, accountID, authz.ViewAccount); err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	err = server.watchAccountActivity(r.Context(), accountActivityWatch{
+		accountID:    accountID,
+		afterEntryID: afterEntryID,
+		authorize: func(ctx context.Context) error {
+			if _, err := server.verifyAuthorizationHeader(authHeader); err != nil {
+				return unauthenticatedError(err)
+			}
+			_, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount)
+			return err
+		},
+		send: func(rsp *pb.WatchAccountResponse) error {
+			event := sseBalanceEvent
+			if rsp.Transaction != nil {
+				event = sseTransactionEvent
+			}
+			return writeSSEEvent(w, rc, strconv.FormatInt(rsp.GetLastEntryId(), 10), event, rsp)
+		},
+		heartbeat: func() error {
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+			return rc.Flush()
+		},
+		heartbeatInterval: sseHeartbeatInterval,
+	})
+	if err != nil && r.Context().Err() == nil {
+		// the status line is gone, so the error ends the stream as an event
+		writeSSEEvent(w, rc, "", sseErrorEvent, status.Convert(err).Proto())
+	}
+}
+
+// eventStreamAuthorization returns the authorization header of an event stream
+// request. Without one, the access token is taken from the access_token cookie,
+// or else the query parameter, as a bearer token.
+func eventStreamAuthorization(r *http.Request) string {
+	if authHeader := r.Header.Get(authorizationHeader); authHeader != "" {
+		return authHeader
+	}
+
+	accessToken := r.URL.Query().Get(accessTokenParam)
+	if cookie, err := r.Cookie(accessTokenParam); err == nil && cookie.Value != "" {
+		accessToken = cookie.Value
+	}
+	if accessToken == "" {
+		return ""
+	}
+	return authorizationBearer + " " + accessToken
+}
+
+// writeSSEEvent writes a message as the JSON data of an event and flushes it.
+// An empty id leaves the last event ID of the client unchanged.
+func writeSSEEvent(w http.ResponseWriter, rc *http.ResponseController, id string, event string, msg proto.Message) error {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to marshal event: %v", err)
+	}
+
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
@@ -0,0 +1,358 @@
+package gapi
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/nhat195/simple_bank/db/mock"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/token"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// sseEvent is an event as a client reads it off the stream.
+type sseEvent struct {
+	id    string
+	event string
+	data  *pb.WatchAccountResponse
+}
+
+func TestAccountEvents(t *testing.T) {
+	username := util.RandomOwner()
+	account := randomAccount(username)
+	counterparty := randomAccount(util.RandomOwner())
+	counterparty.ID = account.ID + 1
+	numbers := []db.ListAccountNumbersRow{
+		{ID: account.ID, AccountNumber: account.AccountNumber},
+		{ID: counterparty.ID, AccountNumber: counterparty.AccountNumber},
+	}
+	activity := []db.ListAccountActivityRow{
+		randomActivity(account, counterparty, 6, account.Balance+10),
+		randomActivity(account, counterparty, 7, account.Balance+20),
+	}
+
+	testCases := []struct {
+		name          string
+		pathParams    map[string]string
+		setupRequest  func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore, cancel context.CancelFunc)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				request.Header.Set(authorizationHeader, authorizationBearer+" "+createToken(t, tokenMaker, username))
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				expectViewer(store, account, username, 2)
+				store.EXPECT().
+					GetAccountActivityHead(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(db.GetAccountActivityHeadRow{Balance: account.Balance, LastEntryID: 5}, nil)
+				expectActivity(store, account, 5, nil, numbers, cancel)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+
+				events := readSSEEvents(t, recorder)
+				require.Len(t, events, 1)
+				require.Equal(t, "5", events[0].id)
+				require.Equal(t, sseBalanceEvent, events[0].event)
+				require.Nil(t, events[0].data.GetTransaction())
+				require.Equal(t, account.Balance, events[0].data.GetBalance())
+				require.Equal(t, int64(5), events[0].data.GetLastEntryId())
+			},
+		},
+		{
+			name:       "ResumeFromLastEventID",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				request.Header.Set(authorizationHeader, authorizationBearer+" "+createToken(t, tokenMaker, username))
+				request.Header.Set(lastEventIDHeader, "5")
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				expectViewer(store, account, username, 2)
+				store.EXPECT().
+					GetAccountActivityHead(gomock.Any(), gomock.Any()).
+					Times(0)
+				expectActivity(store, account, 5, activity, numbers, cancel)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				events := readSSEEvents(t, recorder)
+				require.Len(t, events, len(activity))
+				for i, row := range activity {
+					require.Equal(t, fmt.Sprint(row.Entry.ID), events[i].id)
+					require.Equal(t, sseTransactionEvent, events[i].event)
+					require.Equal(t, row.BalanceAfter, events[i].data.GetBalance())
+					require.Equal(t, row.Entry.ID, events[i].data.GetLastEntryId())
+
+					transaction := events[i].data.GetTransaction()
+					require.Equal(t, row.Entry.Amount, transaction.GetAmount())
+					require.Equal(t, account.AccountNumber, transaction.GetAccountNumber())
+					require.Equal(t, counterparty.AccountNumber, transaction.GetCounterpartyAccountNumber())
+					require.Equal(t, float64(row.Entry.ID), transaction.GetMetadata().AsMap()["entry"])
+				}
+			},
+		},
+		{
+			name:       "AccountNumber",
+			pathParams: map[string]string{"account_number": account.AccountNumber},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				request.Header.Set(authorizationHeader, authorizationBearer+" "+createToken(t, tokenMaker, username))
+				request.Header.Set(lastEventIDHeader, "5")
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				store.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				expectViewer(store, account, username, 2)
+				expectActivity(store, account, 5, nil, numbers, cancel)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Empty(t, readSSEEvents(t, recorder))
+			},
+		},
+		{
+			name:       "TokenInQuery",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				query := request.URL.Query()
+				query.Set(accessTokenParam, createToken(t, tokenMaker, username))
+				request.URL.RawQuery = query.Encode()
+				request.Header.Set(lastEventIDHeader, "5")
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				expectViewer(store, account, username, 2)
+				expectActivity(store, account, 5, nil, numbers, cancel)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "TokenInCookie",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				request.AddCookie(&http.Cookie{Name: accessTokenParam, Value: createToken(t, tokenMaker, username)})
+				request.Header.Set(lastEventIDHeader, "5")
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				expectViewer(store, account, username, 2)
+				expectActivity(store, account, 5, nil, numbers, cancel)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "NoAuthorization",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidToken",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				query := request.URL.Query()
+				query.Set(accessTokenParam, "invalid")
+				request.URL.RawQuery = query.Encode()
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:       "UnauthorizedAccount",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				request.Header.Set(authorizationHeader, authorizationBearer+" "+createToken(t, tokenMaker, "unauthorized_user"))
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Eq(db.GetAccountMemberParams{AccountID: account.ID, Username: "unauthorized_user"})).
+					Times(1).
+					Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListAccountActivity(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidLastEventID",
+			pathParams: map[string]string{"id": fmt.Sprint(account.ID)},
+			setupRequest: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				request.Header.Set(authorizationHeader, authorizationBearer+" "+createToken(t, tokenMaker, username))
+				request.Header.Set(lastEventIDHeader, "abc")
+			},
+			buildStubs: func(store *mockdb.MockStore, cancel context.CancelFunc) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store, cancel)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/v1/accounts/events", nil).WithContext(ctx)
+			tc.setupRequest(t, request, server.tokenMaker)
+
+			server.AccountEvents(recorder, request, tc.pathParams)
+			require.NotErrorIs(t, ctx.Err(), context.DeadlineExceeded, "stream did not end")
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func createToken(t *testing.T, tokenMaker token.Maker, username string) string {
+	accessToken, _, err := tokenMaker.CreateToken(username, time.Minute)
+	require.NoError(t, err)
+	return accessToken
+}
+
+// expectViewer expects the user to be authorized to view the account the
+// given number of times: once up front and once per batch of the stream.
+func expectViewer(store *mockdb.MockStore, account db.Account, username string, times int) {
+	store.EXPECT().
+		GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+		Times(times).
+		Return(account, nil)
+	store.EXPECT().
+		GetAccountMember(gomock.Any(), gomock.Eq(db.GetAccountMemberParams{AccountID: account.ID, Username: username})).
+		Times(times).
+		Return(db.AccountMember{AccountID: account.ID, Username: username, Role: util.MemberViewer}, nil)
+}
+
+// expectActivity expects one batch of activity after the given entry and ends
+// the stream once it is sent.
+func expectActivity(store *mockdb.MockStore, account db.Account, afterID int64, activity []db.ListAccountActivityRow, numbers []db.ListAccountNumbersRow, cancel context.CancelFunc) {
+	store.EXPECT().
+		ListAccountActivity(gomock.Any(), gomock.Eq(db.ListAccountActivityParams{
+			AccountID: account.ID,
+			AfterID:   afterID,
+			RowLimit:  accountActivityBatchSize,
+		})).
+		Times(1).
+		Return(activity, nil)
+	store.EXPECT().
+		ListAccountNumbers(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, ids []int64) ([]db.ListAccountNumbersRow, error) {
+			cancel()
+			return numbers, nil
+		})
+}
+
+// readSSEEvents parses the events written to the stream, skipping comments.
+func readSSEEvents(t *testing.T, recorder *httptest.ResponseRecorder) []sseEvent {
+	var events []sseEvent
+	var event sseEvent
+
+	scanner := bufio.NewScanner(recorder.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		field, value, _ := strings.Cut(line, ": ")
+		switch {
+		case line == "":
+			if event.event != "" {
+				events = append(events, event)
+			}
+			event = sseEvent{}
+		case strings.HasPrefix(line, ":"):
+		case field == "id":
+			event.id = value
+		case field == "event":
+			event.event = value
+		case field == "data":
+			event.data = &pb.WatchAccountResponse{}
+			require.NoError(t, protojson.Unmarshal([]byte(value), event.data))
+		default:
+			t.Fatalf("unexpected line %q", line)
+		}
+	}
+	require.NoError(t, scanner.Err())
+	require.Empty(t, event.event, "last event is not terminated by a blank line")
+
+	return events
+}
+
+func randomAccount(owner string) db.Account {
+	return db.Account{
+		ID:            util.RandomInt(1, 1000),
+		Owner:         owner,
+		Balance:       util.RandomMoney(),
+		Currency:      util.RandomCurrency(),
+		AccountNumber: util.RandomAccountNumber(),
+	}
+}
+
+func randomActivity(account db.Account, counterparty db.Account, entryID int64, balanceAfter int64) db.ListAccountActivityRow {
+	return db.ListAccountActivityRow{
+		Entry: db.Entry{
+			ID:         entryID,
+			AccountID:  account.ID,
+			TransferID: sql.NullInt64{Int64: util.RandomInt(1, 1000), Valid: true},
+			Amount:     10,
+			CreatedAt:  time.Now(),
+		},
+		Currency:              account.Currency,
+		CounterpartyAccountID: counterparty.ID,
+		Metadata:              json.RawMessage(fmt.Sprintf(`{"entry":%d}`, entryID)),
+		BalanceAfter:          balanceAfter,
+	}
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/statement"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// StatementDownloadPattern is the gateway route serving generated statements.
+const StatementDownloadPattern = "/v1/statements/{statement_id}/download"
+
+func statementDownloadPath(id uuid.UUID) string {
+	return fmt.Sprintf("/v1/statements/%s/download", id)
+}
+
+// DownloadStatement serves the document of a generated statement. It is mounted
+// directly on the gateway mux since an RPC cannot answer with a raw file body.
+func (server *Server) DownloadStatement(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	authPayload, err := server.verifyAuthorizationHeader(r.Header.Get(authorizationHeader))
+	if err != nil {
+		writeHTTPError(w, unauthenticatedError(err))
+		return
+	}
+
+	id, err := uuid.Parse(pathParams["statement_id"])
+	if err != nil {
+		writeHTTPError(w, status.Errorf(codes.InvalidArgument, "invalid statement_id: %v", err))
+		return
+	}
+
+	stmt, err := server.store.GetStatement(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			writeHTTPError(w, status.Errorf(codes.NotFound, "statement not found"))
+			return
+		}
+		writeHTTPError(w, status.Errorf(codes.Internal, "Failed to get statement: %v", err))
+		return
+	}
+
+	if stmt.Username != authPayload.Username {
+		writeHTTPError(w, status.Errorf(codes.PermissionDenied, "statement doesn't belong to the authenticated user"))
+		return
+	}
+
+	// a member removed from the account loses access to statements requested earlier
+	account, err := server.getAuthorizedAccount(r.Context(), authPayload.Username, stmt.AccountID, authz.ViewAccount)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	switch stmt.Status {
+	case db.StatementReady:
+	case db.StatementFailed:
+		writeHTTPError(w, status.Errorf(codes.FailedPrecondition, "statement generation failed: %s", stmt.FailureReason))
+		return
+	default:
+		writeHTTPError(w, status.Errorf(codes.Unavailable, "statement is not ready yet"))
+		return
+	}
+
+	w.Header().Set("Content-Type", statement.ContentType(stmt.Format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", statement.FileName(stmt, account.AccountNumber)))
+	w.Header().Set("Content-Length", strconv.Itoa(len(stmt.Content)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(stmt.Content)
+}
+
+// writeHTTPError writes a gRPC status the way the gateway renders RPC errors.
+func writeHTTPError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		body = []byte(`{"code": 13, "message": "failed to marshal error message"}`)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	w.Write(body)
+}
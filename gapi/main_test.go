@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, store db.Store) *Server {
+	config := util.Config{
+		TokenSymmetricKey:   util.RandomString(32),
+		AccessTokenDuration: time.Minute,
+	}
+
+	server, err := NewServer(config, store, nil)
+	require.NoError(t, err)
+
+	// there is no database to listen on; streams fall back to polling
+	server.entryFeed.once.Do(func() {})
+	return server
+}
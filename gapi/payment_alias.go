@@ -0,0 +1,39 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// resolveRecipientAccount finds the account an alias receives payments in the
+// currency into. Both an unknown alias and a recipient without an account in the
+// currency come back as sql.ErrNoRows.
+func (server *Server) resolveRecipientAccount(ctx context.Context, aliasType string, alias string, currency string) (db.Account, error) {
+	user, err := server.store.ResolveAlias(ctx, aliasType, alias)
+	if err != nil {
+		return db.Account{}, err
+	}
+
+	return server.store.GetDefaultAccount(ctx, db.GetDefaultAccountParams{
+		Owner:    user.Username,
+		Currency: currency,
+	})
+}
+
+// validateAlias checks an alias against the rules of its type, reporting the
+// violations on the two fields the alias was given in.
+func validateAlias(typeField string, aliasField string, aliasType string, alias string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAliasType(aliasType); err != nil {
+		return append(violations, fieldViolation(typeField, err))
+	}
+
+	if err := val.ValidateAlias(aliasType, util.NormalizeAlias(aliasType, alias)); err != nil {
+		violations = append(violations, fieldViolation(aliasField, err))
+	}
+
+	return violations
+}
@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getOpenPaymentRequest loads a payment request that username, as its payer,
+// can still accept or decline.
+func (server *Server) getOpenPaymentRequest(ctx context.Context, username string, id uuid.UUID) (db.PaymentRequest, error) {
+	request, err := server.store.GetPaymentRequest(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return request, status.Errorf(codes.NotFound, "payment request not found")
+		}
+		return request, status.Errorf(codes.Internal, "Failed to get payment request: %v", err)
+	}
+
+	if request.Payer != username {
+		return request, status.Errorf(codes.PermissionDenied, "only the payer can answer the payment request")
+	}
+
+	if err := request.CheckOpen(time.Now()); err != nil {
+		return request, paymentRequestError(err)
+	}
+
+	return request, nil
+}
+
+// paymentRequestError turns the errors of answering a payment request into gRPC errors.
+func paymentRequestError(err error) error {
+	switch {
+	case errors.Is(err, db.ErrPaymentRequestExpired):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, db.ErrPaymentRequestClosed), errors.Is(err, sql.ErrNoRows):
+		// answering finds no pending row when another answer got in first
+		return status.Errorf(codes.FailedPrecondition, "%v", db.ErrPaymentRequestClosed)
+	}
+	return status.Errorf(codes.Internal, "Failed to answer payment request: %v", err)
+}
@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) AcceptPaymentRequest(ctx context.Context, req *pb.AcceptPaymentRequestRequest) (*pb.AcceptPaymentRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateAcceptPaymentRequestRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	request, err := server.getOpenPaymentRequest(ctx, authPayload.Username, uuid.MustParse(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccountID, err := server.resolveAccountID(ctx, req.GetFromAccountId(), req.GetFromAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := server.getAuthorizedAccount(ctx, authPayload.Username, fromAccountID, authz.MoveMoney)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(fromAccount, request.Currency); err != nil {
+		return nil, err
+	}
+
+	if err := server.checkPayeeCoolingOff(ctx, authPayload.Username, request.PayeeAccountID, request.Amount); err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx, db.AcceptPaymentRequestTxParams{
+		PaymentRequestID: request.ID,
+		FromAccountID:    fromAccount.ID,
+	})
+	if err != nil {
+		return nil, paymentRequestError(err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, result.PaymentRequest.PayeeAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.AcceptPaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(result.PaymentRequest, numbers),
+		Fee:            result.Transfer.Fee,
+		Balance:        result.Transfer.FromAccount.Balance,
+	}
+	return rsp, nil
+}
+
+func validateAcceptPaymentRequestRequest(req *pb.AcceptPaymentRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	violations = append(violations, validateAccountRef("from_account_id", "from_account_number", req.GetFromAccountId(), req.GetFromAccountNumber())...)
+
+	return violations
+}
@@ -0,0 +1,93 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthorizeCardPayment debits the account linked to a card for a merchant
+// payment. It is called by the card network gateway, which signs in as a banker.
+func (server *Server) AuthorizeCardPayment(ctx context.Context, req *pb.AuthorizeCardPaymentRequest) (*pb.AuthorizeCardPaymentResponse, error) {
+	if _, err := server.authorizeBanker(ctx); err != nil {
+		return nil, err
+	}
+
+	violations := validateAuthorizeCardPaymentRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	number := util.NormalizeCardNumber(req.GetCardNumber())
+	result, err := server.store.AuthorizeCardTx(ctx, db.AuthorizeCardTxParams{
+		Token:        util.CardToken(server.config.CardTokenKey, number),
+		ExpiryMonth:  req.GetExpiryMonth(),
+		ExpiryYear:   req.GetExpiryYear(),
+		CVV:          req.GetCvv(),
+		Amount:       req.GetAmount(),
+		Currency:     req.GetCurrency(),
+		MerchantName: req.GetMerchantName(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "card %s not found", util.MaskCardNumber(number))
+		case errors.Is(err, db.ErrCardDetailsInvalid):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		case errors.Is(err, db.ErrCurrencyMismatch):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, db.ErrCardFrozen), errors.Is(err, db.ErrCardCancelled),
+			errors.Is(err, db.ErrCardExpired), errors.Is(err, db.ErrInsufficientFunds):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to authorize card payment: %v", err)
+	}
+
+	rsp := &pb.AuthorizeCardPaymentResponse{
+		AuthorizationId: result.Authorization.ID,
+		Card:            convertCard(result.Card, accountNumbersOf(result.Transfer.FromAccount)),
+		TransferId:      result.Transfer.Transfer.ID,
+	}
+	return rsp, nil
+}
+
+func validateAuthorizeCardPaymentRequest(req *pb.AuthorizeCardPaymentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCardNumber(util.NormalizeCardNumber(req.GetCardNumber())); err != nil {
+		violations = append(violations, fieldViolation("card_number", err))
+	}
+
+	if err := val.ValidateExpiryMonth(req.GetExpiryMonth()); err != nil {
+		violations = append(violations, fieldViolation("expiry_month", err))
+	}
+
+	if req.GetExpiryYear() < 2000 {
+		violations = append(violations, fieldViolation("expiry_year", fmt.Errorf("must be a four digit year")))
+	}
+
+	if err := val.ValidateCVV(req.GetCvv()); err != nil {
+		violations = append(violations, fieldViolation("cvv", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateMerchantName(req.GetMerchantName()); err != nil {
+		violations = append(violations, fieldViolation("merchant_name", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,169 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) BatchTransfer(ctx context.Context, req *pb.BatchTransferRequest) (*pb.BatchTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateBatchTransferRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccountID, err := server.resolveAccountID(ctx, req.GetFromAccountId(), req.GetFromAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := server.getAuthorizedAccount(ctx, authPayload.Username, fromAccountID, authz.MoveMoney)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(fromAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	arg := db.BatchTransferTxParams{
+		FromAccountID: fromAccountID,
+		Legs:          make([]db.BatchTransferLeg, len(req.GetLegs())),
+	}
+	for i, leg := range req.GetLegs() {
+		toAccountID := leg.GetToAccountId()
+		if leg.GetBeneficiaryId() != 0 {
+			beneficiary, err := server.getBeneficiary(ctx, authPayload.Username, leg.GetBeneficiaryId())
+			if err != nil {
+				return nil, err
+			}
+			toAccountID = beneficiary.AccountID
+		} else if leg.GetToAlias() != "" {
+			toAccount, err := server.resolveRecipientAccount(ctx, leg.GetToAliasType(), leg.GetToAlias(), req.GetCurrency())
+			if err != nil {
+				if err == sql.ErrNoRows {
+					err = fmt.Errorf("no recipient with a %s account found", req.GetCurrency())
+					return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation(fmt.Sprintf("legs[%d].to_alias", i), err)})
+				}
+				return nil, status.Errorf(codes.Internal, "Failed to resolve alias: %v", err)
+			}
+			toAccountID = toAccount.ID
+		} else if leg.GetToAccountNumber() != "" {
+			toAccountID, err = server.resolveAccountID(ctx, 0, leg.GetToAccountNumber())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := server.checkPayeeCoolingOff(ctx, authPayload.Username, toAccountID, leg.GetAmount()); err != nil {
+			st := status.Convert(err)
+			return nil, status.Errorf(st.Code(), "legs[%d]: %s", i, st.Message())
+		}
+
+		arg.Legs[i] = db.BatchTransferLeg{
+			ToAccountID: toAccountID,
+			Amount:      leg.GetAmount(),
+			Description: leg.GetDescription(),
+			Reference:   leg.GetReference(),
+		}
+	}
+
+	result, err := server.store.BatchTransferTx(ctx, arg)
+	if err != nil {
+		var legErr *db.BatchLegError
+		if errors.As(err, &legErr) {
+			field := fmt.Sprintf("legs[%d].to_account_id", legErr.Index)
+			if errors.Is(legErr.Err, db.ErrInvalidAmount) {
+				field = fmt.Sprintf("legs[%d].amount", legErr.Index)
+			}
+			if errors.Is(legErr.Err, sql.ErrNoRows) {
+				legErr.Err = fmt.Errorf("account not found")
+			}
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation(field, legErr.Err)})
+		}
+		if errors.Is(err, db.ErrTermDepositLocked) || errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to execute batch transfer: %v", err)
+	}
+
+	rsp := &pb.BatchTransferResponse{
+		BatchId:           result.Batch.ID.String(),
+		FromAccountNumber: result.FromAccount.AccountNumber,
+		TotalAmount:       result.Batch.TotalAmount,
+		TotalFee:          result.Batch.TotalFee,
+		Balance:           result.FromAccount.Balance,
+		Legs:              make([]*pb.BatchTransferLegResult, len(result.Legs)),
+		CreatedAt:         timestamppb.New(result.Batch.CreatedAt),
+	}
+	for i, leg := range result.Legs {
+		rsp.Legs[i] = &pb.BatchTransferLegResult{
+			Index:           int32(leg.Index),
+			TransferId:      leg.Transfer.ID,
+			ToAccountNumber: leg.ToAccount.AccountNumber,
+			Amount:          leg.Transfer.Amount,
+			Fee:             leg.Fee,
+		}
+	}
+	return rsp, nil
+}
+
+func validateBatchTransferRequest(req *pb.BatchTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("from_account_id", "from_account_number", req.GetFromAccountId(), req.GetFromAccountNumber())...)
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateBatchSize(len(req.GetLegs()), db.MaxBatchTransferLegs); err != nil {
+		violations = append(violations, fieldViolation("legs", err))
+	}
+
+	for i, leg := range req.GetLegs() {
+		if leg.GetBeneficiaryId() != 0 {
+			if leg.GetToAccountId() != 0 || leg.GetToAccountNumber() != "" || leg.GetToAlias() != "" || leg.GetToAliasType() != "" {
+				violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].beneficiary_id", i), fmt.Errorf("must not be set together with to_account_id, to_account_number or to_alias")))
+			} else if err := val.ValidateID(leg.GetBeneficiaryId()); err != nil {
+				violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].beneficiary_id", i), err))
+			}
+		} else if leg.GetToAlias() != "" || leg.GetToAliasType() != "" {
+			if leg.GetToAccountId() != 0 || leg.GetToAccountNumber() != "" {
+				violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].to_alias", i), fmt.Errorf("must not be set together with to_account_id or to_account_number")))
+			}
+			violations = append(violations, validateAlias(fmt.Sprintf("legs[%d].to_alias_type", i), fmt.Sprintf("legs[%d].to_alias", i), leg.GetToAliasType(), leg.GetToAlias())...)
+		} else {
+			violations = append(violations, validateAccountRef(fmt.Sprintf("legs[%d].to_account_id", i), fmt.Sprintf("legs[%d].to_account_number", i), leg.GetToAccountId(), leg.GetToAccountNumber())...)
+			if leg.GetToAccountId() != 0 && leg.GetToAccountId() == req.GetFromAccountId() {
+				violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].to_account_id", i), db.ErrSameAccount))
+			}
+		}
+
+		if err := val.ValidateAmount(leg.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].amount", i), err))
+		}
+
+		if err := val.ValidateDescription(leg.GetDescription()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].description", i), err))
+		}
+
+		if err := val.ValidateReference(leg.GetReference()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].reference", i), err))
+		}
+	}
+
+	return violations
+}
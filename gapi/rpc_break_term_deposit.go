@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BreakTermDeposit pays a term deposit back to the account it was funded from
+// before it matures, with the early break penalty taken off its interest.
+func (server *Server) BreakTermDeposit(ctx context.Context, req *pb.BreakTermDepositRequest) (*pb.BreakTermDepositResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateBreakTermDepositRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	deposit, err := server.store.GetTermDeposit(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "term deposit [%d] not found", accountID)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get term deposit: %v", err)
+	}
+
+	if _, err := server.getAuthorizedAccount(ctx, authPayload.Username, deposit.SourceAccountID, authz.MoveMoney); err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.BreakTermDepositTx(ctx, deposit.AccountID, time.Now().UTC().Truncate(24*time.Hour))
+	if err != nil {
+		if errors.Is(err, db.ErrTermDepositClosed) || errors.Is(err, db.ErrTermDepositMatured) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to break term deposit: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, result.TermDeposit.AccountID, result.TermDeposit.SourceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.BreakTermDepositResponse{
+		TermDeposit: convertTermDeposit(result.TermDeposit, result.Payout.FromAccount.Currency, result.Payout.FromAccount.Balance, numbers),
+		Interest:    result.Interest,
+	}
+	return rsp, nil
+}
+
+func validateBreakTermDepositRequest(req *pb.BreakTermDepositRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	return validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())
+}
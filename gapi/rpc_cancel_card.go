@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// CancelCard blocks payments with a card for good.
+func (server *Server) CancelCard(ctx context.Context, req *pb.CancelCardRequest) (*pb.CancelCardResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCancelCardRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	card, err := server.setCardStatus(ctx, authPayload.Username, req.GetId(), db.CardCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := server.accountNumbers(ctx, card.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CancelCardResponse{
+		Card: convertCard(card, numbers),
+	}
+	return rsp, nil
+}
+
+func validateCancelCardRequest(req *pb.CancelCardRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
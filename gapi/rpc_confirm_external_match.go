@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ConfirmExternalMatch(ctx context.Context, req *pb.ConfirmExternalMatchRequest) (*pb.ConfirmExternalMatchResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateConfirmExternalMatchRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transaction, err := server.store.ConfirmExternalMatchTx(ctx, db.ConfirmExternalMatchTxParams{
+		ExternalTransactionID: req.GetExternalTransactionId(),
+		TransferID:            req.GetTransferId(),
+		ConfirmedBy:           authPayload.Username,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "external transaction or transfer not found")
+		case errors.Is(err, db.ErrAlreadyConfirmed), errors.Is(err, db.ErrTransferMismatch):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return nil, status.Errorf(codes.AlreadyExists, "transfer is already matched to another external transaction")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to confirm match: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, transaction.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ConfirmExternalMatchResponse{
+		Transaction: convertExternalTransaction(transaction, numbers),
+	}
+	return rsp, nil
+}
+
+func validateConfirmExternalMatchRequest(req *pb.ConfirmExternalMatchRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetExternalTransactionId()); err != nil {
+		violations = append(violations, fieldViolation("external_transaction_id", err))
+	}
+
+	if err := val.ValidateID(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+
+	return violations
+}
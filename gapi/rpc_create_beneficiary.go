@@ -0,0 +1,102 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateBeneficiary saves a payee to the address book of the user. The payee can
+// be given by account ID or by an alias, which is resolved to its account once.
+func (server *Server) CreateBeneficiary(ctx context.Context, req *pb.CreateBeneficiaryRequest) (*pb.CreateBeneficiaryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateBeneficiaryRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetAlias() != "" {
+		account, err := server.resolveRecipientAccount(ctx, req.GetAliasType(), req.GetAlias(), req.GetCurrency())
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "no recipient with a %s account found for the alias", req.GetCurrency())
+			}
+			return nil, status.Errorf(codes.Internal, "Failed to resolve alias: %v", err)
+		}
+		accountID = account.ID
+	}
+
+	beneficiary, err := server.store.AddBeneficiary(ctx, db.AddBeneficiaryParams{
+		Username:   authPayload.Username,
+		Nickname:   req.GetNickname(),
+		AccountID:  accountID,
+		Currency:   req.GetCurrency(),
+		HolderName: req.GetHolderName(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		if errors.Is(err, db.ErrCurrencyMismatch) {
+			return nil, status.Errorf(codes.InvalidArgument, "account [%d] does not hold %s", accountID, req.GetCurrency())
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return nil, status.Errorf(codes.AlreadyExists, "account [%d] is already saved as a beneficiary", accountID)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create beneficiary: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, beneficiary.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CreateBeneficiaryResponse{
+		Beneficiary: convertBeneficiary(beneficiary, numbers),
+	}
+	return rsp, nil
+}
+
+func validateCreateBeneficiaryRequest(req *pb.CreateBeneficiaryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateNickname(req.GetNickname()); err != nil {
+		violations = append(violations, fieldViolation("nickname", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if req.GetAlias() != "" || req.GetAliasType() != "" {
+		if req.GetAccountId() != 0 || req.GetAccountNumber() != "" {
+			violations = append(violations, fieldViolation("alias", fmt.Errorf("must not be set together with account_id or account_number")))
+		}
+		violations = append(violations, validateAlias("alias_type", "alias", req.GetAliasType(), req.GetAlias())...)
+	} else {
+		violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+	}
+
+	if req.GetHolderName() != "" {
+		if err := val.ValidateFullName(req.GetHolderName()); err != nil {
+			violations = append(violations, fieldViolation("holder_name", err))
+		}
+	}
+
+	return violations
+}
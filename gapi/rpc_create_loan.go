@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const loanDateLayout = "2006-01-02"
+
+// CreateLoan grants a term loan and pays it into a customer account. Loans are
+// granted by bankers; the customer repays them through the monthly collection.
+func (server *Server) CreateLoan(ctx context.Context, req *pb.CreateLoanRequest) (*pb.CreateLoanResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateLoanRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	result, err := server.store.CreateLoanTx(ctx, db.CreateLoanTxParams{
+		AccountID:     accountID,
+		Principal:     req.GetPrincipal(),
+		AnnualRateBps: req.GetAnnualRateBps(),
+		TermMonths:    req.GetTermMonths(),
+		CreatedBy:     authPayload.Username,
+		DisbursedOn:   time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		case errors.Is(err, db.ErrLoanAccount):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create loan: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, result.Loan.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CreateLoanResponse{
+		Loan:        convertLoan(result.Loan, numbers),
+		Instalments: convertLoanInstalments(result.Instalments),
+	}
+	return rsp, nil
+}
+
+func validateCreateLoanRequest(req *pb.CreateLoanRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if err := val.ValidateAmount(req.GetPrincipal()); err != nil {
+		violations = append(violations, fieldViolation("principal", err))
+	}
+
+	if err := val.ValidateRateBps(req.GetAnnualRateBps()); err != nil {
+		violations = append(violations, fieldViolation("annual_rate_bps", err))
+	}
+
+	if err := val.ValidateLoanTerm(req.GetTermMonths()); err != nil {
+		violations = append(violations, fieldViolation("term_months", err))
+	}
+
+	return violations
+}
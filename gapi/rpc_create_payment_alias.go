@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/lib/pq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePaymentAlias registers a phone number or handle others can pay the user by.
+// A handle resolves right away. A phone number is sent a code and only resolves
+// once the user verifies it with VerifyPaymentAlias; asking again sends a new code.
+func (server *Server) CreatePaymentAlias(ctx context.Context, req *pb.CreatePaymentAliasRequest) (*pb.CreatePaymentAliasResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreatePaymentAliasRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreatePaymentAliasParams{
+		AliasType:     req.GetAliasType(),
+		Value:         util.NormalizeAlias(req.GetAliasType(), req.GetAlias()),
+		Username:      authPayload.Username,
+		CodeExpiredAt: time.Now(),
+	}
+
+	needsVerification := util.AliasNeedsVerification(arg.AliasType)
+	if needsVerification {
+		// unverified claims do not block each other, so check for an owner first
+		_, err = server.store.GetPaymentAlias(ctx, db.GetPaymentAliasParams{
+			AliasType: arg.AliasType,
+			Value:     arg.Value,
+		})
+		if err == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "the %s is already registered", arg.AliasType)
+		}
+		if err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "Failed to get payment alias: %v", err)
+		}
+
+		arg.SecretCode = util.NewAliasVerificationCode()
+		arg.CodeExpiredAt = time.Now().Add(util.AliasVerificationExpiry)
+	} else {
+		arg.VerifiedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	alias, err := server.store.CreatePaymentAlias(ctx, arg)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return nil, status.Errorf(codes.AlreadyExists, "the %s is already registered", arg.AliasType)
+		}
+		if err == sql.ErrNoRows {
+			// the user verified it before
+			return nil, status.Errorf(codes.AlreadyExists, "the %s is already registered", arg.AliasType)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create payment alias: %v", err)
+	}
+
+	if needsVerification {
+		opts := []asynq.Option{
+			asynq.MaxRetry(5),
+			asynq.Queue(worker.QueueCritical),
+		}
+		err = server.taskDistributor.DistributeTaskSendAliasVerification(ctx, &worker.PayloadSendAliasVerification{
+			AliasType: alias.AliasType,
+			Alias:     alias.Value,
+			Username:  alias.Username,
+		}, opts...)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to send verification code: %v", err)
+		}
+	}
+
+	rsp := &pb.CreatePaymentAliasResponse{
+		Alias: convertPaymentAlias(alias),
+	}
+	return rsp, nil
+}
+
+func validateCreatePaymentAliasRequest(req *pb.CreatePaymentAliasRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !util.IsRegistrableAliasType(req.GetAliasType()) {
+		err := fmt.Errorf("must be %s or %s", util.AliasPhone, util.AliasHandle)
+		return append(violations, fieldViolation("alias_type", err))
+	}
+
+	return validateAlias("alias_type", "alias", req.GetAliasType(), req.GetAlias())
+}
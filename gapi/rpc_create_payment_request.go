@@ -0,0 +1,122 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPaymentRequestExpiry = 7 * 24 * time.Hour
+	maxPaymentRequestExpiry     = 30 * 24 * time.Hour
+)
+
+func (server *Server) CreatePaymentRequest(ctx context.Context, req *pb.CreatePaymentRequestRequest) (*pb.CreatePaymentRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreatePaymentRequestRequest(req, authPayload.Username)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payeeAccountID, err := server.resolveAccountID(ctx, req.GetPayeeAccountId(), req.GetPayeeAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, payeeAccountID, authz.MoveMoney)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(account, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	if _, err := server.store.GetUser(ctx, req.GetPayer()); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "payer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get payer: %v", err)
+	}
+
+	expiresAt := time.Now().Add(defaultPaymentRequestExpiry)
+	if req.ExpiresAt != nil {
+		expiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	arg := db.CreatePaymentRequestTxParams{
+		CreatePaymentRequestParams: db.CreatePaymentRequestParams{
+			ID:             uuid.New(),
+			Requester:      authPayload.Username,
+			PayeeAccountID: account.ID,
+			Payer:          req.GetPayer(),
+			Amount:         req.GetAmount(),
+			Currency:       account.Currency,
+			Message:        req.GetMessage(),
+			ExpiresAt:      expiresAt,
+		},
+	}
+
+	txResult, err := server.store.CreatePaymentRequestTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create payment request: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, txResult.PaymentRequest.PayeeAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CreatePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(txResult.PaymentRequest, numbers),
+	}
+	return rsp, nil
+}
+
+func validateCreatePaymentRequestRequest(req *pb.CreatePaymentRequestRequest, requester string) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("payee_account_id", "payee_account_number", req.GetPayeeAccountId(), req.GetPayeeAccountNumber())...)
+
+	if err := val.ValidateUsername(req.GetPayer()); err != nil {
+		violations = append(violations, fieldViolation("payer", err))
+	} else if req.GetPayer() == requester {
+		violations = append(violations, fieldViolation("payer", fmt.Errorf("cannot request money from yourself")))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateDescription(req.GetMessage()); err != nil {
+		violations = append(violations, fieldViolation("message", err))
+	}
+
+	if req.ExpiresAt != nil {
+		if err := req.GetExpiresAt().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("expires_at", err))
+		} else {
+			expiry := time.Until(req.GetExpiresAt().AsTime())
+			if expiry <= 0 || expiry > maxPaymentRequestExpiry {
+				violations = append(violations, fieldViolation("expires_at", fmt.Errorf("must be in the next 30 days")))
+			}
+		}
+	}
+
+	return violations
+}
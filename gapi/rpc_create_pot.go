@@ -0,0 +1,94 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const potDateLayout = "2006-01-02"
+
+func (server *Server) CreatePot(ctx context.Context, req *pb.CreatePotRequest) (*pb.CreatePotResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	targetDate, violations := validateCreatePotRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.MoveMoney)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.CreatePotTx(ctx, db.CreatePotTxParams{
+		ParentAccountID: account.ID,
+		Name:            req.GetName(),
+		TargetAmount:    req.GetTargetAmount(),
+		TargetDate:      targetDate,
+		AccountNumber:   server.newAccountNumber(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPotParent) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return nil, status.Errorf(codes.AlreadyExists, "account [%d] already has a pot named %q", account.ID, req.GetName())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create pot: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, result.Pot.AccountID, result.Pot.ParentAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CreatePotResponse{
+		Pot: convertPot(result.Pot, result.Account.Currency, result.Account.Balance, numbers),
+	}
+	return rsp, nil
+}
+
+func validateCreatePotRequest(req *pb.CreatePotRequest) (targetDate sql.NullTime, violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if err := val.ValidateString(req.GetName(), 1, 50); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	if req.GetTargetAmount() < 0 {
+		violations = append(violations, fieldViolation("target_amount", fmt.Errorf("must not be negative")))
+	}
+
+	if req.TargetDate != nil {
+		date, err := time.Parse(potDateLayout, req.GetTargetDate())
+		if err != nil {
+			violations = append(violations, fieldViolation("target_date", fmt.Errorf("must be a date in %s format", potDateLayout)))
+		} else if !date.After(time.Now()) {
+			violations = append(violations, fieldViolation("target_date", fmt.Errorf("must be in the future")))
+		} else {
+			targetDate = sql.NullTime{Time: date, Valid: true}
+		}
+	}
+
+	return targetDate, violations
+}
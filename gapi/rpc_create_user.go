@@ -2,15 +2,12 @@ package gapi
 
 import (
 	"context"
-	"time"
 
-	"github.com/hibiken/asynq"
 	"github.com/lib/pq"
 	db "github.com/nhat195/simple_bank/db/sqlc"
 	"github.com/nhat195/simple_bank/pb"
 	"github.com/nhat195/simple_bank/util"
 	"github.com/nhat195/simple_bank/val"
-	"github.com/nhat195/simple_bank/worker"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -35,17 +32,6 @@ func (sever *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
 		},
-		AfterCreate: func(user db.User) error {
-			opts := []asynq.Option{
-				asynq.MaxRetry(10),
-				asynq.ProcessIn(10 * time.Second),
-				asynq.Queue(worker.QueueCritical),
-			}
-			return sever.taskDistributor.DistributeTaskSendVerifyEmail(ctx, &worker.PayloadVerifySendEmail{
-				Username: user.Username,
-			}, opts...)
-
-		},
 	}
 
 	txResult, err := sever.store.CreateUserTx(ctx, arg)
@@ -59,7 +45,6 @@ func (sever *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		}
 		return nil, status.Errorf(codes.Internal, "Failed to create user: %v", err)
 	}
-	// Send email verification task
 
 	rsp := &pb.CreateUserResponse{
 		User: convertUser(txResult.User),
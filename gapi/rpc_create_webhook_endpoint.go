@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateWebhookEndpoint registers a URL that events about the accounts of the
+// user are POSTed to. The secret deliveries are signed with is generated here
+// and only returned in the response.
+func (server *Server) CreateWebhookEndpoint(ctx context.Context, req *pb.CreateWebhookEndpointRequest) (*pb.CreateWebhookEndpointResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateWebhookEndpointRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	endpoint, err := server.store.CreateWebhookEndpoint(ctx, db.CreateWebhookEndpointParams{
+		Username:   authPayload.Username,
+		Url:        req.GetUrl(),
+		Secret:     util.NewWebhookSecret(),
+		EventTypes: req.GetEventTypes(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create webhook endpoint: %v", err)
+	}
+
+	rsp := &pb.CreateWebhookEndpointResponse{
+		Endpoint: convertWebhookEndpoint(endpoint),
+		Secret:   endpoint.Secret,
+	}
+	return rsp, nil
+}
+
+func validateCreateWebhookEndpointRequest(req *pb.CreateWebhookEndpointRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateWebhookURL(req.GetUrl()); err != nil {
+		violations = append(violations, fieldViolation("url", err))
+	}
+
+	if len(req.GetEventTypes()) == 0 {
+		violations = append(violations, fieldViolation("event_types", fmt.Errorf("must not be empty")))
+	}
+	for i, eventType := range req.GetEventTypes() {
+		if !isWebhookEventType(eventType) {
+			err := fmt.Errorf("must be one of %s", strings.Join(db.WebhookEventTypes, ", "))
+			violations = append(violations, fieldViolation(fmt.Sprintf("event_types[%d]", i), err))
+		}
+	}
+
+	return violations
+}
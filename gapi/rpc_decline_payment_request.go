@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) DeclinePaymentRequest(ctx context.Context, req *pb.DeclinePaymentRequestRequest) (*pb.DeclinePaymentRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeclinePaymentRequestRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	request, err := server.getOpenPaymentRequest(ctx, authPayload.Username, uuid.MustParse(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err = server.store.RespondPaymentRequest(ctx, db.RespondPaymentRequestParams{
+		ID:     request.ID,
+		Status: db.PaymentRequestDeclined,
+	})
+	if err != nil {
+		return nil, paymentRequestError(err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, request.PayeeAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.DeclinePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request, numbers),
+	}
+	return rsp, nil
+}
+
+func validateDeclinePaymentRequestRequest(req *pb.DeclinePaymentRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
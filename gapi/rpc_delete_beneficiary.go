@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteBeneficiary(ctx context.Context, req *pb.DeleteBeneficiaryRequest) (*pb.DeleteBeneficiaryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeleteBeneficiaryRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	beneficiary, err := server.getBeneficiary(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.DeleteBeneficiary(ctx, beneficiary.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete beneficiary: %v", err)
+	}
+
+	return &pb.DeleteBeneficiaryResponse{}, nil
+}
+
+func validateDeleteBeneficiaryRequest(req *pb.DeleteBeneficiaryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeletePaymentAlias stops a phone number or handle of the user from resolving.
+func (server *Server) DeletePaymentAlias(ctx context.Context, req *pb.DeletePaymentAliasRequest) (*pb.DeletePaymentAliasResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeletePaymentAliasRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.DeletePaymentAlias(ctx, db.DeletePaymentAliasParams{
+		AliasType: req.GetAliasType(),
+		Value:     util.NormalizeAlias(req.GetAliasType(), req.GetAlias()),
+		Username:  authPayload.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete payment alias: %v", err)
+	}
+	if rows == 0 {
+		return nil, status.Errorf(codes.NotFound, "payment alias not found")
+	}
+
+	return &pb.DeletePaymentAliasResponse{}, nil
+}
+
+func validateDeletePaymentAliasRequest(req *pb.DeletePaymentAliasRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !util.IsRegistrableAliasType(req.GetAliasType()) {
+		err := fmt.Errorf("must be %s or %s", util.AliasPhone, util.AliasHandle)
+		return append(violations, fieldViolation("alias_type", err))
+	}
+
+	return validateAlias("alias_type", "alias", req.GetAliasType(), req.GetAlias())
+}
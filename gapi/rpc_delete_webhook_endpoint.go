@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteWebhookEndpoint stops deliveries to an endpoint. The endpoint is only
+// deactivated, so its delivery log stays available.
+func (server *Server) DeleteWebhookEndpoint(ctx context.Context, req *pb.DeleteWebhookEndpointRequest) (*pb.DeleteWebhookEndpointResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeleteWebhookEndpointRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	endpoint, err := server.getWebhookEndpoint(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.DeactivateWebhookEndpoint(ctx, endpoint.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete webhook endpoint: %v", err)
+	}
+
+	return &pb.DeleteWebhookEndpointResponse{}, nil
+}
+
+func validateDeleteWebhookEndpointRequest(req *pb.DeleteWebhookEndpointRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// FreezeCard blocks payments with a card until it is unfrozen.
+func (server *Server) FreezeCard(ctx context.Context, req *pb.FreezeCardRequest) (*pb.FreezeCardResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateFreezeCardRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	card, err := server.setCardStatus(ctx, authPayload.Username, req.GetId(), db.CardFrozen)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := server.accountNumbers(ctx, card.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.FreezeCardResponse{
+		Card: convertCard(card, numbers),
+	}
+	return rsp, nil
+}
+
+func validateFreezeCardRequest(req *pb.FreezeCardRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,98 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxStatementPeriod bounds how much history a single statement may cover.
+const maxStatementPeriod = 366 * 24 * time.Hour
+
+func (server *Server) GenerateStatement(ctx context.Context, req *pb.GenerateStatementRequest) (*pb.GenerateStatementResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGenerateStatementRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateStatementTxParams{
+		CreateStatementParams: db.CreateStatementParams{
+			ID:          uuid.New(),
+			AccountID:   account.ID,
+			Username:    authPayload.Username,
+			Format:      req.GetFormat(),
+			PeriodStart: req.GetPeriodStart().AsTime(),
+			PeriodEnd:   req.GetPeriodEnd().AsTime(),
+		},
+	}
+
+	txResult, err := server.store.CreateStatementTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create statement: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, txResult.Statement.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GenerateStatementResponse{
+		Statement: convertStatement(txResult.Statement, numbers),
+	}
+	return rsp, nil
+}
+
+func validateGenerateStatementRequest(req *pb.GenerateStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if err := val.ValidateStatementFormat(req.GetFormat()); err != nil {
+		violations = append(violations, fieldViolation("format", err))
+	}
+
+	if err := req.GetPeriodStart().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("period_start", err))
+		return violations
+	}
+
+	if err := req.GetPeriodEnd().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("period_end", err))
+		return violations
+	}
+
+	start := req.GetPeriodStart().AsTime()
+	end := req.GetPeriodEnd().AsTime()
+	switch {
+	case !end.After(start):
+		violations = append(violations, fieldViolation("period_end", fmt.Errorf("must be after period_start")))
+	case end.Sub(start) > maxStatementPeriod:
+		violations = append(violations, fieldViolation("period_end", fmt.Errorf("must be at most 366 days after period_start")))
+	case end.After(time.Now()):
+		violations = append(violations, fieldViolation("period_end", fmt.Errorf("must not be in the future")))
+	}
+
+	return violations
+}
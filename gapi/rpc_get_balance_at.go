@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) GetBalanceAt(ctx context.Context, req *pb.GetBalanceAtRequest) (*pb.GetBalanceAtResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetBalanceAtRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.Now()
+	if req.At != nil {
+		at = req.GetAt().AsTime()
+	}
+
+	balance, err := server.store.GetBalanceAt(ctx, account.ID, at)
+	if err != nil {
+		if errors.Is(err, db.ErrAccountNotOpen) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get balance: %v", err)
+	}
+
+	rsp := &pb.GetBalanceAtResponse{
+		AccountNumber: account.AccountNumber,
+		Balance:       balance,
+		Currency:      account.Currency,
+		At:            timestamppb.New(at),
+	}
+	return rsp, nil
+}
+
+func validateGetBalanceAtRequest(req *pb.GetBalanceAtRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if req.At != nil {
+		if err := req.GetAt().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("at", err))
+		}
+	}
+
+	return violations
+}
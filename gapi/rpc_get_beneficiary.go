@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) GetBeneficiary(ctx context.Context, req *pb.GetBeneficiaryRequest) (*pb.GetBeneficiaryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetBeneficiaryRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	beneficiary, err := server.getBeneficiary(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := server.accountNumbers(ctx, beneficiary.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetBeneficiaryResponse{
+		Beneficiary: convertBeneficiary(beneficiary, numbers),
+	}
+	return rsp, nil
+}
+
+func validateGetBeneficiaryRequest(req *pb.GetBeneficiaryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
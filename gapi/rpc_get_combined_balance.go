@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/authz"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetCombinedBalance(ctx context.Context, req *pb.GetCombinedBalanceRequest) (*pb.GetCombinedBalanceResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetCombinedBalanceRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := server.store.GetCombinedBalance(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get combined balance: %v", err)
+	}
+
+	accountIDs := []int64{balance.Account.ID}
+	for _, pot := range balance.Pots {
+		accountIDs = append(accountIDs, pot.Pot.AccountID)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetCombinedBalanceResponse{
+		AccountNumber: balance.Account.AccountNumber,
+		Currency:      balance.Account.Currency,
+		Balance:       balance.Account.Balance,
+		PotsBalance:   balance.PotsBalance,
+		TotalBalance:  balance.TotalBalance,
+		Pots:          make([]*pb.Pot, len(balance.Pots)),
+	}
+	for i, pot := range balance.Pots {
+		rsp.Pots[i] = convertPot(pot.Pot, balance.Account.Currency, pot.Balance, numbers)
+	}
+	return rsp, nil
+}
+
+func validateGetCombinedBalanceRequest(req *pb.GetCombinedBalanceRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	return violations
+}
@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLoan returns a loan with its repayment schedule and arrears to the members
+// of the account it was paid into.
+func (server *Server) GetLoan(ctx context.Context, req *pb.GetLoanRequest) (*pb.GetLoanResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetLoanRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	loan, err := server.store.GetLoan(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "loan [%d] not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get loan: %v", err)
+	}
+
+	if _, err := server.getAuthorizedAccount(ctx, authPayload.Username, loan.AccountID, authz.ViewAccount); err != nil {
+		return nil, err
+	}
+
+	instalments, err := server.store.ListLoanInstalments(ctx, loan.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list loan instalments: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, loan.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetLoanResponse{
+		Loan:                 convertLoan(loan, numbers),
+		Instalments:          convertLoanInstalments(instalments),
+		Arrears:              convertLoanArrears(db.GetLoanArrears(instalments, time.Now().UTC().Truncate(24*time.Hour))),
+		OutstandingPrincipal: db.OutstandingPrincipal(instalments),
+	}
+	return rsp, nil
+}
+
+func validateGetLoanRequest(req *pb.GetLoanRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
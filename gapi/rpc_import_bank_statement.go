@@ -0,0 +1,133 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/nhat195/simple_bank/bankcsv"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBankStatementSize bounds the CSV files finance can import in one request.
+const maxBankStatementSize = 5 << 20
+
+func (server *Server) ImportBankStatement(ctx context.Context, req *pb.ImportBankStatementRequest) (*pb.ImportBankStatementResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, violations := validateImportBankStatementRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := bankcsv.Parse(bytes.NewReader(req.GetContent()), layout)
+	if err != nil {
+		var parseErr *bankcsv.ParseError
+		if errors.As(err, &parseErr) {
+			for _, lineErr := range parseErr.Lines {
+				violations = append(violations, fieldViolation(fmt.Sprintf("content[line %d]", lineErr.Line), lineErr.Err))
+			}
+			return nil, invalidArgumentError(violations)
+		}
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("content", err)})
+	}
+
+	arg := db.ImportExternalTxParams{
+		AccountID:  account.ID,
+		Source:     req.GetSource(),
+		ImportedBy: authPayload.Username,
+		Lines:      make([]db.ExternalTransactionLine, len(transactions)),
+	}
+	for i, transaction := range transactions {
+		arg.Lines[i] = db.ExternalTransactionLine{
+			LineNumber:  int32(transaction.LineNumber),
+			BookedOn:    transaction.BookedOn,
+			Amount:      transaction.Amount,
+			Reference:   transaction.Reference,
+			Description: transaction.Description,
+		}
+	}
+
+	result, err := server.store.ImportExternalTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to import bank statement: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, len(result.Transactions))
+	for _, transaction := range result.Transactions {
+		accountIDs = append(accountIDs, transaction.AccountID)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ImportBankStatementResponse{
+		ImportId:     result.Import.ID.String(),
+		RowCount:     result.Import.RowCount,
+		MatchedCount: int32(result.Matched),
+	}
+	for _, transaction := range result.Transactions {
+		if transaction.Status == db.ExternalUnmatched {
+			rsp.Unmatched = append(rsp.Unmatched, convertExternalTransaction(transaction, numbers))
+		}
+	}
+	return rsp, nil
+}
+
+func validateImportBankStatementRequest(req *pb.ImportBankStatementRequest) (layout bankcsv.Layout, violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if err := val.ValidateString(req.GetSource(), 1, 100); err != nil {
+		violations = append(violations, fieldViolation("source", err))
+	}
+
+	if len(req.GetContent()) == 0 || len(req.GetContent()) > maxBankStatementSize {
+		violations = append(violations, fieldViolation("content", fmt.Errorf("must contain from 1-%d bytes", maxBankStatementSize)))
+	}
+
+	l := req.GetLayout()
+	delimiter, size := utf8.DecodeRuneInString(l.GetDelimiter())
+	if size == 0 || size != len(l.GetDelimiter()) {
+		violations = append(violations, fieldViolation("layout.delimiter", fmt.Errorf("must be a single character")))
+		return layout, violations
+	}
+
+	layout = bankcsv.Layout{
+		Delimiter:         delimiter,
+		SkipRows:          int(l.GetSkipRows()),
+		DateColumn:        int(l.GetDateColumn()),
+		DateFormat:        l.GetDateFormat(),
+		AmountColumn:      int(l.GetAmountColumn()),
+		CreditColumn:      int(l.GetCreditColumn()),
+		DebitColumn:       int(l.GetDebitColumn()),
+		ReferenceColumn:   int(l.GetReferenceColumn()),
+		DescriptionColumn: int(l.GetDescriptionColumn()),
+		DecimalComma:      l.GetDecimalComma(),
+	}
+	if err := layout.Validate(); err != nil {
+		violations = append(violations, fieldViolation("layout", err))
+	}
+
+	return layout, violations
+}
@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) InviteAccountMember(ctx context.Context, req *pb.InviteAccountMemberRequest) (*pb.InviteAccountMemberResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateInviteAccountMemberRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := server.store.GetUser(ctx, req.GetUsername()); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get user: %v", err)
+	}
+
+	member, err := server.store.CreateAccountMember(ctx, db.CreateAccountMemberParams{
+		AccountID: account.ID,
+		Username:  req.GetUsername(),
+		Role:      req.GetRole(),
+		AddedBy:   sql.NullString{String: authPayload.Username, Valid: true},
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return nil, status.Errorf(codes.AlreadyExists, "user is already a member of account [%d]", account.ID)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to add account member: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, member.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.InviteAccountMemberResponse{
+		Member: convertAccountMember(member, numbers),
+	}
+	return rsp, nil
+}
+
+func validateInviteAccountMemberRequest(req *pb.InviteAccountMemberRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if err := val.ValidateMemberRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	} else if req.GetRole() == util.MemberOwner {
+		violations = append(violations, fieldViolation("role", fmt.Errorf("an account has a single owner")))
+	}
+
+	return violations
+}
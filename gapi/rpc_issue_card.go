@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IssueCard issues a virtual debit card on an account to the user. Only a token
+// of the card number and a hash of the CVV are stored, so the response is the
+// only place both are ever shown.
+func (server *Server) IssueCard(ctx context.Context, req *pb.IssueCardRequest) (*pb.IssueCardResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateIssueCardRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.MoveMoney)
+	if err != nil {
+		return nil, err
+	}
+
+	if !util.IsCustomerProduct(account.ProductCode) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cards cannot be issued on %s accounts", account.ProductCode)
+	}
+
+	number := util.NewCardNumber(server.config.CardBIN)
+	cvv := util.NewCVV()
+
+	hashedCvv, err := util.HashPassword(cvv)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to hash cvv: %v", err)
+	}
+
+	month, year := util.CardExpiry(time.Now())
+	card, err := server.store.CreateCard(ctx, db.CreateCardParams{
+		AccountID:    account.ID,
+		Cardholder:   authPayload.Username,
+		Token:        util.CardToken(server.config.CardTokenKey, number),
+		MaskedNumber: util.MaskCardNumber(number),
+		ExpiryMonth:  month,
+		ExpiryYear:   year,
+		HashedCvv:    hashedCvv,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create card: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, card.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.IssueCardResponse{
+		Card:       convertCard(card, numbers),
+		CardNumber: number,
+		Cvv:        cvv,
+	}
+	return rsp, nil
+}
+
+func validateIssueCardRequest(req *pb.IssueCardRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	return validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListBeneficiaries lists the address book of the user by nickname.
+func (server *Server) ListBeneficiaries(ctx context.Context, req *pb.ListBeneficiariesRequest) (*pb.ListBeneficiariesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListBeneficiariesRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	beneficiaries, err := server.store.ListBeneficiaries(ctx, db.ListBeneficiariesParams{
+		Username: authPayload.Username,
+		Limit:    req.GetPageSize(),
+		Offset:   (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list beneficiaries: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, len(beneficiaries))
+	for _, beneficiary := range beneficiaries {
+		accountIDs = append(accountIDs, beneficiary.AccountID)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListBeneficiariesResponse{
+		Beneficiaries: make([]*pb.Beneficiary, len(beneficiaries)),
+	}
+	for i, beneficiary := range beneficiaries {
+		rsp.Beneficiaries[i] = convertBeneficiary(beneficiary, numbers)
+	}
+	return rsp, nil
+}
+
+func validateListBeneficiariesRequest(req *pb.ListBeneficiariesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
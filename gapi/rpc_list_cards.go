@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListCards(ctx context.Context, req *pb.ListCardsRequest) (*pb.ListCardsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListCardsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := server.store.ListCards(ctx, db.ListCardsParams{
+		AccountID: account.ID,
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list cards: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, len(cards))
+	for _, card := range cards {
+		accountIDs = append(accountIDs, card.AccountID)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListCardsResponse{
+		Cards: make([]*pb.Card, len(cards)),
+	}
+	for i, card := range cards {
+		rsp.Cards[i] = convertCard(card, numbers)
+	}
+	return rsp, nil
+}
+
+func validateListCardsRequest(req *pb.ListCardsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
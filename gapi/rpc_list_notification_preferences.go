@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListNotificationPreferences returns the preference of the user for every
+// alert. Alerts the user never set up are listed turned off.
+func (server *Server) ListNotificationPreferences(ctx context.Context, req *pb.ListNotificationPreferencesRequest) (*pb.ListNotificationPreferencesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	preferences, err := server.store.ListNotificationPreferences(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list notification preferences: %v", err)
+	}
+
+	saved := make(map[string]db.NotificationPreference, len(preferences))
+	for _, preference := range preferences {
+		saved[preference.EventType] = preference
+	}
+
+	rsp := &pb.ListNotificationPreferencesResponse{
+		Preferences: make([]*pb.NotificationPreference, len(db.AlertTypes)),
+	}
+	for i, alertType := range db.AlertTypes {
+		preference, ok := saved[alertType]
+		if !ok {
+			preference = db.NotificationPreference{
+				Username:  authPayload.Username,
+				EventType: alertType,
+				Channels:  []string{},
+			}
+		}
+		rsp.Preferences[i] = convertNotificationPreference(preference)
+	}
+	return rsp, nil
+}
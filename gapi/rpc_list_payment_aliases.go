@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPaymentAliases lists the phone numbers and handles the user registered.
+func (server *Server) ListPaymentAliases(ctx context.Context, req *pb.ListPaymentAliasesRequest) (*pb.ListPaymentAliasesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	aliases, err := server.store.ListPaymentAliases(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list payment aliases: %v", err)
+	}
+
+	rsp := &pb.ListPaymentAliasesResponse{
+		Aliases: make([]*pb.PaymentAlias, len(aliases)),
+	}
+	for i, alias := range aliases {
+		rsp.Aliases[i] = convertPaymentAlias(alias)
+	}
+	return rsp, nil
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPaymentRequests lists the requests the user sent and received, newest first.
+func (server *Server) ListPaymentRequests(ctx context.Context, req *pb.ListPaymentRequestsRequest) (*pb.ListPaymentRequestsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListPaymentRequestsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	requests, err := server.store.ListPaymentRequests(ctx, db.ListPaymentRequestsParams{
+		Username:  authPayload.Username,
+		RowLimit:  req.GetPageSize(),
+		RowOffset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list payment requests: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, len(requests))
+	for _, request := range requests {
+		accountIDs = append(accountIDs, request.PayeeAccountID)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListPaymentRequestsResponse{
+		PaymentRequests: make([]*pb.PaymentRequest, len(requests)),
+	}
+	for i, request := range requests {
+		rsp.PaymentRequests[i] = convertPaymentRequest(request, numbers)
+	}
+	return rsp, nil
+}
+
+func validateListPaymentRequestsRequest(req *pb.ListPaymentRequestsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
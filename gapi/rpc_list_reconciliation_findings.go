@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListReconciliationFindings(ctx context.Context, req *pb.ListReconciliationFindingsRequest) (*pb.ListReconciliationFindingsResponse, error) {
+	if _, err := server.authorizeBanker(ctx); err != nil {
+		return nil, err
+	}
+
+	violations := validateListReconciliationFindingsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListReconciliationFindingsParams{
+		RowLimit:  req.GetPageSize(),
+		RowOffset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+	if req.RunId != nil {
+		arg.RunID = uuid.NullUUID{UUID: uuid.MustParse(req.GetRunId()), Valid: true}
+	}
+
+	findings, err := server.store.ListReconciliationFindings(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list findings: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, len(findings))
+	for _, finding := range findings {
+		accountIDs = append(accountIDs, finding.AccountID.Int64)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListReconciliationFindingsResponse{
+		Findings: make([]*pb.ReconciliationFinding, len(findings)),
+	}
+	for i, finding := range findings {
+		rsp.Findings[i] = convertReconciliationFinding(finding, numbers)
+	}
+	return rsp, nil
+}
+
+func validateListReconciliationFindingsRequest(req *pb.ListReconciliationFindingsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if req.RunId != nil {
+		if _, err := uuid.Parse(req.GetRunId()); err != nil {
+			violations = append(violations, fieldViolation("run_id", err))
+		}
+	}
+
+	return violations
+}
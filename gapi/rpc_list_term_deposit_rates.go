@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTermDepositRates returns the terms deposits can currently be opened for.
+func (server *Server) ListTermDepositRates(ctx context.Context, req *pb.ListTermDepositRatesRequest) (*pb.ListTermDepositRatesResponse, error) {
+	if _, err := server.authorizeUser(ctx); err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	rates, err := server.store.ListTermDepositRates(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list term deposit rates: %v", err)
+	}
+
+	rsp := &pb.ListTermDepositRatesResponse{
+		Rates: make([]*pb.TermDepositRate, len(rates)),
+	}
+	for i, rate := range rates {
+		rsp.Rates[i] = &pb.TermDepositRate{
+			TermMonths:           rate.TermMonths,
+			AnnualRateBps:        rate.AnnualRateBps,
+			EarlyBreakPenaltyBps: rate.EarlyBreakPenaltyBps,
+		}
+	}
+	return rsp, nil
+}
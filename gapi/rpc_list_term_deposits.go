@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/authz"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTermDeposits returns the term deposits funded from an account, including
+// the ones that have been paid out or broken.
+func (server *Server) ListTermDeposits(ctx context.Context, req *pb.ListTermDepositsRequest) (*pb.ListTermDepositsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTermDepositsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits, err := server.store.ListTermDeposits(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list term deposits: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, 2*len(deposits))
+	for _, deposit := range deposits {
+		accountIDs = append(accountIDs, deposit.TermDeposit.AccountID, deposit.TermDeposit.SourceAccountID)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListTermDepositsResponse{
+		TermDeposits: make([]*pb.TermDeposit, len(deposits)),
+	}
+	for i, deposit := range deposits {
+		rsp.TermDeposits[i] = convertTermDeposit(deposit.TermDeposit, deposit.Currency, deposit.Balance, numbers)
+	}
+	return rsp, nil
+}
+
+func validateListTermDepositsRequest(req *pb.ListTermDepositsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	return validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())
+}
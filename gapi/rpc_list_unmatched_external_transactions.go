@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListUnmatchedExternalTransactions(ctx context.Context, req *pb.ListUnmatchedExternalTransactionsRequest) (*pb.ListUnmatchedExternalTransactionsResponse, error) {
+	if _, err := server.authorizeBanker(ctx); err != nil {
+		return nil, err
+	}
+
+	violations := validateListUnmatchedExternalTransactionsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ListUnmatchedExternalTransactionsParams{
+		RowLimit:  req.GetPageSize(),
+		RowOffset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+	if accountID != 0 {
+		arg.AccountID = sql.NullInt64{Int64: accountID, Valid: true}
+	}
+	if req.ImportId != nil {
+		arg.ImportID = uuid.NullUUID{UUID: uuid.MustParse(req.GetImportId()), Valid: true}
+	}
+
+	transactions, err := server.store.ListUnmatchedExternalTransactions(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list external transactions: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, len(transactions))
+	for _, transaction := range transactions {
+		accountIDs = append(accountIDs, transaction.AccountID)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListUnmatchedExternalTransactionsResponse{
+		Transactions: make([]*pb.ExternalTransaction, len(transactions)),
+	}
+	for i, transaction := range transactions {
+		rsp.Transactions[i] = convertExternalTransaction(transaction, numbers)
+	}
+	return rsp, nil
+}
+
+func validateListUnmatchedExternalTransactionsRequest(req *pb.ListUnmatchedExternalTransactionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if req.AccountId != nil || req.AccountNumber != nil {
+		violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+	}
+
+	if req.ImportId != nil {
+		if _, err := uuid.Parse(req.GetImportId()); err != nil {
+			violations = append(violations, fieldViolation("import_id", err))
+		}
+	}
+
+	return violations
+}
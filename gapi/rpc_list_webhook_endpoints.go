@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListWebhookEndpoints(ctx context.Context, req *pb.ListWebhookEndpointsRequest) (*pb.ListWebhookEndpointsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	endpoints, err := server.store.ListWebhookEndpoints(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list webhook endpoints: %v", err)
+	}
+
+	rsp := &pb.ListWebhookEndpointsResponse{
+		Endpoints: make([]*pb.WebhookEndpoint, len(endpoints)),
+	}
+	for i, endpoint := range endpoints {
+		rsp.Endpoints[i] = convertWebhookEndpoint(endpoint)
+	}
+	return rsp, nil
+}
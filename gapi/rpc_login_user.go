@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/hibiken/asynq"
 	db "github.com/nhat195/simple_bank/db/sqlc"
 	"github.com/nhat195/simple_bank/pb"
 	"github.com/nhat195/simple_bank/util"
 	"github.com/nhat195/simple_bank/val"
+	"github.com/nhat195/simple_bank/worker"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -57,6 +60,14 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		return nil, status.Errorf(codes.Internal, "Failed to create session: %v", err)
 	}
 
+	// a lost alert is not worth failing the login over
+	err = server.taskDistributor.DistributeTaskEvaluateLoginAlert(ctx, &worker.PayloadEvaluateLoginAlert{
+		SessionID: session.ID,
+	}, asynq.Queue(worker.QueueDefault))
+	if err != nil {
+		log.Error().Err(err).Str("username", user.Username).Msg("cannot queue login alert")
+	}
+
 	rsp := &pb.LoginUserResponse{
 		User:                  convertUser(user),
 		SessionId:             session.ID.String(),
@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DepositToPot(ctx context.Context, req *pb.MovePotMoneyRequest) (*pb.MovePotMoneyResponse, error) {
+	return server.movePotMoney(ctx, req, false)
+}
+
+func (server *Server) WithdrawFromPot(ctx context.Context, req *pb.MovePotMoneyRequest) (*pb.MovePotMoneyResponse, error) {
+	return server.movePotMoney(ctx, req, true)
+}
+
+// movePotMoney moves money into a pot or back out of it, for members who may
+// move money on the parent account of the pot.
+func (server *Server) movePotMoney(ctx context.Context, req *pb.MovePotMoneyRequest, withdraw bool) (*pb.MovePotMoneyResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateMovePotMoneyRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	potID, err := server.resolveAccountID(ctx, req.GetPotId(), req.GetPotAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	pot, err := server.getPot(ctx, potID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := server.getAuthorizedAccount(ctx, authPayload.Username, pot.ParentAccountID, authz.MoveMoney); err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.MovePotMoneyTx(ctx, db.MovePotMoneyTxParams{
+		PotAccountID: pot.AccountID,
+		Amount:       req.GetAmount(),
+		Withdraw:     withdraw,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to move pot money: %v", err)
+	}
+
+	rsp := &pb.MovePotMoneyResponse{
+		Pot:            convertPot(result.Pot, result.PotAccount.Currency, result.PotAccount.Balance, accountNumbersOf(result.PotAccount, result.ParentAccount)),
+		TransferId:     result.Transfer.ID,
+		AccountBalance: result.ParentAccount.Balance,
+	}
+	return rsp, nil
+}
+
+func validateMovePotMoneyRequest(req *pb.MovePotMoneyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("pot_id", "pot_account_number", req.GetPotId(), req.GetPotAccountNumber())...)
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	return violations
+}
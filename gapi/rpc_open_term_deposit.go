@@ -0,0 +1,96 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const termDepositDateLayout = "2006-01-02"
+
+// OpenTermDeposit locks money from a current or savings account away for one
+// of the offered terms, starting today.
+func (server *Server) OpenTermDeposit(ctx context.Context, req *pb.OpenTermDepositRequest) (*pb.OpenTermDepositResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateOpenTermDepositRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetSourceAccountId(), req.GetSourceAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.MoveMoney)
+	if err != nil {
+		return nil, err
+	}
+
+	onMaturity := req.GetOnMaturity()
+	if onMaturity == "" {
+		onMaturity = util.MaturityPayout
+	}
+
+	result, err := server.store.OpenTermDepositTx(ctx, db.OpenTermDepositTxParams{
+		SourceAccountID: account.ID,
+		Amount:          req.GetAmount(),
+		TermMonths:      req.GetTermMonths(),
+		OnMaturity:      onMaturity,
+		StartsOn:        time.Now().UTC().Truncate(24 * time.Hour),
+		AccountNumber:   server.newAccountNumber(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrUnsupportedTerm) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, db.ErrInvalidDepositSource) || errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to open term deposit: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, result.TermDeposit.AccountID, result.TermDeposit.SourceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.OpenTermDepositResponse{
+		TermDeposit:   convertTermDeposit(result.TermDeposit, result.Account.Currency, result.Account.Balance, numbers),
+		AccountNumber: result.Account.AccountNumber,
+	}
+	return rsp, nil
+}
+
+func validateOpenTermDepositRequest(req *pb.OpenTermDepositRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("source_account_id", "source_account_number", req.GetSourceAccountId(), req.GetSourceAccountNumber())...)
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateLoanTerm(req.GetTermMonths()); err != nil {
+		violations = append(violations, fieldViolation("term_months", err))
+	}
+
+	if req.GetOnMaturity() != "" {
+		if err := val.ValidateMaturityInstruction(req.GetOnMaturity()); err != nil {
+			violations = append(violations, fieldViolation("on_maturity", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/nhat195/simple_bank/authz"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) QuoteTransfer(ctx context.Context, req *pb.QuoteTransferRequest) (*pb.QuoteTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateQuoteTransferRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccountID, err := server.resolveAccountID(ctx, req.GetFromAccountId(), req.GetFromAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := server.getAuthorizedAccount(ctx, authPayload.Username, fromAccountID, authz.MoveMoney)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(fromAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	toAccountID, err := server.resolveAccountID(ctx, req.GetToAccountId(), req.GetToAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.getAccount(ctx, toAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(toAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	fee, err := server.store.GetTransferFee(ctx, fromAccount, req.GetAmount())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to calculate fee: %v", err)
+	}
+
+	rsp := &pb.QuoteTransferResponse{
+		Amount:        req.GetAmount(),
+		Fee:           fee.Amount,
+		TotalDebit:    req.GetAmount() + fee.Amount,
+		Currency:      fromAccount.Currency,
+		FeeScheduleId: fee.ScheduleID,
+	}
+	return rsp, nil
+}
+
+func validateQuoteTransferRequest(req *pb.QuoteTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("from_account_id", "from_account_number", req.GetFromAccountId(), req.GetFromAccountNumber())...)
+
+	violations = append(violations, validateAccountRef("to_account_id", "to_account_number", req.GetToAccountId(), req.GetToAccountNumber())...)
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RemoveAccountMember takes a member off an account. The owner can remove anyone
+// but themselves, and every other member can leave the account on their own.
+func (server *Server) RemoveAccountMember(ctx context.Context, req *pb.RemoveAccountMemberRequest) (*pb.RemoveAccountMemberResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateRemoveAccountMemberRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	permission := authz.ManageMembers
+	if req.GetUsername() == authPayload.Username {
+		permission = authz.ViewAccount
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.GetAccountMemberParams{
+		AccountID: account.ID,
+		Username:  req.GetUsername(),
+	}
+
+	member, err := server.store.GetAccountMember(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user is not a member of account [%d]", account.ID)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get account member: %v", err)
+	}
+
+	if member.Role == util.MemberOwner {
+		return nil, status.Errorf(codes.FailedPrecondition, "the owner cannot be removed from account [%d]", account.ID)
+	}
+
+	err = server.store.DeleteAccountMember(ctx, db.DeleteAccountMemberParams(arg))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to remove account member: %v", err)
+	}
+
+	return &pb.RemoveAccountMemberResponse{}, nil
+}
+
+func validateRemoveAccountMemberRequest(req *pb.RemoveAccountMemberRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	return violations
+}
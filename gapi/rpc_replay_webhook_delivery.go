@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hibiken/asynq"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"github.com/nhat195/simple_bank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReplayWebhookDelivery sends a delivery again, whether it succeeded or failed.
+// The event keeps its ID, so the receiver can tell it is a replay.
+func (server *Server) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.ReplayWebhookDeliveryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReplayWebhookDeliveryRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	delivery, err := server.store.GetWebhookDelivery(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "webhook delivery [%d] not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get webhook delivery: %v", err)
+	}
+
+	if _, err := server.getWebhookEndpoint(ctx, authPayload.Username, delivery.EndpointID); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "webhook delivery [%d] not found", req.GetId())
+		}
+		return nil, err
+	}
+
+	delivery, err = server.store.ResetWebhookDelivery(ctx, delivery.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to reset webhook delivery: %v", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(worker.WebhookMaxRetry),
+		asynq.Queue(worker.QueueDefault),
+	}
+	err = server.taskDistributor.DistributeTaskDeliverWebhook(ctx, &worker.PayloadDeliverWebhook{
+		DeliveryID: delivery.ID,
+	}, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to replay webhook delivery: %v", err)
+	}
+
+	rsp := &pb.ReplayWebhookDeliveryResponse{
+		Delivery: convertWebhookDelivery(delivery),
+	}
+	return rsp, nil
+}
+
+func validateReplayWebhookDeliveryRequest(req *pb.ReplayWebhookDeliveryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveRecipient looks up who an alias belongs to before money is sent to it.
+// Only a masked name is returned, together with the account that receives
+// payments in each currency the recipient holds.
+func (server *Server) ResolveRecipient(ctx context.Context, req *pb.ResolveRecipientRequest) (*pb.ResolveRecipientResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateResolveRecipientRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.store.ResolveAlias(ctx, req.GetAliasType(), req.GetAlias())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "no recipient found for the alias")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to resolve alias: %v", err)
+	}
+
+	accounts, err := server.store.ListDefaultAccounts(ctx, user.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list recipient accounts: %v", err)
+	}
+
+	rsp := &pb.ResolveRecipientResponse{
+		AliasType:   req.GetAliasType(),
+		Alias:       util.NormalizeAlias(req.GetAliasType(), req.GetAlias()),
+		DisplayName: util.MaskName(user.FullName),
+		Accounts:    make([]*pb.RecipientAccount, len(accounts)),
+	}
+	for i, account := range accounts {
+		rsp.Accounts[i] = &pb.RecipientAccount{
+			Currency:      account.Currency,
+			AccountNumber: account.AccountNumber,
+		}
+	}
+	return rsp, nil
+}
+
+func validateResolveRecipientRequest(req *pb.ResolveRecipientRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	return validateAlias("alias_type", "alias", req.GetAliasType(), req.GetAlias())
+}
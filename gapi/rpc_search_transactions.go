@@ -0,0 +1,149 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhat195/simple_bank/authz"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SearchTransactions(ctx context.Context, req *pb.SearchTransactionsRequest) (*pb.SearchTransactionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSearchTransactionsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if accountID != 0 {
+		if _, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount); err != nil {
+			return nil, err
+		}
+	}
+
+	counterpartyAccountID, err := server.resolveAccountID(ctx, req.GetCounterpartyAccountId(), req.GetCounterpartyAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.SearchTransactionsParams{
+		Username:              authPayload.Username,
+		AccountID:             accountID,
+		Currency:              req.GetCurrency(),
+		MinAmount:             req.GetMinAmount(),
+		MaxAmount:             req.GetMaxAmount(),
+		Direction:             req.GetDirection(),
+		CounterpartyAccountID: counterpartyAccountID,
+		Reference:             req.GetReference(),
+		RowLimit:              req.GetPageSize(),
+		RowOffset:             (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+	if req.FromTime != nil {
+		arg.FromTime = req.GetFromTime().AsTime()
+	}
+	if req.ToTime != nil {
+		arg.ToTime = req.GetToTime().AsTime()
+	}
+
+	transactions, err := server.store.SearchTransactions(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to search transactions: %v", err)
+	}
+
+	accountIDs := make([]int64, 0, 2*len(transactions))
+	for _, transaction := range transactions {
+		accountIDs = append(accountIDs, transaction.AccountID, transaction.CounterpartyAccountID.Int64)
+	}
+	numbers, err := server.accountNumbers(ctx, accountIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.SearchTransactionsResponse{
+		Transactions: make([]*pb.Transaction, len(transactions)),
+	}
+	for i, transaction := range transactions {
+		rsp.Transactions[i] = convertTransaction(transaction, numbers)
+	}
+	return rsp, nil
+}
+
+func validateSearchTransactionsRequest(req *pb.SearchTransactionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if req.AccountId != nil || req.AccountNumber != nil {
+		violations = append(violations, validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())...)
+	}
+
+	if req.Currency != nil {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+
+	if req.FromTime != nil {
+		if err := req.GetFromTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("from_time", err))
+		}
+	}
+
+	if req.ToTime != nil {
+		if err := req.GetToTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("to_time", err))
+		} else if req.FromTime != nil && !req.GetToTime().AsTime().After(req.GetFromTime().AsTime()) {
+			violations = append(violations, fieldViolation("to_time", fmt.Errorf("must be after from_time")))
+		}
+	}
+
+	if req.MinAmount != nil {
+		if err := val.ValidateAmount(req.GetMinAmount()); err != nil {
+			violations = append(violations, fieldViolation("min_amount", err))
+		}
+	}
+
+	if req.MaxAmount != nil {
+		if err := val.ValidateAmount(req.GetMaxAmount()); err != nil {
+			violations = append(violations, fieldViolation("max_amount", err))
+		} else if req.GetMaxAmount() < req.GetMinAmount() {
+			violations = append(violations, fieldViolation("max_amount", fmt.Errorf("must not be less than min_amount")))
+		}
+	}
+
+	if req.Direction != nil {
+		if err := val.ValidateDirection(req.GetDirection()); err != nil {
+			violations = append(violations, fieldViolation("direction", err))
+		}
+	}
+
+	if req.CounterpartyAccountId != nil || req.CounterpartyAccountNumber != nil {
+		violations = append(violations, validateAccountRef("counterparty_account_id", "counterparty_account_number", req.GetCounterpartyAccountId(), req.GetCounterpartyAccountNumber())...)
+	}
+
+	if req.Reference != nil {
+		if err := val.ValidateString(req.GetReference(), 1, val.MaxReferenceLength); err != nil {
+			violations = append(violations, fieldViolation("reference", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// UnfreezeCard lets a frozen card pay again.
+func (server *Server) UnfreezeCard(ctx context.Context, req *pb.UnfreezeCardRequest) (*pb.UnfreezeCardResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUnfreezeCardRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	card, err := server.setCardStatus(ctx, authPayload.Username, req.GetId(), db.CardActive)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := server.accountNumbers(ctx, card.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.UnfreezeCardResponse{
+		Card: convertCard(card, numbers),
+	}
+	return rsp, nil
+}
+
+func validateUnfreezeCardRequest(req *pb.UnfreezeCardRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateBeneficiary renames a payee. The account of a payee cannot be changed,
+// since that would skip the cooling-off period of a new payee.
+func (server *Server) UpdateBeneficiary(ctx context.Context, req *pb.UpdateBeneficiaryRequest) (*pb.UpdateBeneficiaryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateBeneficiaryRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	beneficiary, err := server.getBeneficiary(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	beneficiary, err = server.store.UpdateBeneficiary(ctx, db.UpdateBeneficiaryParams{
+		ID:       beneficiary.ID,
+		Nickname: req.GetNickname(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update beneficiary: %v", err)
+	}
+
+	numbers, err := server.accountNumbers(ctx, beneficiary.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.UpdateBeneficiaryResponse{
+		Beneficiary: convertBeneficiary(beneficiary, numbers),
+	}
+	return rsp, nil
+}
+
+func validateUpdateBeneficiaryRequest(req *pb.UpdateBeneficiaryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateNickname(req.GetNickname()); err != nil {
+		violations = append(violations, fieldViolation("nickname", err))
+	}
+
+	return violations
+}
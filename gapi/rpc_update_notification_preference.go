@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateNotificationPreference sets the channels an alert is sent through and,
+// for low_balance and large_debit, the amount it fires at.
+func (server *Server) UpdateNotificationPreference(ctx context.Context, req *pb.UpdateNotificationPreferenceRequest) (*pb.UpdateNotificationPreferenceResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateNotificationPreferenceRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	preference, err := server.store.UpsertNotificationPreference(ctx, db.UpsertNotificationPreferenceParams{
+		Username:  authPayload.Username,
+		EventType: req.GetEventType(),
+		Channels:  append([]string{}, req.GetChannels()...),
+		Threshold: req.GetThreshold(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update notification preference: %v", err)
+	}
+
+	rsp := &pb.UpdateNotificationPreferenceResponse{
+		Preference: convertNotificationPreference(preference),
+	}
+	return rsp, nil
+}
+
+func validateUpdateNotificationPreferenceRequest(req *pb.UpdateNotificationPreferenceRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !slices.Contains(db.AlertTypes, req.GetEventType()) {
+		err := fmt.Errorf("must be one of %s", strings.Join(db.AlertTypes, ", "))
+		violations = append(violations, fieldViolation("event_type", err))
+	}
+
+	for i, channel := range req.GetChannels() {
+		if !slices.Contains(db.NotificationChannels, channel) {
+			err := fmt.Errorf("must be one of %s", strings.Join(db.NotificationChannels, ", "))
+			violations = append(violations, fieldViolation(fmt.Sprintf("channels[%d]", i), err))
+		} else if slices.Contains(req.GetChannels()[:i], channel) {
+			violations = append(violations, fieldViolation(fmt.Sprintf("channels[%d]", i), fmt.Errorf("is given more than once")))
+		}
+	}
+
+	switch {
+	case req.GetThreshold() < 0:
+		violations = append(violations, fieldViolation("threshold", fmt.Errorf("must not be negative")))
+	case !db.AlertHasThreshold(req.GetEventType()) && req.GetThreshold() != 0:
+		violations = append(violations, fieldViolation("threshold", fmt.Errorf("is only used by low_balance and large_debit")))
+	case db.AlertHasThreshold(req.GetEventType()) && len(req.GetChannels()) > 0 && req.GetThreshold() == 0:
+		violations = append(violations, fieldViolation("threshold", fmt.Errorf("must be set to turn on %s", req.GetEventType())))
+	}
+
+	return violations
+}
@@ -40,6 +40,10 @@ func (sever *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			String: req.GetEmail(),
 			Valid:  req.Email != nil,
 		},
+		Language: sql.NullString{
+			String: req.GetLanguage(),
+			Valid:  req.Language != nil,
+		},
 	}
 
 	if req.Password != nil {
@@ -64,6 +68,8 @@ func (sever *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			switch pqErr.Code.Name() {
 			case "no_data_found":
 				return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "email is already in use")
 			}
 
 		}
@@ -98,5 +104,11 @@ func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdeta
 		}
 	}
 
+	if req.Language != nil {
+		if err := val.ValidateLanguage(req.GetLanguage()); err != nil {
+			violations = append(violations, fieldViolation("language", err))
+		}
+	}
+
 	return violations
 }
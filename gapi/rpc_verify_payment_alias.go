@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/nhat195/simple_bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyPaymentAlias confirms a phone number of the user with the code sent to
+// it, after which payments to the number reach the user. A code is rejected
+// once it expired or after util.AliasMaxVerifyAttempts wrong ones.
+func (server *Server) VerifyPaymentAlias(ctx context.Context, req *pb.VerifyPaymentAliasRequest) (*pb.VerifyPaymentAliasResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateVerifyPaymentAliasRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentError(violations)
+	}
+
+	key := db.GetUserPaymentAliasParams{
+		AliasType: req.GetAliasType(),
+		Value:     util.NormalizeAlias(req.GetAliasType(), req.GetAlias()),
+		Username:  authPayload.Username,
+	}
+
+	alias, err := server.store.VerifyPaymentAlias(ctx, db.VerifyPaymentAliasParams{
+		AliasType:   key.AliasType,
+		Value:       key.Value,
+		Username:    key.Username,
+		SecretCode:  req.GetSecretCode(),
+		MaxAttempts: util.AliasMaxVerifyAttempts,
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return nil, status.Errorf(codes.AlreadyExists, "the %s is already registered", key.AliasType)
+		}
+		if err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "Failed to verify payment alias: %v", err)
+		}
+
+		// tell a wrong code apart from an alias there is nothing to verify
+		pending, err := server.store.GetUserPaymentAlias(ctx, key)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "payment alias not found")
+			}
+			return nil, status.Errorf(codes.Internal, "Failed to get payment alias: %v", err)
+		}
+		if pending.VerifiedAt.Valid {
+			return nil, status.Errorf(codes.FailedPrecondition, "payment alias is already verified")
+		}
+
+		err = server.store.RecordPaymentAliasVerifyFailure(ctx, db.RecordPaymentAliasVerifyFailureParams(key))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to record verification attempt: %v", err)
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "invalid or expired verification code")
+	}
+
+	rsp := &pb.VerifyPaymentAliasResponse{
+		Alias: convertPaymentAlias(alias),
+	}
+	return rsp, nil
+}
+
+func validateVerifyPaymentAliasRequest(req *pb.VerifyPaymentAliasRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !util.AliasNeedsVerification(req.GetAliasType()) {
+		err := fmt.Errorf("must be %s", util.AliasPhone)
+		return append(violations, fieldViolation("alias_type", err))
+	}
+
+	violations = validateAlias("alias_type", "alias", req.GetAliasType(), req.GetAlias())
+
+	if err := val.ValidateAliasVerificationCode(req.GetSecretCode()); err != nil {
+		violations = append(violations, fieldViolation("secret_code", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhat195/simple_bank/authz"
+	"github.com/nhat195/simple_bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+)
+
+// WatchAccount streams the entries of an account as their transactions commit.
+// A client that reconnects with the last_entry_id it received gets every entry
+// it missed.
+func (server *Server) WatchAccount(req *pb.WatchAccountRequest, stream grpc.ServerStreamingServer[pb.WatchAccountResponse]) error {
+	ctx := stream.Context()
+
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return unauthenticatedError(err)
+	}
+
+	violations := validateWatchAccountRequest(req)
+	if len(violations) > 0 {
+		return invalidArgumentError(violations)
+	}
+
+	accountID, err := server.resolveAccountID(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return err
+	}
+
+	account, err := server.getAuthorizedAccount(ctx, authPayload.Username, accountID, authz.ViewAccount)
+	if err != nil {
+		return err
+	}
+
+	return server.watchAccountActivity(ctx, accountActivityWatch{
+		accountID:    account.ID,
+		afterEntryID: req.GetAfterEntryId(),
+		authorize: func(ctx context.Context) error {
+			if _, err := server.authorizeUser(ctx); err != nil {
+				return unauthenticatedError(err)
+			}
+			_, err := server.getAuthorizedAccount(ctx, authPayload.Username, account.ID, authz.ViewAccount)
+			return err
+		},
+		send: stream.Send,
+	})
+}
+
+func validateWatchAccountRequest(req *pb.WatchAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateAccountRef("account_id", "account_number", req.GetAccountId(), req.GetAccountNumber())
+	if req.GetAfterEntryId() < 0 {
+		violations = append(violations, fieldViolation("after_entry_id", fmt.Errorf("must not be negative")))
+	}
+	return violations
+}
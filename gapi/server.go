@@ -17,6 +17,7 @@ type Server struct {
 	store           db.Store
 	tokenMaker      token.Maker
 	taskDistributor worker.TaskDistributor
+	entryFeed       *entryFeed
 }
 
 // NewServer creates a new gRPC server.
@@ -31,6 +32,7 @@ func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDi
 		store:           store,
 		tokenMaker:      tokenMaker,
 		taskDistributor: taskDistributor,
+		entryFeed:       newEntryFeed(config.DBSource),
 	}
 
 	return server, nil
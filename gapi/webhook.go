@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getWebhookEndpoint loads a webhook endpoint registered by the user. Deleted
+// endpoints and those of other users are reported as not found.
+func (server *Server) getWebhookEndpoint(ctx context.Context, username string, id int64) (db.WebhookEndpoint, error) {
+	endpoint, err := server.store.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return endpoint, status.Errorf(codes.NotFound, "webhook endpoint [%d] not found", id)
+		}
+		return endpoint, status.Errorf(codes.Internal, "Failed to get webhook endpoint: %v", err)
+	}
+
+	if endpoint.Username != username || !endpoint.Active {
+		return db.WebhookEndpoint{}, status.Errorf(codes.NotFound, "webhook endpoint [%d] not found", id)
+	}
+
+	return endpoint, nil
+}
+
+func isWebhookEventType(eventType string) bool {
+	return slices.Contains(db.WebhookEventTypes, eventType)
+}
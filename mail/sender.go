@@ -21,6 +21,14 @@ type EmailSender interface {
 		bcc []string,
 		attachFiles []string,
 	) error
+	// SendMessage sends a rendered template along with its text alternative.
+	SendMessage(
+		message Message,
+		to []string,
+		cc []string,
+		bcc []string,
+		attachFiles []string,
+	) error
 }
 
 type GmailSender struct {
@@ -38,10 +46,17 @@ func NewGmailSender(name, fromEmailAddr, fromEmailPassword string) EmailSender {
 }
 
 func (s *GmailSender) SendEmail(subject string, content string, to []string, cc []string, bcc []string, attachFiles []string) error {
+	return s.SendMessage(Message{Subject: subject, HTML: content}, to, cc, bcc, attachFiles)
+}
+
+func (s *GmailSender) SendMessage(message Message, to []string, cc []string, bcc []string, attachFiles []string) error {
 	e := email.NewEmail()
 	e.From = fmt.Sprintf("%s <%s>", s.name, s.fromEmailAddr)
-	e.Subject = subject
-	e.HTML = []byte(content)
+	e.Subject = message.Subject
+	e.HTML = []byte(message.HTML)
+	if message.Text != "" {
+		e.Text = []byte(message.Text)
+	}
 	e.To = to
 	e.Cc = cc
 	e.Bcc = bcc
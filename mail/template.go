@@ -0,0 +1,200 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+)
+
+// Templates are kept per language under templates/<language>. Every email has
+// an HTML and a text variant: <name>.html.tmpl defines its "body", and
+// <name>.txt.tmpl defines its "subject" and plain text "body". Both are wrapped
+// in the layouts of templates/, which take their "footer" from the footer.tmpl
+// of the language.
+//
+//go:embed templates
+var templateFS embed.FS
+
+// Names of the email templates
+const (
+	verifyEmailTemplate      = "verify_email"
+	paymentRequestTemplate   = "payment_request"
+	incomingTransferTemplate = "incoming_transfer"
+	largeDebitTemplate       = "large_debit"
+	lowBalanceTemplate       = "low_balance"
+	newLoginTemplate         = "new_login"
+)
+
+var templateNames = []string{
+	verifyEmailTemplate,
+	paymentRequestTemplate,
+	incomingTransferTemplate,
+	largeDebitTemplate,
+	lowBalanceTemplate,
+	newLoginTemplate,
+}
+
+var languages = []string{util.LanguageEnglish, util.LanguageVietnamese}
+
+// Message is an email rendered from a template, with a plain text alternative
+// for clients that do not show HTML.
+type Message struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+}
+
+type emailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// templates holds every email by language and name. They are parsed when the
+// package loads, so a broken template stops the program from starting.
+var templates = parseTemplates()
+
+func parseTemplates() map[string]map[string]*emailTemplate {
+	parsed := make(map[string]map[string]*emailTemplate, len(languages))
+	for _, language := range languages {
+		parsed[language] = make(map[string]*emailTemplate, len(templateNames))
+		for _, name := range templateNames {
+			footer := fmt.Sprintf("templates/%s/footer.tmpl", language)
+			parsed[language][name] = &emailTemplate{
+				html: htmltemplate.Must(htmltemplate.New("layout.html.tmpl").Funcs(templateFuncs(language)).ParseFS(templateFS,
+					"templates/layout.html.tmpl", footer, fmt.Sprintf("templates/%s/%s.html.tmpl", language, name))),
+				text: texttemplate.Must(texttemplate.New("layout.txt.tmpl").Funcs(templateFuncs(language)).ParseFS(templateFS,
+					"templates/layout.txt.tmpl", footer, fmt.Sprintf("templates/%s/%s.txt.tmpl", language, name))),
+			}
+		}
+	}
+	return parsed
+}
+
+// templateFuncs formats values the way readers of the language expect.
+func templateFuncs(language string) map[string]any {
+	decimalSeparator := "."
+	timeLayout := "2006-01-02 15:04 MST"
+	if language == util.LanguageVietnamese {
+		decimalSeparator = ","
+		timeLayout = "15:04 02/01/2006 MST"
+	}
+
+	return map[string]any{
+		"lang": func() string {
+			return language
+		},
+		"money": func(amount int64, currency string) string {
+			return strings.Replace(util.FormatAmount(amount), ".", decimalSeparator, 1) + " " + currency
+		},
+		"datetime": func(t time.Time) string {
+			return t.UTC().Format(timeLayout)
+		},
+	}
+}
+
+// render renders an email in the language, falling back to DefaultLanguage
+// for languages there are no templates for.
+func render(name string, language string, data any) (Message, error) {
+	if !util.IsSupportedLanguage(language) {
+		language = util.DefaultLanguage
+	}
+	tmpl := templates[language][name]
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, fmt.Errorf("could not render subject of %s: %w", name, err)
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return Message{}, fmt.Errorf("could not render text of %s: %w", name, err)
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return Message{}, fmt.Errorf("could not render HTML of %s: %w", name, err)
+	}
+
+	return Message{
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
+
+// VerifyEmailData fills in the email asking a new user to verify their address.
+type VerifyEmailData struct {
+	FullName  string
+	VerifyURL string
+}
+
+func RenderVerifyEmail(language string, data VerifyEmailData) (Message, error) {
+	return render(verifyEmailTemplate, language, data)
+}
+
+// PaymentRequestData fills in the email telling a payer about a payment request.
+type PaymentRequestData struct {
+	PayerName     string
+	RequesterName string
+	Amount        int64
+	Currency      string
+	Message       string
+	ExpiresAt     time.Time
+}
+
+func RenderPaymentRequest(language string, data PaymentRequestData) (Message, error) {
+	return render(paymentRequestTemplate, language, data)
+}
+
+// IncomingTransferData fills in the alert of money paid into an account.
+type IncomingTransferData struct {
+	FullName      string
+	AccountNumber string
+	Amount        int64
+	Currency      string
+	Reference     string
+}
+
+func RenderIncomingTransfer(language string, data IncomingTransferData) (Message, error) {
+	return render(incomingTransferTemplate, language, data)
+}
+
+// LargeDebitData fills in the alert of a large payment out of an account.
+type LargeDebitData struct {
+	FullName      string
+	AccountNumber string
+	Amount        int64
+	Currency      string
+}
+
+func RenderLargeDebit(language string, data LargeDebitData) (Message, error) {
+	return render(largeDebitTemplate, language, data)
+}
+
+// LowBalanceData fills in the alert of a payment taking a balance below the
+// threshold of the user.
+type LowBalanceData struct {
+	FullName      string
+	AccountNumber string
+	Amount        int64
+	Balance       int64
+	Currency      string
+}
+
+func RenderLowBalance(language string, data LowBalanceData) (Message, error) {
+	return render(lowBalanceTemplate, language, data)
+}
+
+// NewLoginData fills in the alert of a login from a new device.
+type NewLoginData struct {
+	FullName  string
+	At        time.Time
+	ClientIP  string
+	UserAgent string
+}
+
+func RenderNewLogin(language string, data NewLoginData) (Message, error) {
+	return render(newLoginTemplate, language, data)
+}
@@ -0,0 +1,121 @@
+package mail
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var testTime = time.Date(2024, time.October, 7, 14, 30, 0, 0, time.UTC)
+
+// testRenders renders every template with the same data in a given language.
+var testRenders = map[string]func(language string) (Message, error){
+	verifyEmailTemplate: func(language string) (Message, error) {
+		return RenderVerifyEmail(language, VerifyEmailData{
+			FullName:  "Alice Nguyen",
+			VerifyURL: "https://simplebank.example/verify_email?email_id=7&secret_code=abc",
+		})
+	},
+	paymentRequestTemplate: func(language string) (Message, error) {
+		return RenderPaymentRequest(language, PaymentRequestData{
+			PayerName:     "Alice Nguyen",
+			RequesterName: "Bob Tran",
+			Amount:        123_450,
+			Currency:      util.EUR,
+			Message:       `Rent & "utilities" <October>`,
+			ExpiresAt:     testTime,
+		})
+	},
+	incomingTransferTemplate: func(language string) (Message, error) {
+		return RenderIncomingTransfer(language, IncomingTransferData{
+			FullName:      "Alice Nguyen",
+			AccountNumber: "SB12000100000007",
+			Amount:        250_000,
+			Currency:      util.USD,
+			Reference:     "PAY/2024/10",
+		})
+	},
+	largeDebitTemplate: func(language string) (Message, error) {
+		return RenderLargeDebit(language, LargeDebitData{
+			FullName:      "Alice Nguyen",
+			AccountNumber: "SB12000100000007",
+			Amount:        99_999,
+			Currency:      util.USD,
+		})
+	},
+	lowBalanceTemplate: func(language string) (Message, error) {
+		return RenderLowBalance(language, LowBalanceData{
+			FullName:      "Alice Nguyen",
+			AccountNumber: "SB12000100000007",
+			Amount:        12_550,
+			Balance:       1_005,
+			Currency:      util.CAD,
+		})
+	},
+	newLoginTemplate: func(language string) (Message, error) {
+		return RenderNewLogin(language, NewLoginData{
+			FullName:  "Alice Nguyen",
+			At:        testTime,
+			ClientIP:  "203.0.113.9",
+			UserAgent: "Mozilla/5.0 <Test>",
+		})
+	},
+}
+
+func requireGolden(t *testing.T, name string, got string) {
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(want), got)
+}
+
+// TestRenderTemplates compares every template in every language with its
+// golden files. The HTML golden files double as previews: open them in a
+// browser after running the tests with -update.
+func TestRenderTemplates(t *testing.T) {
+	require.Len(t, testRenders, len(templateNames))
+
+	for _, language := range languages {
+		for _, name := range templateNames {
+			t.Run(language+"/"+name, func(t *testing.T) {
+				message, err := testRenders[name](language)
+				require.NoError(t, err)
+				require.NotEmpty(t, message.Subject)
+				require.NotContains(t, message.Subject, "\n")
+
+				requireGolden(t, filepath.Join(language, name+".html"), message.HTML)
+				requireGolden(t, filepath.Join(language, name+".txt"), "Subject: "+message.Subject+"\n\n"+message.Text)
+			})
+		}
+	}
+}
+
+func TestRenderEscapesHTMLOnly(t *testing.T) {
+	message, err := testRenders[paymentRequestTemplate](util.LanguageEnglish)
+	require.NoError(t, err)
+	require.Contains(t, message.HTML, "Rent &amp; &#34;utilities&#34; &lt;October&gt;")
+	require.Contains(t, message.Text, `Rent & "utilities" <October>`)
+}
+
+func TestRenderFallsBackToDefaultLanguage(t *testing.T) {
+	want, err := testRenders[newLoginTemplate](util.DefaultLanguage)
+	require.NoError(t, err)
+
+	for _, language := range []string{"", "fr"} {
+		got, err := testRenders[newLoginTemplate](language)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+}
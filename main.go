@@ -16,7 +16,9 @@ import (
 	"github.com/nhat195/simple_bank/api"
 	db "github.com/nhat195/simple_bank/db/sqlc"
 	"github.com/nhat195/simple_bank/gapi"
+	"github.com/nhat195/simple_bank/mail"
 	"github.com/nhat195/simple_bank/pb"
+	"github.com/nhat195/simple_bank/sms"
 	"github.com/nhat195/simple_bank/util"
 	"github.com/nhat195/simple_bank/worker"
 	"github.com/rs/zerolog"
@@ -45,6 +47,11 @@ func main() {
 
 	store := db.NewStore(conn)
 
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		runReconciliation(store)
+		return
+	}
+
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
 	}
@@ -52,14 +59,22 @@ func main() {
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
 	// runGinServer(config, store)
 
-	go runTaskProcessor(redisOpt, store)
+	go runTaskProcessor(config, redisOpt, store, taskDistributor)
+	go runTaskScheduler(redisOpt)
+	go runOutboxRelay(store, taskDistributor)
 	go runGatewayServer(config, store, taskDistributor)
 	runGPCServer(config, store, taskDistributor)
 
 }
 
-func runTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) {
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store)
+func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store, taskDistributor worker.TaskDistributor) {
+	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
+	// there is no SMS provider yet, so codes are only logged in development
+	smsSender := sms.NewUnavailableSender()
+	if config.Environment == "development" {
+		smsSender = sms.NewLogSender()
+	}
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer, smsSender, taskDistributor, config.PublicURL)
 	log.Info().Msg("task processor started")
 	err := taskProcessor.Start()
 	if err != nil {
@@ -67,6 +82,36 @@ func runTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) {
 	}
 }
 
+func runTaskScheduler(redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt)
+	log.Info().Msg("task scheduler started")
+	err := taskScheduler.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot start task scheduler")
+	}
+}
+
+func runOutboxRelay(store db.Store, taskDistributor worker.TaskDistributor) {
+	relay := worker.NewOutboxRelay(store, taskDistributor)
+	log.Info().Msg("outbox relay started")
+	err := relay.Start(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot start outbox relay")
+	}
+}
+
+func runReconciliation(store db.Store) {
+	result, err := store.ReconcileTx(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot reconcile ledger")
+	}
+
+	worker.LogReconciliation(result.RunID.String(), len(result.Findings))
+	if len(result.Findings) > 0 {
+		os.Exit(1)
+	}
+}
+
 func runDbMigrations(migrationURL, dbSource string) {
 	migration, err := migrate.New(migrationURL, dbSource)
 
@@ -129,6 +174,24 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 		log.Fatal().Err(err).Msg("cannot register gateway server:")
 	}
 
+	err = grpcMux.HandlePath(http.MethodGet, gapi.StatementDownloadPattern, server.DownloadStatement)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot register statement download route:")
+	}
+
+	err = grpcMux.HandlePath(http.MethodGet, gapi.AccountEventsPattern, server.AccountEvents)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot register account events route:")
+	}
+
+	err = grpcMux.HandlePath(http.MethodGet, gapi.AccountEventsByNumberPattern, server.AccountEvents)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot register account events route:")
+	}
+
 	mux := http.NewServeMux()
 
 	mux.Handle("/", grpcMux)
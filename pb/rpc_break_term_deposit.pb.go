@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_break_term_deposit.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BreakTermDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId     int64  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+}
+
+func (x *BreakTermDepositRequest) Reset() {
+	*x = BreakTermDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_break_term_deposit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakTermDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakTermDepositRequest) ProtoMessage() {}
+
+func (x *BreakTermDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_break_term_deposit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakTermDepositRequest.ProtoReflect.Descriptor instead.
+func (*BreakTermDepositRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_break_term_deposit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BreakTermDepositRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *BreakTermDepositRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type BreakTermDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermDeposit *TermDeposit `protobuf:"bytes,1,opt,name=term_deposit,json=termDeposit,proto3" json:"term_deposit,omitempty"`
+	Interest    int64        `protobuf:"varint,2,opt,name=interest,proto3" json:"interest,omitempty"`
+}
+
+func (x *BreakTermDepositResponse) Reset() {
+	*x = BreakTermDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_break_term_deposit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakTermDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakTermDepositResponse) ProtoMessage() {}
+
+func (x *BreakTermDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_break_term_deposit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakTermDepositResponse.ProtoReflect.Descriptor instead.
+func (*BreakTermDepositResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_break_term_deposit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BreakTermDepositResponse) GetTermDeposit() *TermDeposit {
+	if x != nil {
+		return x.TermDeposit
+	}
+	return nil
+}
+
+func (x *BreakTermDepositResponse) GetInterest() int64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+var File_rpc_break_term_deposit_proto protoreflect.FileDescriptor
+
+var file_rpc_break_term_deposit_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x72, 0x70, 0x63, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x74, 0x65, 0x72, 0x6d,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x12, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x17, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x54,
+	0x65, 0x72, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x18, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x54, 0x65, 0x72, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x0b, 0x74, 0x65, 0x72, 0x6d,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6e, 0x68, 0x61, 0x74, 0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_break_term_deposit_proto_rawDescOnce sync.Once
+	file_rpc_break_term_deposit_proto_rawDescData = file_rpc_break_term_deposit_proto_rawDesc
+)
+
+func file_rpc_break_term_deposit_proto_rawDescGZIP() []byte {
+	file_rpc_break_term_deposit_proto_rawDescOnce.Do(func() {
+		file_rpc_break_term_deposit_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_break_term_deposit_proto_rawDescData)
+	})
+	return file_rpc_break_term_deposit_proto_rawDescData
+}
+
+var file_rpc_break_term_deposit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_break_term_deposit_proto_goTypes = []any{
+	(*BreakTermDepositRequest)(nil),  // 0: pb.BreakTermDepositRequest
+	(*BreakTermDepositResponse)(nil), // 1: pb.BreakTermDepositResponse
+	(*TermDeposit)(nil),              // 2: pb.TermDeposit
+}
+var file_rpc_break_term_deposit_proto_depIdxs = []int32{
+	2, // 0: pb.BreakTermDepositResponse.term_deposit:type_name -> pb.TermDeposit
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_break_term_deposit_proto_init() }
+func file_rpc_break_term_deposit_proto_init() {
+	if File_rpc_break_term_deposit_proto != nil {
+		return
+	}
+	file_term_deposit_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_break_term_deposit_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*BreakTermDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_break_term_deposit_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*BreakTermDepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_break_term_deposit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_break_term_deposit_proto_goTypes,
+		DependencyIndexes: file_rpc_break_term_deposit_proto_depIdxs,
+		MessageInfos:      file_rpc_break_term_deposit_proto_msgTypes,
+	}.Build()
+	File_rpc_break_term_deposit_proto = out.File
+	file_rpc_break_term_deposit_proto_rawDesc = nil
+	file_rpc_break_term_deposit_proto_goTypes = nil
+	file_rpc_break_term_deposit_proto_depIdxs = nil
+}
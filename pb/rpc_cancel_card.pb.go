@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_cancel_card.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancelCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelCardRequest) Reset() {
+	*x = CancelCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_cancel_card_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCardRequest) ProtoMessage() {}
+
+func (x *CancelCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_cancel_card_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCardRequest.ProtoReflect.Descriptor instead.
+func (*CancelCardRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_cancel_card_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CancelCardRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card *Card `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *CancelCardResponse) Reset() {
+	*x = CancelCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_cancel_card_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCardResponse) ProtoMessage() {}
+
+func (x *CancelCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_cancel_card_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCardResponse.ProtoReflect.Descriptor instead.
+func (*CancelCardResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_cancel_card_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CancelCardResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+var File_rpc_cancel_card_proto protoreflect.FileDescriptor
+
+var file_rpc_cancel_card_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x63, 0x61, 0x72,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x63, 0x61, 0x72,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x12,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e,
+	0x68, 0x61, 0x74, 0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x61,
+	0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_cancel_card_proto_rawDescOnce sync.Once
+	file_rpc_cancel_card_proto_rawDescData = file_rpc_cancel_card_proto_rawDesc
+)
+
+func file_rpc_cancel_card_proto_rawDescGZIP() []byte {
+	file_rpc_cancel_card_proto_rawDescOnce.Do(func() {
+		file_rpc_cancel_card_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_cancel_card_proto_rawDescData)
+	})
+	return file_rpc_cancel_card_proto_rawDescData
+}
+
+var file_rpc_cancel_card_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_cancel_card_proto_goTypes = []any{
+	(*CancelCardRequest)(nil),  // 0: pb.CancelCardRequest
+	(*CancelCardResponse)(nil), // 1: pb.CancelCardResponse
+	(*Card)(nil),               // 2: pb.Card
+}
+var file_rpc_cancel_card_proto_depIdxs = []int32{
+	2, // 0: pb.CancelCardResponse.card:type_name -> pb.Card
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_cancel_card_proto_init() }
+func file_rpc_cancel_card_proto_init() {
+	if File_rpc_cancel_card_proto != nil {
+		return
+	}
+	file_card_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_cancel_card_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_cancel_card_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelCardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_cancel_card_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_cancel_card_proto_goTypes,
+		DependencyIndexes: file_rpc_cancel_card_proto_depIdxs,
+		MessageInfos:      file_rpc_cancel_card_proto_msgTypes,
+	}.Build()
+	File_rpc_cancel_card_proto = out.File
+	file_rpc_cancel_card_proto_rawDesc = nil
+	file_rpc_cancel_card_proto_goTypes = nil
+	file_rpc_cancel_card_proto_depIdxs = nil
+}
@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_confirm_external_match.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfirmExternalMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalTransactionId int64 `protobuf:"varint,1,opt,name=external_transaction_id,json=externalTransactionId,proto3" json:"external_transaction_id,omitempty"`
+	TransferId            int64 `protobuf:"varint,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+}
+
+func (x *ConfirmExternalMatchRequest) Reset() {
+	*x = ConfirmExternalMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_confirm_external_match_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmExternalMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmExternalMatchRequest) ProtoMessage() {}
+
+func (x *ConfirmExternalMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_confirm_external_match_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmExternalMatchRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmExternalMatchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_confirm_external_match_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfirmExternalMatchRequest) GetExternalTransactionId() int64 {
+	if x != nil {
+		return x.ExternalTransactionId
+	}
+	return 0
+}
+
+func (x *ConfirmExternalMatchRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type ConfirmExternalMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *ExternalTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *ConfirmExternalMatchResponse) Reset() {
+	*x = ConfirmExternalMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_confirm_external_match_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmExternalMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmExternalMatchResponse) ProtoMessage() {}
+
+func (x *ConfirmExternalMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_confirm_external_match_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmExternalMatchResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmExternalMatchResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_confirm_external_match_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfirmExternalMatchResponse) GetTransaction() *ExternalTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+var File_rpc_confirm_external_match_proto protoreflect.FileDescriptor
+
+var file_rpc_confirm_external_match_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x76, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x1c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x68, 0x61, 0x74, 0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpc_confirm_external_match_proto_rawDescOnce sync.Once
+	file_rpc_confirm_external_match_proto_rawDescData = file_rpc_confirm_external_match_proto_rawDesc
+)
+
+func file_rpc_confirm_external_match_proto_rawDescGZIP() []byte {
+	file_rpc_confirm_external_match_proto_rawDescOnce.Do(func() {
+		file_rpc_confirm_external_match_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_confirm_external_match_proto_rawDescData)
+	})
+	return file_rpc_confirm_external_match_proto_rawDescData
+}
+
+var file_rpc_confirm_external_match_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_confirm_external_match_proto_goTypes = []any{
+	(*ConfirmExternalMatchRequest)(nil),  // 0: pb.ConfirmExternalMatchRequest
+	(*ConfirmExternalMatchResponse)(nil), // 1: pb.ConfirmExternalMatchResponse
+	(*ExternalTransaction)(nil),          // 2: pb.ExternalTransaction
+}
+var file_rpc_confirm_external_match_proto_depIdxs = []int32{
+	2, // 0: pb.ConfirmExternalMatchResponse.transaction:type_name -> pb.ExternalTransaction
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_confirm_external_match_proto_init() }
+func file_rpc_confirm_external_match_proto_init() {
+	if File_rpc_confirm_external_match_proto != nil {
+		return
+	}
+	file_external_transaction_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_confirm_external_match_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmExternalMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_confirm_external_match_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmExternalMatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_confirm_external_match_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_confirm_external_match_proto_goTypes,
+		DependencyIndexes: file_rpc_confirm_external_match_proto_depIdxs,
+		MessageInfos:      file_rpc_confirm_external_match_proto_msgTypes,
+	}.Build()
+	File_rpc_confirm_external_match_proto = out.File
+	file_rpc_confirm_external_match_proto_rawDesc = nil
+	file_rpc_confirm_external_match_proto_goTypes = nil
+	file_rpc_confirm_external_match_proto_depIdxs = nil
+}
@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_create_pot.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId     int64   `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  int64   `protobuf:"varint,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	TargetDate    *string `protobuf:"bytes,4,opt,name=target_date,json=targetDate,proto3,oneof" json:"target_date,omitempty"`
+	AccountNumber string  `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+}
+
+func (x *CreatePotRequest) Reset() {
+	*x = CreatePotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_pot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePotRequest) ProtoMessage() {}
+
+func (x *CreatePotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_pot_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePotRequest.ProtoReflect.Descriptor instead.
+func (*CreatePotRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_pot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePotRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreatePotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePotRequest) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *CreatePotRequest) GetTargetDate() string {
+	if x != nil && x.TargetDate != nil {
+		return *x.TargetDate
+	}
+	return ""
+}
+
+func (x *CreatePotRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type CreatePotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pot *Pot `protobuf:"bytes,1,opt,name=pot,proto3" json:"pot,omitempty"`
+}
+
+func (x *CreatePotResponse) Reset() {
+	*x = CreatePotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_pot_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePotResponse) ProtoMessage() {}
+
+func (x *CreatePotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_pot_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePotResponse.ProtoReflect.Descriptor instead.
+func (*CreatePotResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_pot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePotResponse) GetPot() *Pot {
+	if x != nil {
+		return x.Pot
+	}
+	return nil
+}
+
+var File_rpc_create_pot_proto protoreflect.FileDescriptor
+
+var file_rpc_create_pot_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x09, 0x70, 0x6f, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22,
+	0x2e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x74, 0x52, 0x03, 0x70, 0x6f, 0x74, 0x42,
+	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x68,
+	0x61, 0x74, 0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e,
+	0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_pot_proto_rawDescOnce sync.Once
+	file_rpc_create_pot_proto_rawDescData = file_rpc_create_pot_proto_rawDesc
+)
+
+func file_rpc_create_pot_proto_rawDescGZIP() []byte {
+	file_rpc_create_pot_proto_rawDescOnce.Do(func() {
+		file_rpc_create_pot_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_pot_proto_rawDescData)
+	})
+	return file_rpc_create_pot_proto_rawDescData
+}
+
+var file_rpc_create_pot_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_pot_proto_goTypes = []any{
+	(*CreatePotRequest)(nil),  // 0: pb.CreatePotRequest
+	(*CreatePotResponse)(nil), // 1: pb.CreatePotResponse
+	(*Pot)(nil),               // 2: pb.Pot
+}
+var file_rpc_create_pot_proto_depIdxs = []int32{
+	2, // 0: pb.CreatePotResponse.pot:type_name -> pb.Pot
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_pot_proto_init() }
+func file_rpc_create_pot_proto_init() {
+	if File_rpc_create_pot_proto != nil {
+		return
+	}
+	file_pot_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_pot_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_pot_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_create_pot_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_pot_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_pot_proto_goTypes,
+		DependencyIndexes: file_rpc_create_pot_proto_depIdxs,
+		MessageInfos:      file_rpc_create_pot_proto_msgTypes,
+	}.Build()
+	File_rpc_create_pot_proto = out.File
+	file_rpc_create_pot_proto_rawDesc = nil
+	file_rpc_create_pot_proto_goTypes = nil
+	file_rpc_create_pot_proto_depIdxs = nil
+}
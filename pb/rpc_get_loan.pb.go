@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_get_loan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_loan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_loan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetLoanRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan        *Loan             `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Instalments []*LoanInstalment `protobuf:"bytes,2,rep,name=instalments,proto3" json:"instalments,omitempty"`
+	Arrears     *LoanArrears      `protobuf:"bytes,3,opt,name=arrears,proto3" json:"arrears,omitempty"`
+	// Principal still to be repaid.
+	OutstandingPrincipal int64 `protobuf:"varint,4,opt,name=outstanding_principal,json=outstandingPrincipal,proto3" json:"outstanding_principal,omitempty"`
+}
+
+func (x *GetLoanResponse) Reset() {
+	*x = GetLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_loan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanResponse) ProtoMessage() {}
+
+func (x *GetLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_loan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetInstalments() []*LoanInstalment {
+	if x != nil {
+		return x.Instalments
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetArrears() *LoanArrears {
+	if x != nil {
+		return x.Arrears
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetOutstandingPrincipal() int64 {
+	if x != nil {
+		return x.OutstandingPrincipal
+	}
+	return 0
+}
+
+var File_rpc_get_loan_proto protoreflect.FileDescriptor
+
+var file_rpc_get_loan_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6c, 0x6f,
+	0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x07, 0x61, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73,
+	0x52, 0x07, 0x61, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x6f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x42, 0x23,
+	0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x68, 0x61,
+	0x74, 0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x6b,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_loan_proto_rawDescOnce sync.Once
+	file_rpc_get_loan_proto_rawDescData = file_rpc_get_loan_proto_rawDesc
+)
+
+func file_rpc_get_loan_proto_rawDescGZIP() []byte {
+	file_rpc_get_loan_proto_rawDescOnce.Do(func() {
+		file_rpc_get_loan_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_loan_proto_rawDescData)
+	})
+	return file_rpc_get_loan_proto_rawDescData
+}
+
+var file_rpc_get_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_loan_proto_goTypes = []any{
+	(*GetLoanRequest)(nil),  // 0: pb.GetLoanRequest
+	(*GetLoanResponse)(nil), // 1: pb.GetLoanResponse
+	(*Loan)(nil),            // 2: pb.Loan
+	(*LoanInstalment)(nil),  // 3: pb.LoanInstalment
+	(*LoanArrears)(nil),     // 4: pb.LoanArrears
+}
+var file_rpc_get_loan_proto_depIdxs = []int32{
+	2, // 0: pb.GetLoanResponse.loan:type_name -> pb.Loan
+	3, // 1: pb.GetLoanResponse.instalments:type_name -> pb.LoanInstalment
+	4, // 2: pb.GetLoanResponse.arrears:type_name -> pb.LoanArrears
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_loan_proto_init() }
+func file_rpc_get_loan_proto_init() {
+	if File_rpc_get_loan_proto != nil {
+		return
+	}
+	file_loan_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_loan_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_loan_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_loan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_loan_proto_goTypes,
+		DependencyIndexes: file_rpc_get_loan_proto_depIdxs,
+		MessageInfos:      file_rpc_get_loan_proto_msgTypes,
+	}.Build()
+	File_rpc_get_loan_proto = out.File
+	file_rpc_get_loan_proto_rawDesc = nil
+	file_rpc_get_loan_proto_goTypes = nil
+	file_rpc_get_loan_proto_depIdxs = nil
+}
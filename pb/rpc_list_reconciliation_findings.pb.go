@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_list_reconciliation_findings.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListReconciliationFindingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageId   int32   `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize int32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	RunId    *string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`
+}
+
+func (x *ListReconciliationFindingsRequest) Reset() {
+	*x = ListReconciliationFindingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_reconciliation_findings_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReconciliationFindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationFindingsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationFindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_reconciliation_findings_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationFindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationFindingsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_reconciliation_findings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListReconciliationFindingsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListReconciliationFindingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReconciliationFindingsRequest) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+type ListReconciliationFindingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Findings []*ReconciliationFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+}
+
+func (x *ListReconciliationFindingsResponse) Reset() {
+	*x = ListReconciliationFindingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_reconciliation_findings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReconciliationFindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationFindingsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationFindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_reconciliation_findings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationFindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationFindingsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_reconciliation_findings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListReconciliationFindingsResponse) GetFindings() []*ReconciliationFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+var File_rpc_list_reconciliation_findings_proto protoreflect.FileDescriptor
+
+var file_rpc_list_reconciliation_findings_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1c, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x21, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x5b, 0x0a,
+	0x22, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x68, 0x61, 0x74, 0x31, 0x39, 0x35,
+	0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_reconciliation_findings_proto_rawDescOnce sync.Once
+	file_rpc_list_reconciliation_findings_proto_rawDescData = file_rpc_list_reconciliation_findings_proto_rawDesc
+)
+
+func file_rpc_list_reconciliation_findings_proto_rawDescGZIP() []byte {
+	file_rpc_list_reconciliation_findings_proto_rawDescOnce.Do(func() {
+		file_rpc_list_reconciliation_findings_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_reconciliation_findings_proto_rawDescData)
+	})
+	return file_rpc_list_reconciliation_findings_proto_rawDescData
+}
+
+var file_rpc_list_reconciliation_findings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_reconciliation_findings_proto_goTypes = []any{
+	(*ListReconciliationFindingsRequest)(nil),  // 0: pb.ListReconciliationFindingsRequest
+	(*ListReconciliationFindingsResponse)(nil), // 1: pb.ListReconciliationFindingsResponse
+	(*ReconciliationFinding)(nil),              // 2: pb.ReconciliationFinding
+}
+var file_rpc_list_reconciliation_findings_proto_depIdxs = []int32{
+	2, // 0: pb.ListReconciliationFindingsResponse.findings:type_name -> pb.ReconciliationFinding
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_reconciliation_findings_proto_init() }
+func file_rpc_list_reconciliation_findings_proto_init() {
+	if File_rpc_list_reconciliation_findings_proto != nil {
+		return
+	}
+	file_reconciliation_finding_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_reconciliation_findings_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ListReconciliationFindingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_reconciliation_findings_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ListReconciliationFindingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_list_reconciliation_findings_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_reconciliation_findings_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_reconciliation_findings_proto_goTypes,
+		DependencyIndexes: file_rpc_list_reconciliation_findings_proto_depIdxs,
+		MessageInfos:      file_rpc_list_reconciliation_findings_proto_msgTypes,
+	}.Build()
+	File_rpc_list_reconciliation_findings_proto = out.File
+	file_rpc_list_reconciliation_findings_proto_rawDesc = nil
+	file_rpc_list_reconciliation_findings_proto_goTypes = nil
+	file_rpc_list_reconciliation_findings_proto_depIdxs = nil
+}
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_list_unmatched_external_transactions.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListUnmatchedExternalTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageId        int32   `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	AccountId     *int64  `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	ImportId      *string `protobuf:"bytes,4,opt,name=import_id,json=importId,proto3,oneof" json:"import_id,omitempty"`
+	AccountNumber *string `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+}
+
+func (x *ListUnmatchedExternalTransactionsRequest) Reset() {
+	*x = ListUnmatchedExternalTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_unmatched_external_transactions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUnmatchedExternalTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnmatchedExternalTransactionsRequest) ProtoMessage() {}
+
+func (x *ListUnmatchedExternalTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_unmatched_external_transactions_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnmatchedExternalTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUnmatchedExternalTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_unmatched_external_transactions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListUnmatchedExternalTransactionsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListUnmatchedExternalTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUnmatchedExternalTransactionsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListUnmatchedExternalTransactionsRequest) GetImportId() string {
+	if x != nil && x.ImportId != nil {
+		return *x.ImportId
+	}
+	return ""
+}
+
+func (x *ListUnmatchedExternalTransactionsRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+type ListUnmatchedExternalTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*ExternalTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *ListUnmatchedExternalTransactionsResponse) Reset() {
+	*x = ListUnmatchedExternalTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_unmatched_external_transactions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUnmatchedExternalTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnmatchedExternalTransactionsResponse) ProtoMessage() {}
+
+func (x *ListUnmatchedExternalTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_unmatched_external_transactions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnmatchedExternalTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUnmatchedExternalTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_unmatched_external_transactions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListUnmatchedExternalTransactionsResponse) GetTransactions() []*ExternalTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+var File_rpc_list_unmatched_external_transactions_proto protoreflect.FileDescriptor
+
+var file_rpc_list_unmatched_external_transactions_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x82, 0x02, 0x0a, 0x28, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x29, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x68,
+	0x61, 0x74, 0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e,
+	0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_unmatched_external_transactions_proto_rawDescOnce sync.Once
+	file_rpc_list_unmatched_external_transactions_proto_rawDescData = file_rpc_list_unmatched_external_transactions_proto_rawDesc
+)
+
+func file_rpc_list_unmatched_external_transactions_proto_rawDescGZIP() []byte {
+	file_rpc_list_unmatched_external_transactions_proto_rawDescOnce.Do(func() {
+		file_rpc_list_unmatched_external_transactions_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_unmatched_external_transactions_proto_rawDescData)
+	})
+	return file_rpc_list_unmatched_external_transactions_proto_rawDescData
+}
+
+var file_rpc_list_unmatched_external_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_unmatched_external_transactions_proto_goTypes = []any{
+	(*ListUnmatchedExternalTransactionsRequest)(nil),  // 0: pb.ListUnmatchedExternalTransactionsRequest
+	(*ListUnmatchedExternalTransactionsResponse)(nil), // 1: pb.ListUnmatchedExternalTransactionsResponse
+	(*ExternalTransaction)(nil),                       // 2: pb.ExternalTransaction
+}
+var file_rpc_list_unmatched_external_transactions_proto_depIdxs = []int32{
+	2, // 0: pb.ListUnmatchedExternalTransactionsResponse.transactions:type_name -> pb.ExternalTransaction
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_unmatched_external_transactions_proto_init() }
+func file_rpc_list_unmatched_external_transactions_proto_init() {
+	if File_rpc_list_unmatched_external_transactions_proto != nil {
+		return
+	}
+	file_external_transaction_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_unmatched_external_transactions_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ListUnmatchedExternalTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_unmatched_external_transactions_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ListUnmatchedExternalTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_list_unmatched_external_transactions_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_unmatched_external_transactions_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_unmatched_external_transactions_proto_goTypes,
+		DependencyIndexes: file_rpc_list_unmatched_external_transactions_proto_depIdxs,
+		MessageInfos:      file_rpc_list_unmatched_external_transactions_proto_msgTypes,
+	}.Build()
+	File_rpc_list_unmatched_external_transactions_proto = out.File
+	file_rpc_list_unmatched_external_transactions_proto_rawDesc = nil
+	file_rpc_list_unmatched_external_transactions_proto_goTypes = nil
+	file_rpc_list_unmatched_external_transactions_proto_depIdxs = nil
+}
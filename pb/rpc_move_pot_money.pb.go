@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_move_pot_money.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MovePotMoneyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PotId            int64  `protobuf:"varint,1,opt,name=pot_id,json=potId,proto3" json:"pot_id,omitempty"`
+	Amount           int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PotAccountNumber string `protobuf:"bytes,3,opt,name=pot_account_number,json=potAccountNumber,proto3" json:"pot_account_number,omitempty"`
+}
+
+func (x *MovePotMoneyRequest) Reset() {
+	*x = MovePotMoneyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_move_pot_money_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovePotMoneyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePotMoneyRequest) ProtoMessage() {}
+
+func (x *MovePotMoneyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_move_pot_money_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePotMoneyRequest.ProtoReflect.Descriptor instead.
+func (*MovePotMoneyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_move_pot_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MovePotMoneyRequest) GetPotId() int64 {
+	if x != nil {
+		return x.PotId
+	}
+	return 0
+}
+
+func (x *MovePotMoneyRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MovePotMoneyRequest) GetPotAccountNumber() string {
+	if x != nil {
+		return x.PotAccountNumber
+	}
+	return ""
+}
+
+type MovePotMoneyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pot            *Pot  `protobuf:"bytes,1,opt,name=pot,proto3" json:"pot,omitempty"`
+	TransferId     int64 `protobuf:"varint,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	AccountBalance int64 `protobuf:"varint,3,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
+}
+
+func (x *MovePotMoneyResponse) Reset() {
+	*x = MovePotMoneyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_move_pot_money_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovePotMoneyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePotMoneyResponse) ProtoMessage() {}
+
+func (x *MovePotMoneyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_move_pot_money_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePotMoneyResponse.ProtoReflect.Descriptor instead.
+func (*MovePotMoneyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_move_pot_money_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MovePotMoneyResponse) GetPot() *Pot {
+	if x != nil {
+		return x.Pot
+	}
+	return nil
+}
+
+func (x *MovePotMoneyResponse) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *MovePotMoneyResponse) GetAccountBalance() int64 {
+	if x != nil {
+		return x.AccountBalance
+	}
+	return 0
+}
+
+var File_rpc_move_pot_money_proto protoreflect.FileDescriptor
+
+var file_rpc_move_pot_money_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x74, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x09,
+	0x70, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x72, 0x0a, 0x13, 0x4d, 0x6f, 0x76,
+	0x65, 0x50, 0x6f, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7b, 0x0a,
+	0x14, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x74, 0x52, 0x03, 0x70, 0x6f, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x68, 0x61, 0x74, 0x31, 0x39, 0x35,
+	0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_move_pot_money_proto_rawDescOnce sync.Once
+	file_rpc_move_pot_money_proto_rawDescData = file_rpc_move_pot_money_proto_rawDesc
+)
+
+func file_rpc_move_pot_money_proto_rawDescGZIP() []byte {
+	file_rpc_move_pot_money_proto_rawDescOnce.Do(func() {
+		file_rpc_move_pot_money_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_move_pot_money_proto_rawDescData)
+	})
+	return file_rpc_move_pot_money_proto_rawDescData
+}
+
+var file_rpc_move_pot_money_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_move_pot_money_proto_goTypes = []any{
+	(*MovePotMoneyRequest)(nil),  // 0: pb.MovePotMoneyRequest
+	(*MovePotMoneyResponse)(nil), // 1: pb.MovePotMoneyResponse
+	(*Pot)(nil),                  // 2: pb.Pot
+}
+var file_rpc_move_pot_money_proto_depIdxs = []int32{
+	2, // 0: pb.MovePotMoneyResponse.pot:type_name -> pb.Pot
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_move_pot_money_proto_init() }
+func file_rpc_move_pot_money_proto_init() {
+	if File_rpc_move_pot_money_proto != nil {
+		return
+	}
+	file_pot_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_move_pot_money_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*MovePotMoneyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_move_pot_money_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*MovePotMoneyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_move_pot_money_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_move_pot_money_proto_goTypes,
+		DependencyIndexes: file_rpc_move_pot_money_proto_depIdxs,
+		MessageInfos:      file_rpc_move_pot_money_proto_msgTypes,
+	}.Build()
+	File_rpc_move_pot_money_proto = out.File
+	file_rpc_move_pot_money_proto_rawDesc = nil
+	file_rpc_move_pot_money_proto_goTypes = nil
+	file_rpc_move_pot_money_proto_depIdxs = nil
+}
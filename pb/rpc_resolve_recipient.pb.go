@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: rpc_resolve_recipient.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResolveRecipientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AliasType string `protobuf:"bytes,1,opt,name=alias_type,json=aliasType,proto3" json:"alias_type,omitempty"`
+	Alias     string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (x *ResolveRecipientRequest) Reset() {
+	*x = ResolveRecipientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_resolve_recipient_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveRecipientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRecipientRequest) ProtoMessage() {}
+
+func (x *ResolveRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_resolve_recipient_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRecipientRequest.ProtoReflect.Descriptor instead.
+func (*ResolveRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_resolve_recipient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResolveRecipientRequest) GetAliasType() string {
+	if x != nil {
+		return x.AliasType
+	}
+	return ""
+}
+
+func (x *ResolveRecipientRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type ResolveRecipientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AliasType   string              `protobuf:"bytes,1,opt,name=alias_type,json=aliasType,proto3" json:"alias_type,omitempty"`
+	Alias       string              `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	DisplayName string              `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Accounts    []*RecipientAccount `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *ResolveRecipientResponse) Reset() {
+	*x = ResolveRecipientResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_resolve_recipient_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveRecipientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRecipientResponse) ProtoMessage() {}
+
+func (x *ResolveRecipientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_resolve_recipient_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRecipientResponse.ProtoReflect.Descriptor instead.
+func (*ResolveRecipientResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_resolve_recipient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveRecipientResponse) GetAliasType() string {
+	if x != nil {
+		return x.AliasType
+	}
+	return ""
+}
+
+func (x *ResolveRecipientResponse) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *ResolveRecipientResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ResolveRecipientResponse) GetAccounts() []*RecipientAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+var File_rpc_resolve_recipient_proto protoreflect.FileDescriptor
+
+var file_rpc_resolve_recipient_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x23, 0x5a,
+	0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x68, 0x61, 0x74,
+	0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_resolve_recipient_proto_rawDescOnce sync.Once
+	file_rpc_resolve_recipient_proto_rawDescData = file_rpc_resolve_recipient_proto_rawDesc
+)
+
+func file_rpc_resolve_recipient_proto_rawDescGZIP() []byte {
+	file_rpc_resolve_recipient_proto_rawDescOnce.Do(func() {
+		file_rpc_resolve_recipient_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_resolve_recipient_proto_rawDescData)
+	})
+	return file_rpc_resolve_recipient_proto_rawDescData
+}
+
+var file_rpc_resolve_recipient_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_resolve_recipient_proto_goTypes = []any{
+	(*ResolveRecipientRequest)(nil),  // 0: pb.ResolveRecipientRequest
+	(*ResolveRecipientResponse)(nil), // 1: pb.ResolveRecipientResponse
+	(*RecipientAccount)(nil),         // 2: pb.RecipientAccount
+}
+var file_rpc_resolve_recipient_proto_depIdxs = []int32{
+	2, // 0: pb.ResolveRecipientResponse.accounts:type_name -> pb.RecipientAccount
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_resolve_recipient_proto_init() }
+func file_rpc_resolve_recipient_proto_init() {
+	if File_rpc_resolve_recipient_proto != nil {
+		return
+	}
+	file_payment_alias_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_resolve_recipient_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolveRecipientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_resolve_recipient_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolveRecipientResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_resolve_recipient_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_resolve_recipient_proto_goTypes,
+		DependencyIndexes: file_rpc_resolve_recipient_proto_depIdxs,
+		MessageInfos:      file_rpc_resolve_recipient_proto_msgTypes,
+	}.Build()
+	File_rpc_resolve_recipient_proto = out.File
+	file_rpc_resolve_recipient_proto_rawDesc = nil
+	file_rpc_resolve_recipient_proto_goTypes = nil
+	file_rpc_resolve_recipient_proto_depIdxs = nil
+}
@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.2
+// source: term_deposit.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TermDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermMonths           int32                  `protobuf:"varint,3,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	AnnualRateBps        int64                  `protobuf:"varint,4,opt,name=annual_rate_bps,json=annualRateBps,proto3" json:"annual_rate_bps,omitempty"`
+	EarlyBreakPenaltyBps int64                  `protobuf:"varint,5,opt,name=early_break_penalty_bps,json=earlyBreakPenaltyBps,proto3" json:"early_break_penalty_bps,omitempty"`
+	Principal            int64                  `protobuf:"varint,6,opt,name=principal,proto3" json:"principal,omitempty"`
+	Currency             string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance              int64                  `protobuf:"varint,8,opt,name=balance,proto3" json:"balance,omitempty"`
+	StartsOn             string                 `protobuf:"bytes,9,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	MaturesOn            string                 `protobuf:"bytes,10,opt,name=matures_on,json=maturesOn,proto3" json:"matures_on,omitempty"`
+	OnMaturity           string                 `protobuf:"bytes,11,opt,name=on_maturity,json=onMaturity,proto3" json:"on_maturity,omitempty"`
+	Status               string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt             *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=closed_at,json=closedAt,proto3,oneof" json:"closed_at,omitempty"`
+	AccountNumber        string                 `protobuf:"bytes,15,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	SourceAccountNumber  string                 `protobuf:"bytes,16,opt,name=source_account_number,json=sourceAccountNumber,proto3" json:"source_account_number,omitempty"`
+}
+
+func (x *TermDeposit) Reset() {
+	*x = TermDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_deposit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermDeposit) ProtoMessage() {}
+
+func (x *TermDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_term_deposit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermDeposit.ProtoReflect.Descriptor instead.
+func (*TermDeposit) Descriptor() ([]byte, []int) {
+	return file_term_deposit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TermDeposit) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *TermDeposit) GetAnnualRateBps() int64 {
+	if x != nil {
+		return x.AnnualRateBps
+	}
+	return 0
+}
+
+func (x *TermDeposit) GetEarlyBreakPenaltyBps() int64 {
+	if x != nil {
+		return x.EarlyBreakPenaltyBps
+	}
+	return 0
+}
+
+func (x *TermDeposit) GetPrincipal() int64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *TermDeposit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TermDeposit) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *TermDeposit) GetStartsOn() string {
+	if x != nil {
+		return x.StartsOn
+	}
+	return ""
+}
+
+func (x *TermDeposit) GetMaturesOn() string {
+	if x != nil {
+		return x.MaturesOn
+	}
+	return ""
+}
+
+func (x *TermDeposit) GetOnMaturity() string {
+	if x != nil {
+		return x.OnMaturity
+	}
+	return ""
+}
+
+func (x *TermDeposit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TermDeposit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TermDeposit) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *TermDeposit) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *TermDeposit) GetSourceAccountNumber() string {
+	if x != nil {
+		return x.SourceAccountNumber
+	}
+	return ""
+}
+
+type TermDepositRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermMonths           int32 `protobuf:"varint,1,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	AnnualRateBps        int64 `protobuf:"varint,2,opt,name=annual_rate_bps,json=annualRateBps,proto3" json:"annual_rate_bps,omitempty"`
+	EarlyBreakPenaltyBps int64 `protobuf:"varint,3,opt,name=early_break_penalty_bps,json=earlyBreakPenaltyBps,proto3" json:"early_break_penalty_bps,omitempty"`
+}
+
+func (x *TermDepositRate) Reset() {
+	*x = TermDepositRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_deposit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermDepositRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermDepositRate) ProtoMessage() {}
+
+func (x *TermDepositRate) ProtoReflect() protoreflect.Message {
+	mi := &file_term_deposit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermDepositRate.ProtoReflect.Descriptor instead.
+func (*TermDepositRate) Descriptor() ([]byte, []int) {
+	return file_term_deposit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TermDepositRate) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *TermDepositRate) GetAnnualRateBps() int64 {
+	if x != nil {
+		return x.AnnualRateBps
+	}
+	return 0
+}
+
+func (x *TermDepositRate) GetEarlyBreakPenaltyBps() int64 {
+	if x != nil {
+		return x.EarlyBreakPenaltyBps
+	}
+	return 0
+}
+
+var File_term_deposit_proto protoreflect.FileDescriptor
+
+var file_term_deposit_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x04, 0x0a, 0x0b, 0x54, 0x65,
+	0x72, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72,
+	0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6e,
+	0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x42,
+	0x70, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x50,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x42, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x4f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a,
+	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x08,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x52, 0x11, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x91, 0x01, 0x0a, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61,
+	0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x17,
+	0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x65,
+	0x61, 0x72, 0x6c, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x42, 0x70, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6e, 0x68, 0x61, 0x74, 0x31, 0x39, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_term_deposit_proto_rawDescOnce sync.Once
+	file_term_deposit_proto_rawDescData = file_term_deposit_proto_rawDesc
+)
+
+func file_term_deposit_proto_rawDescGZIP() []byte {
+	file_term_deposit_proto_rawDescOnce.Do(func() {
+		file_term_deposit_proto_rawDescData = protoimpl.X.CompressGZIP(file_term_deposit_proto_rawDescData)
+	})
+	return file_term_deposit_proto_rawDescData
+}
+
+var file_term_deposit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_term_deposit_proto_goTypes = []any{
+	(*TermDeposit)(nil),           // 0: pb.TermDeposit
+	(*TermDepositRate)(nil),       // 1: pb.TermDepositRate
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_term_deposit_proto_depIdxs = []int32{
+	2, // 0: pb.TermDeposit.created_at:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.TermDeposit.closed_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_term_deposit_proto_init() }
+func file_term_deposit_proto_init() {
+	if File_term_deposit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_term_deposit_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*TermDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_term_deposit_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TermDepositRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_term_deposit_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_term_deposit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_term_deposit_proto_goTypes,
+		DependencyIndexes: file_term_deposit_proto_depIdxs,
+		MessageInfos:      file_term_deposit_proto_msgTypes,
+	}.Build()
+	File_term_deposit_proto = out.File
+	file_term_deposit_proto_rawDesc = nil
+	file_term_deposit_proto_goTypes = nil
+	file_term_deposit_proto_depIdxs = nil
+}
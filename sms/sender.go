@@ -0,0 +1,41 @@
+package sms
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ErrNoProvider is returned by senders that cannot deliver text messages.
+var ErrNoProvider = errors.New("no SMS provider is configured")
+
+// SMSSender sends text messages to phone numbers in E.164 format.
+type SMSSender interface {
+	SendSMS(to string, body string) error
+}
+
+// LogSender writes text messages to the log instead of sending them, so phone
+// numbers can be verified in development without an SMS provider. It must not
+// be used elsewhere: the messages carry secret codes.
+type LogSender struct{}
+
+func NewLogSender() SMSSender {
+	return &LogSender{}
+}
+
+func (sender *LogSender) SendSMS(to string, body string) error {
+	log.Info().Str("to", to).Str("body", body).Msg("sms")
+	return nil
+}
+
+// UnavailableSender fails every message, for environments without an SMS
+// provider. Tasks sending text messages fail instead of pretending to succeed.
+type UnavailableSender struct{}
+
+func NewUnavailableSender() SMSSender {
+	return &UnavailableSender{}
+}
+
+func (sender *UnavailableSender) SendSMS(to string, body string) error {
+	return ErrNoProvider
+}
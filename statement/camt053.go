@@ -0,0 +1,247 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+)
+
+const (
+	camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+	camtNotProvided  = "NOTPROVIDED"
+	camtCredit       = "CRDT"
+	camtDebit        = "DBIT"
+)
+
+// The types below model the subset of camt.053.001.02 we fill in, with the
+// elements in schema order.
+type camtDocument struct {
+	XMLName xml.Name `xml:"Document"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Stmt    camtBkToCstmrStmt
+}
+
+type camtBkToCstmrStmt struct {
+	XMLName xml.Name `xml:"BkToCstmrStmt"`
+	GrpHdr  camtGrpHdr
+	Stmt    camtStmt
+}
+
+type camtGrpHdr struct {
+	MsgID    string `xml:"MsgId"`
+	CreDtTm  string `xml:"CreDtTm"`
+	MsgPgntn struct {
+		PgNb      int  `xml:"PgNb"`
+		LastPgInd bool `xml:"LastPgInd"`
+	} `xml:"MsgPgntn"`
+}
+
+type camtStmt struct {
+	ID        string `xml:"Id"`
+	CreDtTm   string `xml:"CreDtTm"`
+	FrToDt    camtFrToDt
+	Acct      camtAcct
+	Bal       []camtBal
+	TxsSummry camtTxsSummry
+	Ntry      []camtNtry
+}
+
+type camtFrToDt struct {
+	FrDtTm string `xml:"FrDtTm"`
+	ToDtTm string `xml:"ToDtTm"`
+}
+
+type camtAcct struct {
+	ID   string `xml:"Id>Othr>Id"`
+	Ccy  string `xml:"Ccy"`
+	Ownr string `xml:"Ownr>Nm"`
+}
+
+type camtAmt struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camtBal struct {
+	Cd        string  `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       camtAmt `xml:"Amt"`
+	CdtDbtInd string  `xml:"CdtDbtInd"`
+	Dt        string  `xml:"Dt>Dt"`
+}
+
+type camtNbAndSum struct {
+	NbOfNtries string `xml:"NbOfNtries"`
+	Sum        string `xml:"Sum"`
+}
+
+type camtTxsSummry struct {
+	TtlNtries struct {
+		NbOfNtries    string `xml:"NbOfNtries"`
+		TtlNetNtryAmt string `xml:"TtlNetNtryAmt"`
+		CdtDbtInd     string `xml:"CdtDbtInd"`
+	} `xml:"TtlNtries"`
+	TtlCdtNtries camtNbAndSum `xml:"TtlCdtNtries"`
+	TtlDbtNtries camtNbAndSum `xml:"TtlDbtNtries"`
+}
+
+type camtNtry struct {
+	NtryRef   string  `xml:"NtryRef"`
+	Amt       camtAmt `xml:"Amt"`
+	CdtDbtInd string  `xml:"CdtDbtInd"`
+	Sts       string  `xml:"Sts"`
+	BookgDt   string  `xml:"BookgDt>DtTm"`
+	ValDt     string  `xml:"ValDt>Dt"`
+	BkTxCd    struct {
+		Domn struct {
+			Cd   string `xml:"Cd"`
+			Fmly struct {
+				Cd        string `xml:"Cd"`
+				SubFmlyCd string `xml:"SubFmlyCd"`
+			} `xml:"Fmly"`
+		} `xml:"Domn"`
+	} `xml:"BkTxCd"`
+	TxDtls camtTxDtls `xml:"NtryDtls>TxDtls"`
+}
+
+type camtTxDtls struct {
+	EndToEndID string     `xml:"Refs>EndToEndId"`
+	TxID       string     `xml:"Refs>TxId,omitempty"`
+	Dbtr       *camtParty `xml:"RltdPties>Dbtr,omitempty"`
+	Cdtr       *camtParty `xml:"RltdPties>Cdtr,omitempty"`
+	Ustrd      string     `xml:"RmtInf>Ustrd,omitempty"`
+}
+
+type camtParty struct {
+	Nm string `xml:"Nm"`
+}
+
+// RenderCAMT053 renders a statement as an ISO 20022 camt.053.001.02 bank to
+// customer statement, with booked opening (OPBD) and closing (CLBD) balances.
+func RenderCAMT053(statement db.AccountStatement) ([]byte, error) {
+	currency := statement.Account.Currency
+	// Id holds at most 35 characters, room for account numbers of up to 26
+	id := fmt.Sprintf("%s-%s", statement.Account.AccountNumber, statement.PeriodStart.UTC().Format("20060102"))
+
+	stmt := camtStmt{
+		ID:      id,
+		CreDtTm: camtDateTime(statement.GeneratedAt),
+		FrToDt: camtFrToDt{
+			FrDtTm: camtDateTime(statement.PeriodStart),
+			ToDtTm: camtDateTime(statement.PeriodEnd),
+		},
+		Acct: camtAcct{
+			ID:   statement.Account.AccountNumber,
+			Ccy:  currency,
+			Ownr: statement.HolderName,
+		},
+		Bal: []camtBal{
+			camtBalance("OPBD", statement.OpeningBalance, currency, statement.PeriodStart),
+			camtBalance("CLBD", statement.ClosingBalance, currency, closingDate(statement)),
+		},
+		Ntry: make([]camtNtry, len(statement.Lines)),
+	}
+
+	var credits, debits int
+	for i, line := range statement.Lines {
+		stmt.Ntry[i] = camtEntry(line, currency)
+		if line.Amount < 0 {
+			debits++
+		} else {
+			credits++
+		}
+	}
+
+	net := statement.TotalCredits - statement.TotalDebits
+	summary := &stmt.TxsSummry
+	summary.TtlNtries.NbOfNtries = strconv.Itoa(len(statement.Lines))
+	summary.TtlNtries.TtlNetNtryAmt, summary.TtlNtries.CdtDbtInd = camtAmount(net)
+	summary.TtlCdtNtries = camtNbAndSum{NbOfNtries: strconv.Itoa(credits), Sum: util.FormatAmount(statement.TotalCredits)}
+	summary.TtlDbtNtries = camtNbAndSum{NbOfNtries: strconv.Itoa(debits), Sum: util.FormatAmount(statement.TotalDebits)}
+
+	doc := camtDocument{
+		Xmlns: camt053Namespace,
+		Stmt: camtBkToCstmrStmt{
+			GrpHdr: camtGrpHdr{MsgID: id, CreDtTm: stmt.CreDtTm},
+			Stmt:   stmt,
+		},
+	}
+	doc.Stmt.GrpHdr.MsgPgntn.PgNb = 1
+	doc.Stmt.GrpHdr.MsgPgntn.LastPgInd = true
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func camtEntry(line db.StatementLine, currency string) camtNtry {
+	amount, indicator := camtAmount(line.Amount)
+
+	ntry := camtNtry{
+		NtryRef:   strconv.FormatInt(line.ID, 10),
+		Amt:       camtAmt{Ccy: currency, Value: amount},
+		CdtDbtInd: indicator,
+		Sts:       "BOOK",
+		BookgDt:   camtDateTime(line.CreatedAt),
+		ValDt:     line.CreatedAt.UTC().Format(dateLayout),
+	}
+
+	// Book transfers between accounts of the bank: issued (ICDT) or received (RCDT)
+	ntry.BkTxCd.Domn.Cd = "PMNT"
+	ntry.BkTxCd.Domn.Fmly.Cd = "RCDT"
+	if indicator == camtDebit {
+		ntry.BkTxCd.Domn.Fmly.Cd = "ICDT"
+	}
+	ntry.BkTxCd.Domn.Fmly.SubFmlyCd = "BOOK"
+
+	ntry.TxDtls.EndToEndID = line.Reference
+	if ntry.TxDtls.EndToEndID == "" {
+		ntry.TxDtls.EndToEndID = camtNotProvided
+	}
+	if line.TransferID.Valid {
+		ntry.TxDtls.TxID = strconv.FormatInt(line.TransferID.Int64, 10)
+	}
+	if line.CounterpartyName != "" {
+		party := &camtParty{Nm: line.CounterpartyName}
+		if indicator == camtDebit {
+			ntry.TxDtls.Cdtr = party
+		} else {
+			ntry.TxDtls.Dbtr = party
+		}
+	}
+	ntry.TxDtls.Ustrd = line.Description
+
+	return ntry
+}
+
+func camtBalance(code string, balance int64, currency string, date time.Time) camtBal {
+	amount, indicator := camtAmount(balance)
+	return camtBal{
+		Cd:        code,
+		Amt:       camtAmt{Ccy: currency, Value: amount},
+		CdtDbtInd: indicator,
+		Dt:        date.UTC().Format(dateLayout),
+	}
+}
+
+// camtAmount splits an amount into its absolute value and credit/debit indicator.
+func camtAmount(amount int64) (string, string) {
+	if amount < 0 {
+		return util.FormatAmount(-amount), camtDebit
+	}
+	return util.FormatAmount(amount), camtCredit
+}
+
+func camtDateTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
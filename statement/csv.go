@@ -0,0 +1,62 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+)
+
+var csvHeader = []string{"date", "description", "reference", "counterparty", "debit", "credit", "balance", "currency"}
+
+// RenderCSV renders a statement as one table that opens with the opening balance,
+// lists every entry with its running balance and ends with the closing balance.
+func RenderCSV(statement db.AccountStatement) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	currency := statement.Account.Currency
+
+	records := [][]string{
+		csvHeader,
+		{formatTime(statement.PeriodStart), "Opening balance", "", "", "", "", util.FormatAmount(statement.OpeningBalance), currency},
+	}
+	for _, line := range statement.Lines {
+		debit, credit := "", ""
+		if line.Amount < 0 {
+			debit = util.FormatAmount(-line.Amount)
+		} else {
+			credit = util.FormatAmount(line.Amount)
+		}
+		records = append(records, []string{
+			formatTime(line.CreatedAt),
+			line.Description,
+			line.Reference,
+			line.CounterpartyName,
+			debit,
+			credit,
+			util.FormatAmount(line.Balance),
+			currency,
+		})
+	}
+	records = append(records, []string{
+		formatTime(statement.PeriodEnd),
+		"Closing balance",
+		"",
+		"",
+		util.FormatAmount(statement.TotalDebits),
+		util.FormatAmount(statement.TotalCredits),
+		util.FormatAmount(statement.ClosingBalance),
+		currency,
+	})
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,140 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+)
+
+const (
+	mt940NoReference     = "NONREF"
+	mt940ReferenceLength = 16
+	mt940NarrativeLines  = 6
+	mt940NarrativeWidth  = 65
+)
+
+// RenderMT940 renders a statement as the text block of a SWIFT MT940 customer
+// statement message: booked opening balance (:60F:), one :61:/:86: pair per entry
+// and booked closing balance (:62F:). Lines end with CRLF as SWIFT requires.
+func RenderMT940(statement db.AccountStatement) ([]byte, error) {
+	var buf bytes.Buffer
+	field := func(tag string, value string) {
+		fmt.Fprintf(&buf, ":%s:%s\r\n", tag, value)
+	}
+	currency := statement.Account.Currency
+
+	field("20", mt940Reference("STMT"+statement.PeriodStart.UTC().Format("060102")))
+	field("25", statement.Account.AccountNumber)
+	// statements are numbered by the year and month they start in, e.g. 2410/1
+	field("28C", statement.PeriodStart.UTC().Format("0601")+"/1")
+	field("60F", mt940Balance(statement.OpeningBalance, statement.PeriodStart, currency))
+
+	for _, line := range statement.Lines {
+		mark, amount := mt940Amount(line.Amount)
+
+		reference := mt940Reference(line.Reference)
+		if reference == "" {
+			reference = mt940NoReference
+		}
+		bankReference := mt940NoReference
+		if line.TransferID.Valid {
+			bankReference = strconv.FormatInt(line.TransferID.Int64, 10)
+		}
+
+		bookedAt := line.CreatedAt.UTC()
+		field("61", fmt.Sprintf("%s%s%s%sNTRF%s//%s",
+			bookedAt.Format("060102"),
+			bookedAt.Format("0102"),
+			mark,
+			amount,
+			reference,
+			bankReference,
+		))
+
+		narrative := mt940Narrative(line.Description, line.CounterpartyName)
+		if narrative != "" {
+			field("86", narrative)
+		}
+	}
+
+	field("62F", mt940Balance(statement.ClosingBalance, closingDate(statement), currency))
+	buf.WriteString("-\r\n")
+
+	return buf.Bytes(), nil
+}
+
+// mt940Balance formats a balance field: D/C mark, date, currency and amount.
+func mt940Balance(balance int64, date time.Time, currency string) string {
+	mark, amount := mt940Amount(balance)
+	return mark + date.UTC().Format("060102") + currency + amount
+}
+
+// mt940Amount splits an amount into its credit/debit mark and absolute value,
+// written with a decimal comma.
+func mt940Amount(amount int64) (string, string) {
+	mark := "C"
+	if amount < 0 {
+		mark = "D"
+		amount = -amount
+	}
+	return mark, strings.Replace(util.FormatAmount(amount), ".", ",", 1)
+}
+
+// mt940Reference makes a reference fit the 16 character reference subfields.
+// A reference may not start or end with a slash, nor contain two in a row.
+func mt940Reference(value string) string {
+	value = mt940Sanitize(value)
+	value = strings.ReplaceAll(value, "//", "/")
+	value = strings.ReplaceAll(value, " ", "")
+	if len(value) > mt940ReferenceLength {
+		value = value[:mt940ReferenceLength]
+	}
+	return strings.Trim(value, "/")
+}
+
+// mt940Narrative builds the :86: information to account owner, wrapped into at
+// most six lines of 65 characters.
+func mt940Narrative(description string, counterparty string) string {
+	var parts []string
+	if description != "" {
+		parts = append(parts, description)
+	}
+	if counterparty != "" {
+		parts = append(parts, counterparty)
+	}
+	text := mt940Sanitize(strings.Join(parts, " / "))
+
+	var lines []string
+	for len(text) > 0 && len(lines) < mt940NarrativeLines {
+		n := len(text)
+		if n > mt940NarrativeWidth {
+			n = mt940NarrativeWidth
+		}
+		line := text[:n]
+		// keep lines from starting with a character that would read as a field tag
+		if strings.HasPrefix(line, ":") || strings.HasPrefix(line, "-") {
+			line = "." + line[1:]
+		}
+		lines = append(lines, line)
+		text = text[n:]
+	}
+	return strings.Join(lines, "\r\n")
+}
+
+// mt940Sanitize replaces everything outside the SWIFT X character set with a dot.
+func mt940Sanitize(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("/-?:().,'+ ", r):
+			return r
+		}
+		return '.'
+	}, value)
+}
@@ -0,0 +1,133 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/go-pdf/fpdf"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+)
+
+const (
+	pdfFont       = "Helvetica"
+	pdfLineHeight = 6
+)
+
+// pdfColumns are the table columns with their width in millimetres; they add
+// up to the printable width of an A4 page with the default margins.
+var pdfColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"Date", 22, "L"},
+	{"Description", 48, "L"},
+	{"Counterparty", 40, "L"},
+	{"Debit", 26, "R"},
+	{"Credit", 26, "R"},
+	{"Balance", 28, "R"},
+}
+
+// RenderPDF renders a statement as a printable A4 document. The document dates
+// come from the statement so the same statement always renders the same bytes.
+func RenderPDF(statement db.AccountStatement) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetCatalogSort(true)
+	pdf.SetCreationDate(statement.GeneratedAt)
+	pdf.SetModificationDate(statement.GeneratedAt)
+	pdf.SetTitle(fmt.Sprintf("Statement of account %s", statement.Account.AccountNumber), true)
+	pdf.SetAuthor("Simple Bank", true)
+	pdf.AliasNbPages("")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)
+		pdf.SetFont(pdfFont, "I", 8)
+		pdf.CellFormat(0, 10, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	header := func() {
+		pdf.SetFont(pdfFont, "B", 9)
+		pdf.SetFillColor(230, 230, 230)
+		for _, column := range pdfColumns {
+			pdf.CellFormat(column.width, pdfLineHeight, column.title, "1", 0, column.align, true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont(pdfFont, "", 9)
+	}
+	pdf.SetHeaderFunc(func() {
+		if pdf.PageNo() > 1 {
+			header()
+		}
+	})
+
+	pdf.AddPage()
+	pdf.SetFont(pdfFont, "B", 16)
+	pdf.CellFormat(0, 10, "Account statement", "", 1, "L", false, 0, "")
+
+	pdf.SetFont(pdfFont, "", 10)
+	summary := [][2]string{
+		{"Account holder", tr(statement.HolderName)},
+		{"Account", fmt.Sprintf("%s (%s)", statement.Account.AccountNumber, statement.Account.Currency)},
+		{"Period", fmt.Sprintf("%s to %s",
+			statement.PeriodStart.UTC().Format(dateLayout),
+			statement.PeriodEnd.UTC().Format(dateLayout),
+		)},
+		{"Opening balance", util.FormatAmount(statement.OpeningBalance)},
+		{"Total debits", util.FormatAmount(statement.TotalDebits)},
+		{"Total credits", util.FormatAmount(statement.TotalCredits)},
+		{"Closing balance", util.FormatAmount(statement.ClosingBalance)},
+	}
+	for _, row := range summary {
+		pdf.CellFormat(40, pdfLineHeight, row[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, pdfLineHeight, row[1], "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	header()
+	for _, line := range statement.Lines {
+		debit, credit := "", ""
+		if line.Amount < 0 {
+			debit = util.FormatAmount(-line.Amount)
+		} else {
+			credit = util.FormatAmount(line.Amount)
+		}
+
+		values := []string{
+			line.CreatedAt.UTC().Format(dateLayout),
+			fit(pdf, tr(line.Description), pdfColumns[1].width),
+			fit(pdf, tr(line.CounterpartyName), pdfColumns[2].width),
+			debit,
+			credit,
+			util.FormatAmount(line.Balance),
+		}
+		for i, column := range pdfColumns {
+			pdf.CellFormat(column.width, pdfLineHeight, values[i], "1", 0, column.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	if len(statement.Lines) == 0 {
+		pdf.CellFormat(0, pdfLineHeight, "No transactions in this period.", "1", 1, "C", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fit shortens text with an ellipsis so it fits in a table cell of the given width.
+func fit(pdf *fpdf.Fpdf, text string, width float64) string {
+	const padding = 2
+	if pdf.GetStringWidth(text) <= width-padding {
+		return text
+	}
+
+	for len(text) > 0 && pdf.GetStringWidth(text+"...") > width-padding {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}
@@ -0,0 +1,71 @@
+// Package statement renders account statements in the formats customers can download.
+package statement
+
+import (
+	"fmt"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+)
+
+const dateLayout = "2006-01-02"
+
+// closingDate is the day whose end the closing balance of a statement reports.
+// Statements include entries up to and including PeriodEnd, so a period ending at
+// midnight closes on the previous day.
+func closingDate(statement db.AccountStatement) time.Time {
+	return statement.PeriodEnd.UTC().Add(-time.Nanosecond)
+}
+
+// Render renders an account statement in the given format.
+func Render(format string, statement db.AccountStatement) ([]byte, error) {
+	switch format {
+	case util.StatementCSV:
+		return RenderCSV(statement)
+	case util.StatementPDF:
+		return RenderPDF(statement)
+	case util.StatementCAMT053:
+		return RenderCAMT053(statement)
+	case util.StatementMT940:
+		return RenderMT940(statement)
+	}
+	return nil, fmt.Errorf("unsupported statement format %q", format)
+}
+
+// ContentType returns the MIME type of statements rendered in the given format.
+func ContentType(format string) string {
+	switch format {
+	case util.StatementCSV:
+		return "text/csv"
+	case util.StatementPDF:
+		return "application/pdf"
+	case util.StatementCAMT053:
+		return "application/xml"
+	case util.StatementMT940:
+		return "text/plain"
+	}
+	return "application/octet-stream"
+}
+
+// extension returns the file extension ERP systems expect for the format.
+func extension(format string) string {
+	switch format {
+	case util.StatementCAMT053:
+		return "xml"
+	case util.StatementMT940:
+		return "sta"
+	}
+	return format
+}
+
+// FileName returns the file name a generated statement of the account with the
+// given number is downloaded as.
+func FileName(statement db.Statement, accountNumber string) string {
+	return fmt.Sprintf("statement-%s-%s-%s.%s",
+		accountNumber,
+		statement.PeriodStart.UTC().Format(dateLayout),
+		statement.PeriodEnd.UTC().Format(dateLayout),
+		extension(statement.Format),
+	)
+}
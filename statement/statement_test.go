@@ -0,0 +1,197 @@
+package statement
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/xml"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func testStatement() db.AccountStatement {
+	start := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	entries := []db.Entry{
+		{
+			ID:               101,
+			AccountID:        7,
+			Amount:           -12_550,
+			CreatedAt:        start.Add(36 * time.Hour),
+			TransferID:       sql.NullInt64{Int64: 501, Valid: true},
+			Description:      "October rent",
+			Reference:        "RENT-2024-10",
+			CounterpartyName: "Jane Landlord",
+		},
+		{
+			ID:               102,
+			AccountID:        7,
+			Amount:           250_000,
+			CreatedAt:        start.Add(24*time.Hour*24 + 9*time.Hour),
+			TransferID:       sql.NullInt64{Int64: 502, Valid: true},
+			Description:      "Salary, October",
+			Reference:        "PAY/2024/10",
+			CounterpartyName: "Acme \"Widgets\" Inc",
+		},
+		{
+			ID:               103,
+			AccountID:        7,
+			Amount:           -75,
+			CreatedAt:        start.Add(24*time.Hour*24 + 9*time.Hour),
+			TransferID:       sql.NullInt64{Int64: 503, Valid: true},
+			Description:      "Transfer fee",
+			Reference:        "PAY/2024/10",
+			CounterpartyName: "Simple Bank",
+		},
+	}
+
+	statement := db.AccountStatement{
+		Account: db.Account{
+			ID:            7,
+			Owner:         "alice",
+			Currency:      util.EUR,
+			AccountNumber: "SB12000100000007",
+		},
+		HolderName:     "Alice Müller",
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		OpeningBalance: 100_000,
+		GeneratedAt:    end.Add(90 * time.Minute),
+	}
+
+	balance := statement.OpeningBalance
+	for _, entry := range entries {
+		balance += entry.Amount
+		if entry.Amount < 0 {
+			statement.TotalDebits -= entry.Amount
+		} else {
+			statement.TotalCredits += entry.Amount
+		}
+		statement.Lines = append(statement.Lines, db.StatementLine{Entry: entry, Balance: balance})
+	}
+	statement.ClosingBalance = balance
+
+	return statement
+}
+
+func requireGolden(t *testing.T, name string, got []byte) {
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got))
+}
+
+func TestRenderCSV(t *testing.T) {
+	got, err := Render(util.StatementCSV, testStatement())
+	require.NoError(t, err)
+	requireGolden(t, "statement.csv", got)
+}
+
+func TestRenderCAMT053(t *testing.T) {
+	got, err := Render(util.StatementCAMT053, testStatement())
+	require.NoError(t, err)
+	requireGolden(t, "statement.camt053.xml", got)
+
+	var doc camtDocument
+	require.NoError(t, xml.Unmarshal(got, &doc))
+	require.Len(t, doc.Stmt.Stmt.Ntry, 3)
+}
+
+func TestRenderCAMT053Overdrawn(t *testing.T) {
+	statement := testStatement()
+	statement.OpeningBalance = -500
+	statement.ClosingBalance = -1_000
+	statement.Lines = nil
+
+	got, err := RenderCAMT053(statement)
+	require.NoError(t, err)
+
+	var doc camtDocument
+	require.NoError(t, xml.Unmarshal(got, &doc))
+	require.Equal(t, camtBal{
+		Cd:        "CLBD",
+		Amt:       camtAmt{Ccy: util.EUR, Value: "10.00"},
+		CdtDbtInd: camtDebit,
+		Dt:        "2024-10-31",
+	}, doc.Stmt.Stmt.Bal[1])
+}
+
+func TestRenderMT940(t *testing.T) {
+	got, err := Render(util.StatementMT940, testStatement())
+	require.NoError(t, err)
+	requireGolden(t, "statement.mt940", got)
+}
+
+func TestMT940Narrative(t *testing.T) {
+	require.Empty(t, mt940Narrative("", ""))
+	require.Equal(t, "Rent / Jane", mt940Narrative("Rent", "Jane"))
+	require.Equal(t, "Caf. M.ller", mt940Narrative("Café Müller", ""))
+
+	lines := strings.Split(mt940Narrative(strings.Repeat("a", 500), ""), "\r\n")
+	require.Len(t, lines, mt940NarrativeLines)
+	for _, line := range lines {
+		require.Len(t, line, mt940NarrativeWidth)
+	}
+
+	require.Equal(t, ".00 EUR", mt940Narrative(":00 EUR", ""))
+}
+
+func TestMT940Reference(t *testing.T) {
+	require.Equal(t, "INV-2024/1", mt940Reference("/INV-2024//1/"))
+	require.Equal(t, "ABCDEFGHIJKLMNOP", mt940Reference("ABCDEFGHIJKLMNOPQRS"))
+	require.Equal(t, "payment1", mt940Reference("payment 1"))
+}
+
+func TestRenderPDF(t *testing.T) {
+	statement := testStatement()
+
+	got, err := Render(util.StatementPDF, statement)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(got, []byte("%PDF-")))
+
+	again, err := RenderPDF(statement)
+	require.NoError(t, err)
+	require.Equal(t, got, again)
+
+	statement.Lines = nil
+	empty, err := RenderPDF(statement)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(empty, []byte("%PDF-")))
+}
+
+func TestRenderUnsupportedFormat(t *testing.T) {
+	_, err := Render("xls", testStatement())
+	require.Error(t, err)
+}
+
+func TestFileName(t *testing.T) {
+	statement := db.Statement{
+		AccountID:   7,
+		Format:      util.StatementPDF,
+		PeriodStart: time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.Equal(t, "statement-SB12000100000007-2024-10-01-2024-11-01.pdf", FileName(statement, "SB12000100000007"))
+	require.Equal(t, "application/pdf", ContentType(statement.Format))
+
+	statement.Format = util.StatementMT940
+	require.Equal(t, "statement-SB12000100000007-2024-10-01-2024-11-01.sta", FileName(statement, "SB12000100000007"))
+
+	statement.Format = util.StatementCAMT053
+	require.Equal(t, "statement-SB12000100000007-2024-10-01-2024-11-01.xml", FileName(statement, "SB12000100000007"))
+	require.Equal(t, "application/xml", ContentType(statement.Format))
+}
@@ -0,0 +1,63 @@
+package util
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// AccountSerialDigits is the length of the random serial that ends an account number.
+const AccountSerialDigits = 10
+
+// NewAccountNumber generates an IBAN-style account number: the country code, two
+// check digits, then the bank prefix followed by a random serial. The serial is
+// drawn at random so the number says nothing about the internal account ID.
+func NewAccountNumber(country string, bankPrefix string) string {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Exp(big.NewInt(10), big.NewInt(AccountSerialDigits), nil))
+	if err != nil {
+		panic(fmt.Sprintf("cannot read random serial: %v", err))
+	}
+
+	bban := fmt.Sprintf("%s%0*d", strings.ToUpper(bankPrefix), AccountSerialDigits, serial)
+	return strings.ToUpper(country) + AccountNumberCheckDigits(country, bban) + bban
+}
+
+// AccountNumberCheckDigits computes the ISO 7064 mod 97-10 check digits of an
+// account number, the same way IBAN check digits are computed.
+func AccountNumberCheckDigits(country string, bban string) string {
+	return fmt.Sprintf("%02d", 98-mod97(strings.ToUpper(bban+country)+"00"))
+}
+
+// IsValidAccountNumber returns true if the check digits of an account number
+// match the rest of it, which catches any single typo and most swapped digits.
+func IsValidAccountNumber(number string) bool {
+	if len(number) < 5 {
+		return false
+	}
+	return mod97(number[4:]+number[:4]) == 1
+}
+
+// NormalizeAccountNumber removes the spaces account numbers are often printed
+// with and upper-cases the letters.
+func NormalizeAccountNumber(number string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(number), ""))
+}
+
+// mod97 returns the remainder modulo 97 of the number a string of digits and
+// letters stands for, each letter counting as two digits from A=10 to Z=35. It
+// returns -1 if the string holds any other character.
+func mod97(value string) int {
+	remainder := 0
+	for _, c := range value {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		default:
+			return -1
+		}
+	}
+	return remainder
+}
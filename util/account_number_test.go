@@ -0,0 +1,47 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountNumberCheckDigits(t *testing.T) {
+	// the examples of the IBAN registry
+	require.Equal(t, "82", AccountNumberCheckDigits("GB", "WEST12345698765432"))
+	require.Equal(t, "89", AccountNumberCheckDigits("DE", "370400440532013000"))
+}
+
+func TestNewAccountNumber(t *testing.T) {
+	number := NewAccountNumber("vn", "smpl0001")
+	require.Len(t, number, 4+8+AccountSerialDigits)
+	require.Equal(t, "VN", number[:2])
+	require.Equal(t, "SMPL0001", number[4:12])
+	require.True(t, IsValidAccountNumber(number))
+	require.NotEqual(t, number, NewAccountNumber("VN", "SMPL0001"))
+}
+
+func TestIsValidAccountNumber(t *testing.T) {
+	testCases := []struct {
+		name   string
+		number string
+		want   bool
+	}{
+		{"Valid", "GB82WEST12345698765432", true},
+		{"Typo", "GB82WEST12345698765433", false},
+		{"SwappedDigits", "GB82WEST12345698765423", false},
+		{"WrongCheckDigits", "GB28WEST12345698765432", false},
+		{"Lowercase", "gb82west12345698765432", false},
+		{"TooShort", "GB82", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, IsValidAccountNumber(tc.number))
+		})
+	}
+}
+
+func TestNormalizeAccountNumber(t *testing.T) {
+	require.Equal(t, "GB82WEST12345698765432", NormalizeAccountNumber(" gb82 west 1234 5698 7654 32"))
+}
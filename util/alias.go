@@ -0,0 +1,82 @@
+package util
+
+import (
+	"strings"
+	"time"
+)
+
+// Constants for all payment alias types
+const (
+	AliasUsername = "username"
+	AliasEmail    = "email"
+	AliasPhone    = "phone"
+	AliasHandle   = "handle"
+)
+
+// IsSupportedAliasType returns true if a recipient can be resolved by the alias type
+func IsSupportedAliasType(aliasType string) bool {
+	switch aliasType {
+	case AliasUsername, AliasEmail, AliasPhone, AliasHandle:
+		return true
+	}
+	return false
+}
+
+// IsRegistrableAliasType returns true if users register aliases of the type
+// themselves. Usernames and verified emails are aliases without registration.
+func IsRegistrableAliasType(aliasType string) bool {
+	return aliasType == AliasPhone || aliasType == AliasHandle
+}
+
+const (
+	// AliasVerificationCodeLength is the number of digits of the code a phone
+	// number is verified with.
+	AliasVerificationCodeLength = 6
+	// AliasVerificationExpiry is how long a verification code can be used.
+	AliasVerificationExpiry = 15 * time.Minute
+	// AliasMaxVerifyAttempts is how many wrong codes are accepted before a new
+	// code has to be sent, so codes cannot be guessed.
+	AliasMaxVerifyAttempts = 5
+)
+
+// AliasNeedsVerification returns true if a user has to prove an alias of the
+// type is theirs before it resolves. Handles are names users pick, but phone
+// numbers belong to someone.
+func AliasNeedsVerification(aliasType string) bool {
+	return aliasType == AliasPhone
+}
+
+// NewAliasVerificationCode generates the code sent to verify an alias.
+func NewAliasVerificationCode() string {
+	return randomDigits(AliasVerificationCodeLength)
+}
+
+// NormalizeAlias brings an alias into the form it is stored and looked up in, so
+// that "+84 90-123 4567" finds "+84901234567" and "@Alice" finds "alice".
+func NormalizeAlias(aliasType string, value string) string {
+	value = strings.TrimSpace(value)
+	switch aliasType {
+	case AliasUsername, AliasEmail:
+		return strings.ToLower(value)
+	case AliasPhone:
+		return strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(value)
+	case AliasHandle:
+		return strings.ToLower(strings.TrimPrefix(value, "@"))
+	}
+	return value
+}
+
+// MaskName shortens a full name to the first name and the initials of the other
+// names, so a payer can recognize the recipient without learning their full name.
+func MaskName(fullName string) string {
+	names := strings.Fields(fullName)
+	if len(names) == 0 {
+		return ""
+	}
+
+	masked := []string{names[0]}
+	for _, name := range names[1:] {
+		masked = append(masked, string([]rune(name)[0])+".")
+	}
+	return strings.Join(masked, " ")
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAlias(t *testing.T) {
+	testCases := []struct {
+		name      string
+		aliasType string
+		value     string
+		want      string
+	}{
+		{"Username", AliasUsername, " Alice ", "alice"},
+		{"Email", AliasEmail, "Alice@Example.com", "alice@example.com"},
+		{"Phone", AliasPhone, "+84 (90) 123-45.67", "+84901234567"},
+		{"Handle", AliasHandle, "@Alice_Pays", "alice_pays"},
+		{"UnknownType", "iban", " VN12 ", "VN12"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, NormalizeAlias(tc.aliasType, tc.value))
+		})
+	}
+}
+
+func TestNewAliasVerificationCode(t *testing.T) {
+	code := NewAliasVerificationCode()
+	require.Len(t, code, AliasVerificationCodeLength)
+	for _, c := range code {
+		require.True(t, c >= '0' && c <= '9')
+	}
+}
+
+func TestMaskName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fullName string
+		want     string
+	}{
+		{"Empty", "", ""},
+		{"SingleName", "Alice", "Alice"},
+		{"TwoNames", "Alice Smith", "Alice S."},
+		{"ManyNames", "Nguyen  Van An", "Nguyen V. A."},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, MaskName(tc.fullName))
+		})
+	}
+}
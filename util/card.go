@@ -0,0 +1,117 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// CardNumberLength is the length of the card numbers the bank issues.
+	CardNumberLength = 16
+	// CardValidYears is how long a newly issued card stays valid.
+	CardValidYears = 3
+)
+
+// NewCardNumber generates a card number starting with the BIN of the bank,
+// followed by random digits and a Luhn check digit.
+func NewCardNumber(bin string) string {
+	body := bin + randomDigits(CardNumberLength-len(bin)-1)
+	return body + LuhnCheckDigit(body)
+}
+
+// NewCVV generates a three digit card verification value.
+func NewCVV() string {
+	return randomDigits(3)
+}
+
+// LuhnCheckDigit computes the digit that makes a number of the given digits
+// pass the Luhn check when appended to them.
+func LuhnCheckDigit(digits string) string {
+	return fmt.Sprint((10 - luhnSum(digits+"0")%10) % 10)
+}
+
+// IsValidCardNumber returns true if the number has only digits, a length card
+// numbers can have, and a valid Luhn check digit.
+func IsValidCardNumber(number string) bool {
+	if len(number) < 12 || len(number) > 19 {
+		return false
+	}
+	sum := luhnSum(number)
+	return sum >= 0 && sum%10 == 0
+}
+
+// NormalizeCardNumber removes the spaces and dashes card numbers are often typed with.
+func NormalizeCardNumber(number string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(number)
+}
+
+// MaskCardNumber keeps the BIN and the last four digits of a card number,
+// which is all of it that may be displayed.
+func MaskCardNumber(number string) string {
+	if len(number) < 10 {
+		return strings.Repeat("*", len(number))
+	}
+	return number[:6] + strings.Repeat("*", len(number)-10) + number[len(number)-4:]
+}
+
+// CardToken returns the token a card number is stored and looked up by in place
+// of the number itself. The token is an HMAC so it cannot be reversed by trying
+// every number that starts with the BIN without knowing the key.
+func CardToken(key string, number string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(number))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CardExpiry returns the month and year a card issued at the given time expires.
+func CardExpiry(issuedAt time.Time) (month int32, year int32) {
+	expiry := issuedAt.AddDate(CardValidYears, 0, 0)
+	return int32(expiry.Month()), int32(expiry.Year())
+}
+
+// IsCardExpired returns true if a card expiring in the given month is no
+// longer valid at the given time. Cards are valid until the end of that month.
+func IsCardExpired(month int32, year int32, now time.Time) bool {
+	end := time.Date(int(year), time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC)
+	return !now.Before(end)
+}
+
+// luhnSum returns the Luhn sum of a string of digits, doubling every second
+// digit from the right, or -1 if the string holds any other character.
+func luhnSum(digits string) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return -1
+		}
+		d := int(c - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum
+}
+
+// randomDigits returns n digits read from a cryptographically secure source.
+func randomDigits(n int) string {
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			panic(fmt.Sprintf("cannot read random digit: %v", err))
+		}
+		sb.WriteByte(byte('0' + d.Int64()))
+	}
+	return sb.String()
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLuhnCheckDigit(t *testing.T) {
+	require.Equal(t, "3", LuhnCheckDigit("7992739871"))
+	require.Equal(t, "2", LuhnCheckDigit("424242424242424"))
+}
+
+func TestNewCardNumber(t *testing.T) {
+	number := NewCardNumber("400123")
+	require.Len(t, number, CardNumberLength)
+	require.Equal(t, "400123", number[:6])
+	require.True(t, IsValidCardNumber(number))
+	require.NotEqual(t, number, NewCardNumber("400123"))
+}
+
+func TestIsValidCardNumber(t *testing.T) {
+	testCases := []struct {
+		name   string
+		number string
+		want   bool
+	}{
+		{"Valid", "4242424242424242", true},
+		{"Typo", "4242424242424243", false},
+		{"SwappedDigits", "4242424242422442", false},
+		{"Letters", "424242424242424a", false},
+		{"TooShort", "42424242426", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, IsValidCardNumber(tc.number))
+		})
+	}
+}
+
+func TestMaskCardNumber(t *testing.T) {
+	require.Equal(t, "424242******4242", MaskCardNumber("4242424242424242"))
+	require.Equal(t, "424242******4242", MaskCardNumber(NormalizeCardNumber("4242 4242-4242 4242")))
+}
+
+func TestCardToken(t *testing.T) {
+	token := CardToken("key", "4242424242424242")
+	require.Len(t, token, 64)
+	require.Equal(t, token, CardToken("key", "4242424242424242"))
+	require.NotEqual(t, token, CardToken("other key", "4242424242424242"))
+}
+
+func TestCardExpiry(t *testing.T) {
+	month, year := CardExpiry(time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, int32(3), month)
+	require.Equal(t, int32(2024+CardValidYears), year)
+
+	require.False(t, IsCardExpired(12, 2024, time.Date(2024, time.December, 31, 23, 59, 0, 0, time.UTC)))
+	require.True(t, IsCardExpired(12, 2024, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -7,10 +7,13 @@ import (
 )
 
 type Config struct {
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	DBDriver          string `mapstructure:"DB_DRIVER"`
+	DBSource          string `mapstructure:"DB_SOURCE"`
+	MigrationURL      string `mapstructure:"MIGRATION_URL"`
+	HTTPServerAddress string `mapstructure:"HTTP_SERVER_ADDRESS"`
+	// PublicURL is where users reach the HTTP gateway from outside, used to
+	// build the links the bank sends them.
+	PublicURL            string        `mapstructure:"PUBLIC_URL"`
 	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
 	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
 	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
@@ -20,6 +23,14 @@ type Config struct {
 	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
 	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
 	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	// AccountNumberCountry and AccountNumberBankPrefix start every account
+	// number the bank hands out; the prefix holds the bank and branch codes.
+	AccountNumberCountry    string `mapstructure:"ACCOUNT_NUMBER_COUNTRY"`
+	AccountNumberBankPrefix string `mapstructure:"ACCOUNT_NUMBER_BANK_PREFIX"`
+	// CardBIN starts the number of every card the bank issues. CardTokenKey
+	// keys the HMAC card numbers are stored as, so it must stay secret.
+	CardBIN      string `mapstructure:"CARD_BIN"`
+	CardTokenKey string `mapstructure:"CARD_TOKEN_KEY"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -1,5 +1,7 @@
 package util
 
+import "fmt"
+
 // Constants for all supported currencies
 const (
 	USD = "USD"
@@ -15,3 +17,19 @@ func IsSupportedCurrency(currency string) bool {
 	}
 	return false
 }
+
+// MinorUnitsPerUnit is the number of minor units, e.g. cents, in one unit of
+// every supported currency. Amounts are stored in minor units.
+const MinorUnitsPerUnit = 100
+
+// FormatAmount formats an amount of minor units as a decimal number with two
+// fraction digits, e.g. -1234 as "-12.34".
+func FormatAmount(amount int64) string {
+	sign := ""
+	abs := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		abs = uint64(-amount)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, abs/MinorUnitsPerUnit, abs%MinorUnitsPerUnit)
+}
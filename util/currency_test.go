@@ -0,0 +1,29 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatAmount(t *testing.T) {
+	testCases := []struct {
+		name   string
+		amount int64
+		want   string
+	}{
+		{"Zero", 0, "0.00"},
+		{"Cents", 5, "0.05"},
+		{"Units", 1_234, "12.34"},
+		{"Negative", -1_234, "-12.34"},
+		{"NegativeCents", -5, "-0.05"},
+		{"MinInt64", math.MinInt64, "-92233720368547758.08"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, FormatAmount(tc.amount))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package util
+
+// Constants for the direction of a transaction from the account holder's view
+const (
+	DirectionDebit  = "debit"
+	DirectionCredit = "credit"
+)
+
+// IsSupportedDirection returns true if the direction is supported
+func IsSupportedDirection(direction string) bool {
+	switch direction {
+	case DirectionDebit, DirectionCredit:
+		return true
+	}
+	return false
+}
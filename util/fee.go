@@ -0,0 +1,20 @@
+package util
+
+import "math/big"
+
+// PercentageOf returns bps basis points of amount, rounded half away from zero.
+func PercentageOf(amount int64, bps int64) int64 {
+	num := new(big.Int).Mul(big.NewInt(amount), big.NewInt(bps))
+	den := big.NewInt(BasisPointsPerUnit)
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Abs(rem).Int64()*2 >= BasisPointsPerUnit {
+		if num.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	return quo.Int64()
+}
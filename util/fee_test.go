@@ -0,0 +1,29 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentageOf(t *testing.T) {
+	testCases := []struct {
+		name   string
+		amount int64
+		bps    int64
+		want   int64
+	}{
+		{"Zero", 0, 150, 0},
+		{"Exact", 10_000, 150, 150},
+		{"RoundDown", 1_033, 150, 15},
+		{"RoundHalfUp", 1_000, 5, 1},
+		{"RoundUp", 1_067, 150, 16},
+		{"Negative", -1_000, 5, -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, PercentageOf(tc.amount, tc.bps))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package util
+
+import "math/big"
+
+const (
+	// MicrosPerUnit is the number of micro-units in one minor currency unit.
+	// Interest is accrued at this precision so daily rounding does not lose money.
+	MicrosPerUnit = 1_000_000
+	// DaysPerYear is the day count convention used for daily accrual (Actual/365 Fixed).
+	DaysPerYear = 365
+	// BasisPointsPerUnit is the number of basis points in a rate of 100%.
+	BasisPointsPerUnit = 10_000
+)
+
+// DailyInterestMicros returns the interest earned by balance over one day at
+// annualRateBps, in micro-units, truncated toward zero.
+// Non-positive balances and rates earn nothing.
+func DailyInterestMicros(balance int64, annualRateBps int64) int64 {
+	if balance <= 0 || annualRateBps <= 0 {
+		return 0
+	}
+
+	num := new(big.Int).Mul(big.NewInt(balance), big.NewInt(annualRateBps))
+	num.Mul(num, big.NewInt(MicrosPerUnit))
+	den := big.NewInt(BasisPointsPerUnit * DaysPerYear)
+
+	return num.Quo(num, den).Int64()
+}
+
+// SplitMicros splits an amount of micro-units into whole minor units and the
+// remainder that must be carried forward.
+func SplitMicros(micros int64) (amount int64, carry int64) {
+	return micros / MicrosPerUnit, micros % MicrosPerUnit
+}
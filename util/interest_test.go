@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyInterestMicros(t *testing.T) {
+	testCases := []struct {
+		name    string
+		balance int64
+		rateBps int64
+		want    int64
+	}{
+		{"ZeroBalance", 0, 250, 0},
+		{"NegativeBalance", -1000, 250, 0},
+		{"ZeroRate", 1000, 0, 0},
+		// 100000 * 0.025 / 365 = 6.849315068...
+		{"Truncated", 100_000, 250, 6_849_315},
+		// 36500 * 0.01 / 365 = 1 exactly
+		{"Exact", 36_500, 100, 1_000_000},
+		{"LargeBalance", 1_000_000_000_000, 10_000, 2_739_726_027_397_260},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, DailyInterestMicros(tc.balance, tc.rateBps))
+		})
+	}
+}
+
+func TestSplitMicros(t *testing.T) {
+	amount, carry := SplitMicros(6_849_315 * 30)
+	require.Equal(t, int64(205), amount)
+	require.Equal(t, int64(479_450), carry)
+
+	amount, carry = SplitMicros(999_999)
+	require.Zero(t, amount)
+	require.Equal(t, int64(999_999), carry)
+}
@@ -0,0 +1,19 @@
+package util
+
+// Constants for the languages users can be written to in
+const (
+	LanguageEnglish    = "en"
+	LanguageVietnamese = "vi"
+)
+
+// DefaultLanguage is used for users who have not chosen a language.
+const DefaultLanguage = LanguageEnglish
+
+// IsSupportedLanguage returns true if emails can be rendered in the language
+func IsSupportedLanguage(language string) bool {
+	switch language {
+	case LanguageEnglish, LanguageVietnamese:
+		return true
+	}
+	return false
+}
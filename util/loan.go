@@ -0,0 +1,91 @@
+package util
+
+import (
+	"math/big"
+	"time"
+)
+
+// MonthsPerYear converts annual loan rates into monthly ones.
+const MonthsPerYear = 12
+
+// Instalment is one monthly payment of a loan schedule.
+type Instalment struct {
+	Number    int32
+	DueDate   time.Time
+	Principal int64
+	Interest  int64
+	Amount    int64
+}
+
+// AnnuitySchedule splits a loan into termMonths equal monthly payments with the
+// annuity formula, the first due one month after disbursedOn. Each month's
+// interest is the remaining principal times the monthly rate, rounded to the
+// nearest minor unit; the last instalment pays off whatever principal is left,
+// so rounding never leaves a residue.
+func AnnuitySchedule(principal int64, annualRateBps int64, termMonths int32, disbursedOn time.Time) []Instalment {
+	monthlyRate := big.NewRat(annualRateBps, BasisPointsPerUnit*MonthsPerYear)
+	payment := annuityPayment(principal, monthlyRate, termMonths)
+
+	schedule := make([]Instalment, termMonths)
+	balance := principal
+	for i := range schedule {
+		interest := roundRat(new(big.Rat).Mul(new(big.Rat).SetInt64(balance), monthlyRate))
+
+		repaid := payment - interest
+		if repaid > balance || i == len(schedule)-1 {
+			repaid = balance
+		}
+		if repaid < 0 {
+			repaid = 0
+		}
+		balance -= repaid
+
+		schedule[i] = Instalment{
+			Number:    int32(i + 1),
+			DueDate:   AddMonths(disbursedOn, i+1),
+			Principal: repaid,
+			Interest:  interest,
+			Amount:    repaid + interest,
+		}
+	}
+	return schedule
+}
+
+// annuityPayment returns P * r / (1 - (1 + r)^-n) rounded to the nearest minor
+// unit, or P / n when the rate is zero.
+func annuityPayment(principal int64, monthlyRate *big.Rat, termMonths int32) int64 {
+	p := new(big.Rat).SetInt64(principal)
+	if monthlyRate.Sign() == 0 {
+		return roundRat(p.Quo(p, big.NewRat(int64(termMonths), 1)))
+	}
+
+	growth := new(big.Rat).Add(big.NewRat(1, 1), monthlyRate)
+	compound := big.NewRat(1, 1)
+	for i := int32(0); i < termMonths; i++ {
+		compound.Mul(compound, growth)
+	}
+
+	num := new(big.Rat).Mul(p, monthlyRate)
+	num.Mul(num, compound)
+	den := new(big.Rat).Sub(compound, big.NewRat(1, 1))
+	return roundRat(num.Quo(num, den))
+}
+
+// roundRat rounds a non-negative rational to the nearest integer, halves up.
+func roundRat(value *big.Rat) int64 {
+	num := new(big.Int).Mul(value.Num(), big.NewInt(2))
+	num.Add(num, value.Denom())
+	den := new(big.Int).Mul(value.Denom(), big.NewInt(2))
+	return num.Quo(num, den).Int64()
+}
+
+// AddMonths moves a date by whole months, keeping the day of the month unless
+// the target month is shorter, in which case it lands on its last day.
+func AddMonths(date time.Time, months int) time.Time {
+	year, month, day := date.Date()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, date.Location())
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnuitySchedule(t *testing.T) {
+	disbursedOn := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	schedule := AnnuitySchedule(100_000, 1200, 12, disbursedOn)
+	require.Len(t, schedule, 12)
+
+	// 1% a month over 12 months gives a payment of 8884.88
+	require.Equal(t, int64(8885), schedule[0].Amount)
+	require.Equal(t, int64(1000), schedule[0].Interest)
+	require.Equal(t, int64(7885), schedule[0].Principal)
+	require.Equal(t, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), schedule[0].DueDate)
+	require.Equal(t, time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC), schedule[11].DueDate)
+
+	var principal int64
+	for i, instalment := range schedule {
+		require.Equal(t, int32(i+1), instalment.Number)
+		require.Equal(t, instalment.Principal+instalment.Interest, instalment.Amount)
+		if i < len(schedule)-1 {
+			require.Equal(t, schedule[0].Amount, instalment.Amount)
+		}
+		principal += instalment.Principal
+	}
+	require.Equal(t, int64(100_000), principal)
+
+	// the last instalment absorbs the rounding of the others
+	require.InDelta(t, schedule[0].Amount, schedule[11].Amount, 12)
+}
+
+func TestAnnuityScheduleWithoutInterest(t *testing.T) {
+	schedule := AnnuitySchedule(1000, 0, 3, time.Date(2024, time.May, 15, 0, 0, 0, 0, time.UTC))
+	require.Len(t, schedule, 3)
+	require.Equal(t, int64(333), schedule[0].Amount)
+	require.Equal(t, int64(333), schedule[1].Amount)
+	require.Equal(t, int64(334), schedule[2].Amount)
+	for _, instalment := range schedule {
+		require.Zero(t, instalment.Interest)
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	require.Equal(t, time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC), AddMonths(time.Date(2023, time.January, 31, 10, 0, 0, 0, time.UTC), 1))
+	require.Equal(t, time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), AddMonths(time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), 2))
+	require.Equal(t, time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC), AddMonths(time.Date(2024, time.December, 15, 0, 0, 0, 0, time.UTC), 1))
+}
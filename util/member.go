@@ -0,0 +1,17 @@
+package util
+
+// Constants for the role of a user on an account
+const (
+	MemberOwner   = "owner"
+	MemberCoOwner = "co_owner"
+	MemberViewer  = "viewer"
+)
+
+// IsSupportedMemberRole returns true if the member role is supported
+func IsSupportedMemberRole(role string) bool {
+	switch role {
+	case MemberOwner, MemberCoOwner, MemberViewer:
+		return true
+	}
+	return false
+}
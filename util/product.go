@@ -0,0 +1,19 @@
+package util
+
+// Constants for all account products
+const (
+	ProductCurrent     = "current"
+	ProductSavings     = "savings"
+	ProductInternal    = "internal"
+	ProductPot         = "pot"
+	ProductTermDeposit = "term_deposit"
+)
+
+// IsCustomerProduct returns true if customers can open accounts of the product
+func IsCustomerProduct(product string) bool {
+	switch product {
+	case ProductCurrent, ProductSavings:
+		return true
+	}
+	return false
+}
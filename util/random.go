@@ -51,3 +51,8 @@ func RandomCurrency() string {
 func RandomEmail() string {
 	return fmt.Sprintf("%s@email.com", RandomString(6))
 }
+
+// RandomAccountNumber generates a random account number with a valid checksum
+func RandomAccountNumber() string {
+	return NewAccountNumber("VN", "TEST0001")
+}
@@ -0,0 +1,18 @@
+package util
+
+// Constants for all statement formats
+const (
+	StatementCSV     = "csv"
+	StatementPDF     = "pdf"
+	StatementCAMT053 = "camt053"
+	StatementMT940   = "mt940"
+)
+
+// IsSupportedStatementFormat returns true if statements can be rendered in the format
+func IsSupportedStatementFormat(format string) bool {
+	switch format {
+	case StatementCSV, StatementPDF, StatementCAMT053, StatementMT940:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"math/big"
+	"time"
+)
+
+// Constants for what happens to a term deposit when it matures
+const (
+	MaturityPayout   = "payout"
+	MaturityRollover = "rollover"
+)
+
+// IsSupportedMaturityInstruction returns true if the maturity instruction is supported
+func IsSupportedMaturityInstruction(instruction string) bool {
+	switch instruction {
+	case MaturityPayout, MaturityRollover:
+		return true
+	}
+	return false
+}
+
+// TermInterest returns the simple interest earned by principal between two
+// days at annualRateBps, Actual/365 Fixed, truncated to whole minor units.
+func TermInterest(principal int64, annualRateBps int64, from time.Time, to time.Time) int64 {
+	days := int64(to.Sub(from).Hours() / 24)
+	if principal <= 0 || annualRateBps <= 0 || days <= 0 {
+		return 0
+	}
+
+	num := new(big.Int).Mul(big.NewInt(principal), big.NewInt(annualRateBps))
+	num.Mul(num, big.NewInt(days))
+	den := big.NewInt(BasisPointsPerUnit * DaysPerYear)
+
+	return num.Quo(num, den).Int64()
+}
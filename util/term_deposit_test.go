@@ -0,0 +1,20 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermInterest(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// 5% on 10000.00 for 366 days of a leap year
+	require.Equal(t, int64(50136), TermInterest(1_000_000, 500, start, AddMonths(start, 12)))
+	// 91 days at 3.5%, truncated from 872.60
+	require.Equal(t, int64(872), TermInterest(100_000, 350, start, AddMonths(start, 3)))
+
+	require.Zero(t, TermInterest(100_000, 0, start, AddMonths(start, 3)))
+	require.Zero(t, TermInterest(100_000, 350, start, start))
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignWebhook(t *testing.T) {
+	secret := NewWebhookSecret()
+	require.Len(t, secret, len("whsec_")+64)
+
+	body := []byte(`{"type":"transfer.completed"}`)
+	sentAt := time.Unix(1700000000, 0)
+	header := SignWebhook(secret, sentAt, body)
+	require.Regexp(t, `^t=1700000000,v1=[0-9a-f]{64}$`, header)
+
+	require.NoError(t, VerifyWebhookSignature(secret, header, body, sentAt.Add(time.Minute), 5*time.Minute))
+
+	require.ErrorIs(t, VerifyWebhookSignature(secret, header, []byte(`{}`), sentAt, 5*time.Minute), ErrInvalidWebhookSignature)
+	require.ErrorIs(t, VerifyWebhookSignature(NewWebhookSecret(), header, body, sentAt, 5*time.Minute), ErrInvalidWebhookSignature)
+	require.ErrorIs(t, VerifyWebhookSignature(secret, header, body, sentAt.Add(10*time.Minute), 5*time.Minute), ErrInvalidWebhookSignature)
+	require.ErrorIs(t, VerifyWebhookSignature(secret, "v1=abc", body, sentAt, 5*time.Minute), ErrInvalidWebhookSignature)
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	require.Equal(t, 30*time.Second, WebhookRetryDelay(0))
+	require.Equal(t, time.Minute, WebhookRetryDelay(1))
+	require.Equal(t, 4*time.Minute, WebhookRetryDelay(3))
+	require.Equal(t, 6*time.Hour, WebhookRetryDelay(20))
+}
+
+func TestIsPublicWebhookAddr(t *testing.T) {
+	for _, addr := range []string{"93.184.215.14", "2606:2800:21f:cb07:6820:80da:af6b:8b2c"} {
+		require.True(t, IsPublicWebhookAddr(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{
+		"127.0.0.1", "::1", "10.0.0.5", "172.16.3.4", "192.168.1.1", "fd00::1",
+		"169.254.169.254", "fe80::1", "0.0.0.0", "::", "224.0.0.1", "::ffff:127.0.0.1",
+	} {
+		require.False(t, IsPublicWebhookAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
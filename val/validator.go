@@ -1,14 +1,25 @@
 package val
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/mail"
+	"net/netip"
+	"net/url"
 	"regexp"
+	"strings"
+
+	"github.com/nhat195/simple_bank/util"
 )
 
 var (
-	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
-	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidUsername  = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
+	isValidFullName  = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidReference = regexp.MustCompile(`^[a-zA-Z0-9/?:().,'+\- ]*$`).MatchString
+	isValidPhone     = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`).MatchString
+	isAccountNumber  = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`).MatchString
+	isValidCVV       = regexp.MustCompile(`^[0-9]{3}$`).MatchString
+	isDigits         = regexp.MustCompile(`^[0-9]+$`).MatchString
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -63,3 +74,244 @@ func ValidateEmailId(value int64) error {
 func ValidateSecretCode(value string) error {
 	return ValidateString(value, 32, 128)
 }
+
+func ValidateAliasVerificationCode(value string) error {
+	if len(value) != util.AliasVerificationCodeLength || !isDigits(value) {
+		return fmt.Errorf("must be %d digits", util.AliasVerificationCodeLength)
+	}
+	return nil
+}
+
+func ValidateID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+// ValidateAccountNumber checks an account number that has already been normalized,
+// including its check digits, so most typos are caught before any lookup.
+func ValidateAccountNumber(value string) error {
+	if err := ValidateString(value, 15, 34); err != nil {
+		return err
+	}
+	if !isAccountNumber(value) {
+		return fmt.Errorf("must be a country code, two check digits and letters or digits")
+	}
+	if !util.IsValidAccountNumber(value) {
+		return fmt.Errorf("has invalid check digits")
+	}
+	return nil
+}
+
+func ValidateAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be greater than 0")
+	}
+	return nil
+}
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("unsupported currency")
+	}
+	return nil
+}
+
+func ValidateDirection(value string) error {
+	if !util.IsSupportedDirection(value) {
+		return fmt.Errorf("must be %s or %s", util.DirectionDebit, util.DirectionCredit)
+	}
+	return nil
+}
+
+func ValidateStatementFormat(value string) error {
+	if !util.IsSupportedStatementFormat(value) {
+		return fmt.Errorf("unsupported statement format")
+	}
+	return nil
+}
+
+func ValidateMemberRole(value string) error {
+	if !util.IsSupportedMemberRole(value) {
+		return fmt.Errorf("must be %s, %s or %s", util.MemberOwner, util.MemberCoOwner, util.MemberViewer)
+	}
+	return nil
+}
+
+func ValidateNickname(value string) error {
+	return ValidateString(value, 1, 50)
+}
+
+func ValidateAliasType(value string) error {
+	if !util.IsSupportedAliasType(value) {
+		return fmt.Errorf("must be %s, %s, %s or %s", util.AliasUsername, util.AliasEmail, util.AliasPhone, util.AliasHandle)
+	}
+	return nil
+}
+
+// ValidateAlias checks an alias that has already been normalized for its type.
+func ValidateAlias(aliasType string, value string) error {
+	switch aliasType {
+	case util.AliasUsername:
+		return ValidateUsername(value)
+	case util.AliasEmail:
+		return ValidateEmail(value)
+	case util.AliasPhone:
+		if !isValidPhone(value) {
+			return fmt.Errorf("must be a phone number in international format, e.g. +84901234567")
+		}
+		return nil
+	case util.AliasHandle:
+		if err := ValidateString(value, 3, 30); err != nil {
+			return err
+		}
+		if !isValidUsername(value) {
+			return fmt.Errorf("must contain only lowercase letters, digits, or underscore")
+		}
+		return nil
+	}
+	return ValidateAliasType(aliasType)
+}
+
+// ValidateCardNumber checks a card number that has already been normalized,
+// including its Luhn check digit.
+func ValidateCardNumber(value string) error {
+	if !util.IsValidCardNumber(value) {
+		return fmt.Errorf("must be a card number with a valid check digit")
+	}
+	return nil
+}
+
+func ValidateCVV(value string) error {
+	if !isValidCVV(value) {
+		return fmt.Errorf("must contain 3 digits")
+	}
+	return nil
+}
+
+func ValidateExpiryMonth(value int32) error {
+	if value < 1 || value > 12 {
+		return fmt.Errorf("must be from 1-12")
+	}
+	return nil
+}
+
+func ValidateMerchantName(value string) error {
+	return ValidateString(value, 1, MaxCounterpartyNameLength)
+}
+
+// MaxLoanTermMonths is the longest term a loan can be granted for.
+const MaxLoanTermMonths = 360
+
+func ValidateLoanTerm(value int32) error {
+	if value < 1 || value > MaxLoanTermMonths {
+		return fmt.Errorf("must be from 1-%d months", MaxLoanTermMonths)
+	}
+	return nil
+}
+
+func ValidateRateBps(value int64) error {
+	if value < 0 || value > util.BasisPointsPerUnit {
+		return fmt.Errorf("must be from 0-%d basis points", util.BasisPointsPerUnit)
+	}
+	return nil
+}
+
+// ValidateWebhookURL only accepts absolute https URLs, so deliveries and their
+// signatures are never sent in the clear, and rejects hosts in the bank's own
+// network. Host names are checked again when a delivery is made, since they
+// can resolve elsewhere later.
+func ValidateWebhookURL(value string) error {
+	if err := ValidateString(value, 1, 2048); err != nil {
+		return err
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return fmt.Errorf("must be an absolute https URL")
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("must not point to a local host")
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !util.IsPublicWebhookAddr(addr) {
+		return fmt.Errorf("must not point to a private or local address")
+	}
+	return nil
+}
+
+func ValidateLanguage(value string) error {
+	if !util.IsSupportedLanguage(value) {
+		return fmt.Errorf("must be %s or %s", util.LanguageEnglish, util.LanguageVietnamese)
+	}
+	return nil
+}
+
+func ValidateMaturityInstruction(value string) error {
+	if !util.IsSupportedMaturityInstruction(value) {
+		return fmt.Errorf("must be %s or %s", util.MaturityPayout, util.MaturityRollover)
+	}
+	return nil
+}
+
+func ValidateBatchSize(value int, maxSize int) error {
+	if value < 1 || value > maxSize {
+		return fmt.Errorf("must contain from 1-%d items", maxSize)
+	}
+	return nil
+}
+
+func ValidatePageID(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 5 || value > 100 {
+		return fmt.Errorf("must be from 5-100")
+	}
+	return nil
+}
+
+const (
+	MaxDescriptionLength      = 140
+	MaxReferenceLength        = 35
+	MaxCounterpartyNameLength = 140
+	MaxMetadataSize           = 4096
+)
+
+func ValidateDescription(value string) error {
+	return ValidateString(value, 0, MaxDescriptionLength)
+}
+
+// ValidateReference checks an end-to-end reference against the length and
+// character set that ISO 20022 allows, so it survives bank statement exports.
+func ValidateReference(value string) error {
+	if err := ValidateString(value, 0, MaxReferenceLength); err != nil {
+		return err
+	}
+	if !isValidReference(value) {
+		return fmt.Errorf("must contain only letters, digits, spaces or /-?:().,'+")
+	}
+	return nil
+}
+
+func ValidateCounterpartyName(value string) error {
+	return ValidateString(value, 0, MaxCounterpartyNameLength)
+}
+
+func ValidateMetadata(value []byte) error {
+	if len(value) == 0 {
+		return nil
+	}
+	if len(value) > MaxMetadataSize {
+		return fmt.Errorf("must not exceed %d bytes", MaxMetadataSize)
+	}
+	var object map[string]any
+	if err := json.Unmarshal(value, &object); err != nil || object == nil {
+		return fmt.Errorf("must be a JSON object")
+	}
+	return nil
+}
@@ -12,6 +12,46 @@ type TaskDistributor interface {
 		payload *PayloadVerifySendEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskGenerateStatement(
+		ctx context.Context,
+		payload *PayloadGenerateStatement,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendPaymentRequestEmail(
+		ctx context.Context,
+		payload *PayloadSendPaymentRequestEmail,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskTransferCompleted(
+		ctx context.Context,
+		payload *PayloadTransferCompleted,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskDeliverWebhook(
+		ctx context.Context,
+		payload *PayloadDeliverWebhook,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskEvaluateTransferAlerts(
+		ctx context.Context,
+		payload *PayloadEvaluateTransferAlerts,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskEvaluateLoginAlert(
+		ctx context.Context,
+		payload *PayloadEvaluateLoginAlert,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendNotification(
+		ctx context.Context,
+		payload *PayloadSendNotification,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendAliasVerification(
+		ctx context.Context,
+		payload *PayloadSendAliasVerification,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
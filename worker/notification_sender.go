@@ -0,0 +1,24 @@
+package worker
+
+import (
+	"context"
+
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/mail"
+)
+
+// NotificationSender delivers alerts to users over one channel. A new channel
+// is added by implementing it and registering it under the name of the
+// channel in NewRedisTaskProcessor and db.NotificationChannels.
+type NotificationSender interface {
+	SendNotification(ctx context.Context, user db.User, message mail.Message) error
+}
+
+// emailNotificationSender sends alerts to the email address of the user.
+type emailNotificationSender struct {
+	mailer mail.EmailSender
+}
+
+func (sender *emailNotificationSender) SendNotification(ctx context.Context, user db.User, message mail.Message) error {
+	return sender.mailer.SendMessage(message, []string{user.Email}, nil, nil, nil)
+}
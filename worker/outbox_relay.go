@@ -0,0 +1,174 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// outboxPollInterval is how long the relay waits after it has drained the outbox.
+	outboxPollInterval = time.Second
+	// outboxUnavailableBackoff caps how long the relay waits while the queue is
+	// down. The wait doubles from outboxPollInterval with every failed drain.
+	outboxUnavailableBackoff = time.Minute
+	outboxBatchSize          = 100
+	// outboxMaxAttempts stops retrying an event that keeps failing to publish.
+	// Failures while the queue is down do not count.
+	outboxMaxAttempts = 20
+	// outboxRetryBase and outboxRetryCap space out the retries of an event:
+	// 1s, 2s, 4s, ... up to an hour, so 20 attempts span about a day.
+	outboxRetryBase = time.Second
+	outboxRetryCap  = time.Hour
+	// outboxTaskRetention keeps finished tasks in Redis so an event published
+	// again after a failed commit is rejected as a duplicate task ID.
+	outboxTaskRetention = 24 * time.Hour
+)
+
+// OutboxRelay publishes the domain events committed to the outbox as asynq
+// tasks. Each task gets the ID of its event, so publishing an event twice
+// enqueues it only once.
+type OutboxRelay struct {
+	store       db.Store
+	distributor TaskDistributor
+}
+
+func NewOutboxRelay(store db.Store, distributor TaskDistributor) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		distributor: distributor,
+	}
+}
+
+// Start relays events until the context is cancelled. While the outbox or the
+// queue cannot be reached, it backs off instead of polling every second.
+func (r *OutboxRelay) Start(ctx context.Context) error {
+	wait := outboxPollInterval
+	for {
+		err := r.drain(ctx)
+		if err != nil {
+			log.Error().Err(err).Dur("retry_in", wait).Msg("cannot relay outbox events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+
+		if err != nil {
+			wait = min(2*wait, outboxUnavailableBackoff)
+		} else {
+			wait = outboxPollInterval
+		}
+	}
+}
+
+// drain relays batches of events until the outbox has no more pending ones,
+// stopping at the first batch that fails.
+func (r *OutboxRelay) drain(ctx context.Context) error {
+	for {
+		result, err := r.store.RelayOutboxTx(ctx, db.RelayOutboxTxParams{
+			Limit:       outboxBatchSize,
+			MaxAttempts: outboxMaxAttempts,
+			RetryDelay:  outboxRetryDelay,
+			Publish: func(event db.OutboxEvent) error {
+				return r.publish(ctx, event)
+			},
+		})
+		if result.Failed > 0 {
+			log.Warn().Int("published", result.Published).Int("failed", result.Failed).Msg("some outbox events failed to publish")
+		}
+		if err != nil {
+			return err
+		}
+		if result.Published+result.Failed < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+// outboxRetryDelay returns how long an event that failed to publish the given
+// number of times waits before the next attempt.
+func outboxRetryDelay(attempts int32) time.Duration {
+	delay := outboxRetryBase
+	for i := int32(1); i < attempts && delay < outboxRetryCap; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryCap)
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event db.OutboxEvent) error {
+	opts := []asynq.Option{
+		asynq.TaskID(event.EventID.String()),
+		asynq.Retention(outboxTaskRetention),
+	}
+
+	var err error
+	switch event.EventType {
+	case db.EventUserCreated:
+		var payload db.UserCreatedEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %w", err)
+		}
+		opts = append(opts,
+			asynq.MaxRetry(10),
+			asynq.ProcessIn(10*time.Second),
+			asynq.Queue(QueueCritical),
+		)
+		err = r.distributor.DistributeTaskSendVerifyEmail(ctx, &PayloadVerifySendEmail{
+			Username: payload.Username,
+		}, opts...)
+	case db.EventTransferCompleted:
+		var payload PayloadTransferCompleted
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %w", err)
+		}
+		opts = append(opts, asynq.Queue(QueueDefault))
+		err = r.distributor.DistributeTaskTransferCompleted(ctx, &payload, opts...)
+	case db.EventPaymentRequestCreated:
+		var payload db.PaymentRequestCreatedEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %w", err)
+		}
+		opts = append(opts,
+			asynq.MaxRetry(10),
+			asynq.ProcessIn(2*time.Second),
+			asynq.Queue(QueueDefault),
+		)
+		err = r.distributor.DistributeTaskSendPaymentRequestEmail(ctx, &PayloadSendPaymentRequestEmail{
+			PaymentRequestID: payload.PaymentRequestID,
+		}, opts...)
+	case db.EventStatementRequested:
+		var payload db.StatementRequestedEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %w", err)
+		}
+		opts = append(opts,
+			asynq.MaxRetry(5),
+			asynq.ProcessIn(2*time.Second),
+			asynq.Queue(QueueDefault),
+		)
+		err = r.distributor.DistributeTaskGenerateStatement(ctx, &PayloadGenerateStatement{
+			StatementID: payload.StatementID,
+		}, opts...)
+	default:
+		return fmt.Errorf("unknown event type %q", event.EventType)
+	}
+
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		// published by an earlier attempt whose commit failed
+		return nil
+	}
+	if err != nil {
+		// the payload was fine, so the queue itself could not take the task
+		return fmt.Errorf("%w: %v", db.ErrOutboxUnavailable, err)
+	}
+	return nil
+}
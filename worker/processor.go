@@ -2,9 +2,15 @@ package worker
 
 import (
 	"context"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hibiken/asynq"
 	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/mail"
+	"github.com/nhat195/simple_bank/sms"
+	"github.com/nhat195/simple_bank/util"
 	"github.com/rs/zerolog/log"
 )
 
@@ -16,14 +22,35 @@ const (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPostInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error
+	ProcessTaskGenerateStatement(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendPaymentRequestEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCollectLoanRepayments(ctx context.Context, task *asynq.Task) error
+	ProcessTaskMatureTermDeposits(ctx context.Context, task *asynq.Task) error
+	ProcessTaskTransferCompleted(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error
+	ProcessTaskEvaluateTransferAlerts(ctx context.Context, task *asynq.Task) error
+	ProcessTaskEvaluateLoginAlert(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendNotification(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendAliasVerification(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
-	server *asynq.Server
-	store  db.Store
+	server              *asynq.Server
+	store               db.Store
+	mailer              mail.EmailSender
+	smsSender           sms.SMSSender
+	distributor         TaskDistributor
+	webhookClient       *http.Client
+	notificationSenders map[string]NotificationSender
+	// publicURL is the base of the links sent to users
+	publicURL string
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) TaskProcessor {
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender, smsSender sms.SMSSender, distributor TaskDistributor, publicURL string) TaskProcessor {
 	server := asynq.NewServer(redisOpt, asynq.Config{
 		Queues: map[string]int{
 			QueueCritical: 10,
@@ -35,17 +62,45 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) TaskPr
 				Bytes("payload", task.Payload()).
 				Msg("task processing error")
 		}),
+		RetryDelayFunc: func(n int, err error, task *asynq.Task) time.Duration {
+			if task.Type() == TaskDeliverWebhook {
+				return util.WebhookRetryDelay(n)
+			}
+			return asynq.DefaultRetryDelayFunc(n, err, task)
+		},
 		Logger: NewLogger(),
 	})
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
+		server:        server,
+		store:         store,
+		mailer:        mailer,
+		smsSender:     smsSender,
+		distributor:   distributor,
+		webhookClient: newWebhookClient(),
+		notificationSenders: map[string]NotificationSender{
+			db.NotificationChannelEmail: &emailNotificationSender{mailer: mailer},
+		},
+		publicURL: strings.TrimRight(publicURL, "/"),
 	}
 }
 
 func (t *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(TaskSendVerifyEmail, t.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskAccrueInterest, t.ProcessTaskAccrueInterest)
+	mux.HandleFunc(TaskPostInterest, t.ProcessTaskPostInterest)
+	mux.HandleFunc(TaskReconcileLedger, t.ProcessTaskReconcileLedger)
+	mux.HandleFunc(TaskSnapshotBalances, t.ProcessTaskSnapshotBalances)
+	mux.HandleFunc(TaskGenerateStatement, t.ProcessTaskGenerateStatement)
+	mux.HandleFunc(TaskSendPaymentRequestEmail, t.ProcessTaskSendPaymentRequestEmail)
+	mux.HandleFunc(TaskCollectLoanRepayments, t.ProcessTaskCollectLoanRepayments)
+	mux.HandleFunc(TaskMatureTermDeposits, t.ProcessTaskMatureTermDeposits)
+	mux.HandleFunc(TaskTransferCompleted, t.ProcessTaskTransferCompleted)
+	mux.HandleFunc(TaskDeliverWebhook, t.ProcessTaskDeliverWebhook)
+	mux.HandleFunc(TaskEvaluateTransferAlerts, t.ProcessTaskEvaluateTransferAlerts)
+	mux.HandleFunc(TaskEvaluateLoginAlert, t.ProcessTaskEvaluateLoginAlert)
+	mux.HandleFunc(TaskSendNotification, t.ProcessTaskSendNotification)
+	mux.HandleFunc(TaskSendAliasVerification, t.ProcessTaskSendAliasVerification)
 
 	return t.server.Start(mux)
 }
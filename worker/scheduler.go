@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"github.com/hibiken/asynq"
+)
+
+const (
+	// snapshotBalancesSchedule runs right after midnight UTC to record the previous day's closing balances.
+	snapshotBalancesSchedule = "1 0 * * *"
+	// accrueInterestSchedule runs shortly after midnight UTC so the previous day is closed.
+	accrueInterestSchedule = "5 0 * * *"
+	// postInterestSchedule runs on the first day of the month, after that day's accrual.
+	postInterestSchedule = "30 0 1 * *"
+	// reconcileLedgerSchedule runs daily after interest has been accrued and posted.
+	reconcileLedgerSchedule = "0 2 * * *"
+	// collectLoanRepaymentsSchedule runs every morning UTC, so instalments are taken on
+	// their due date and arrears are retried daily until the account covers them.
+	collectLoanRepaymentsSchedule = "0 6 * * *"
+	// matureTermDepositsSchedule runs every morning UTC, so deposits are paid out or
+	// rolled over on the day they mature.
+	matureTermDepositsSchedule = "30 6 * * *"
+)
+
+type TaskScheduler interface {
+	Start() error
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt) TaskScheduler {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Logger: NewLogger(),
+	})
+	return &RedisTaskScheduler{scheduler: scheduler}
+}
+
+func (s *RedisTaskScheduler) Start() error {
+	if _, err := s.scheduler.Register(snapshotBalancesSchedule, asynq.NewTask(TaskSnapshotBalances, nil), asynq.Queue(QueueDefault)); err != nil {
+		return err
+	}
+	if _, err := s.scheduler.Register(accrueInterestSchedule, asynq.NewTask(TaskAccrueInterest, nil), asynq.Queue(QueueDefault)); err != nil {
+		return err
+	}
+	if _, err := s.scheduler.Register(postInterestSchedule, asynq.NewTask(TaskPostInterest, nil), asynq.Queue(QueueDefault)); err != nil {
+		return err
+	}
+	if _, err := s.scheduler.Register(reconcileLedgerSchedule, asynq.NewTask(TaskReconcileLedger, nil), asynq.Queue(QueueDefault)); err != nil {
+		return err
+	}
+	if _, err := s.scheduler.Register(collectLoanRepaymentsSchedule, asynq.NewTask(TaskCollectLoanRepayments, nil), asynq.Queue(QueueDefault)); err != nil {
+		return err
+	}
+	if _, err := s.scheduler.Register(matureTermDepositsSchedule, asynq.NewTask(TaskMatureTermDeposits, nil), asynq.Queue(QueueDefault)); err != nil {
+		return err
+	}
+
+	return s.scheduler.Start()
+}
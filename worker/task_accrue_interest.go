@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskAccrueInterest = "task:accrue_interest"
+
+	// dateLayout is the layout of dates carried in task payloads.
+	dateLayout = "2006-01-02"
+
+	accrualBatchSize = 100
+)
+
+// PayloadAccrueInterest selects the day to accrue interest for.
+// An empty Date means the previous UTC day, which is what the scheduler enqueues.
+type PayloadAccrueInterest struct {
+	Date string `json:"date,omitempty"`
+}
+
+func (t *RedisTaskProcessor) ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadAccrueInterest
+	if len(task.Payload()) > 0 {
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+		}
+	}
+
+	date, err := payloadDate(payload.Date, time.Now().UTC().AddDate(0, 0, -1))
+	if err != nil {
+		return fmt.Errorf("invalid accrual date: %v", asynq.SkipRetry)
+	}
+	cutoff := date.AddDate(0, 0, 1)
+
+	var afterID, accrued int64
+	for {
+		accounts, err := t.store.ListAccrualCandidates(ctx, db.ListAccrualCandidatesParams{
+			Cutoff:   cutoff,
+			AfterID:  afterID,
+			RowLimit: accrualBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("could not list accounts: %w", err)
+		}
+
+		for _, account := range accounts {
+			n, err := t.store.CreateInterestAccrual(ctx, db.CreateInterestAccrualParams{
+				AccountID:     account.ID,
+				AccrualDate:   date,
+				Balance:       account.EndOfDayBalance,
+				AnnualRateBps: account.AnnualRateBps,
+				AmountMicros:  util.DailyInterestMicros(account.EndOfDayBalance, account.AnnualRateBps),
+			})
+			if err != nil {
+				return fmt.Errorf("could not accrue interest for account %d: %w", account.ID, err)
+			}
+			accrued += n
+			afterID = account.ID
+		}
+
+		if len(accounts) < accrualBatchSize {
+			break
+		}
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("date", date.Format(dateLayout)).
+		Int64("accounts", accrued).
+		Msg("accrued interest")
+
+	return nil
+}
+
+// payloadDate parses a payload date, falling back to the start of the day of def.
+func payloadDate(value string, def time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Date(def.Year(), def.Month(), def.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+	return time.Parse(dateLayout, value)
+}
@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskCollectLoanRepayments = "task:collect_loan_repayments"
+
+// PayloadCollectLoanRepayments selects the day instalments are collected for.
+// An empty Date means the current UTC day, which is what the scheduler enqueues.
+type PayloadCollectLoanRepayments struct {
+	Date string `json:"date,omitempty"`
+}
+
+// ProcessTaskCollectLoanRepayments collects every instalment due by the day,
+// including the rest of earlier ones still in arrears.
+func (t *RedisTaskProcessor) ProcessTaskCollectLoanRepayments(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadCollectLoanRepayments
+	if len(task.Payload()) > 0 {
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+		}
+	}
+
+	date, err := payloadDate(payload.Date, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("invalid collection date: %v", asynq.SkipRetry)
+	}
+
+	loanIDs, err := t.store.ListDueLoans(ctx, date)
+	if err != nil {
+		return fmt.Errorf("could not list loans: %w", err)
+	}
+
+	var collected int64
+	var paidOff int
+	for _, loanID := range loanIDs {
+		result, err := t.store.CollectLoanRepaymentTx(ctx, db.CollectLoanRepaymentTxParams{
+			LoanID: loanID,
+			AsOf:   date,
+		})
+		if err != nil {
+			return fmt.Errorf("could not collect repayment of loan %d: %w", loanID, err)
+		}
+		collected += result.Collected
+		if result.Loan.Status == db.LoanPaidOff {
+			paidOff++
+		}
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("date", date.Format(dateLayout)).
+		Int("loans", len(loanIDs)).
+		Int("paid_off", paidOff).
+		Int64("amount", collected).
+		Msg("collected loan repayments")
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskDeliverWebhook = "task:deliver_webhook"
+	// WebhookMaxRetry retries a failing delivery for about a day with the
+	// backoff of util.WebhookRetryDelay before it is marked failed.
+	WebhookMaxRetry = 12
+	// webhookTimeout bounds how long a partner endpoint may take to answer.
+	webhookTimeout = 10 * time.Second
+)
+
+type PayloadDeliverWebhook struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+// WebhookBody is the JSON body POSTed to webhook endpoints.
+type WebhookBody struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+var errWebhookAddrNotAllowed = errors.New("webhook endpoint resolves to a private or local address")
+
+// newWebhookClient returns the client deliveries are sent with. It checks every
+// address it connects to, not only the URL registered, so a host name that is
+// later pointed at the bank's own network is refused at delivery time.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !util.IsPublicWebhookAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", errWebhookAddrNotAllowed, address)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// deliveries go straight to the endpoint, so the dialer sees its address
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   webhookTimeout,
+		// a redirect is reported as a failed delivery rather than followed
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (d *RedisTaskDistributor) DistributeTaskDeliverWebhook(ctx context.Context, payload *PayloadDeliverWebhook, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskDeliverWebhook, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskDeliverWebhook POSTs an event to a webhook endpoint, signed with
+// the secret of the endpoint, and records the outcome in the delivery log.
+// Anything but a 2xx response is retried until the last attempt marks the
+// delivery failed; it can still be replayed after that.
+func (t *RedisTaskProcessor) ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDeliverWebhook
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+
+	delivery, err := t.store.GetWebhookDelivery(ctx, payload.DeliveryID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("webhook delivery not found: %v", asynq.SkipRetry)
+		}
+		return fmt.Errorf("could not get webhook delivery: %w", err)
+	}
+	if delivery.Status != db.WebhookDeliveryPending {
+		return nil
+	}
+
+	endpoint, err := t.store.GetWebhookEndpoint(ctx, delivery.EndpointID)
+	if err != nil {
+		return fmt.Errorf("could not get webhook endpoint: %w", err)
+	}
+	if !endpoint.Active {
+		_, err = t.store.RecordWebhookAttempt(ctx, db.RecordWebhookAttemptParams{
+			ID:        delivery.ID,
+			Status:    db.WebhookDeliveryFailed,
+			LastError: "endpoint was deleted",
+		})
+		return err
+	}
+
+	responseStatus, deliveryErr := t.postWebhook(ctx, endpoint, delivery)
+
+	arg := db.RecordWebhookAttemptParams{
+		ID:             delivery.ID,
+		Status:         db.WebhookDeliverySucceeded,
+		ResponseStatus: int32(responseStatus),
+	}
+	if deliveryErr != nil {
+		arg.Status = db.WebhookDeliveryPending
+		arg.LastError = deliveryErr.Error()
+
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			arg.Status = db.WebhookDeliveryFailed
+		}
+	}
+
+	if _, err := t.store.RecordWebhookAttempt(ctx, arg); err != nil {
+		return fmt.Errorf("could not record webhook attempt: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Int64("delivery_id", delivery.ID).
+		Str("event_id", delivery.EventID.String()).
+		Int("response_status", responseStatus).
+		Str("status", arg.Status).
+		Msg("delivered webhook")
+
+	if deliveryErr != nil {
+		return fmt.Errorf("could not deliver webhook %d: %w", delivery.ID, deliveryErr)
+	}
+	return nil
+}
+
+// postWebhook sends one delivery and returns the HTTP status of the response,
+// or 0 if none was received.
+func (t *RedisTaskProcessor) postWebhook(ctx context.Context, endpoint db.WebhookEndpoint, delivery db.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(WebhookBody{
+		ID:        delivery.EventID,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.CreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(util.WebhookEventIDHeader, delivery.EventID.String())
+	req.Header.Set(util.WebhookSignatureHeader, util.SignWebhook(endpoint.Secret, time.Now(), body))
+
+	rsp, err := t.webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer rsp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(rsp.Body, 64<<10))
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return rsp.StatusCode, fmt.Errorf("endpoint responded with %s", rsp.Status)
+	}
+	return rsp.StatusCode, nil
+}
@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/mail"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskEvaluateLoginAlert = "task:evaluate_login_alert"
+
+type PayloadEvaluateLoginAlert struct {
+	SessionID uuid.UUID `json:"session_id"`
+}
+
+func (d *RedisTaskDistributor) DistributeTaskEvaluateLoginAlert(ctx context.Context, payload *PayloadEvaluateLoginAlert, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskEvaluateLoginAlert, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskEvaluateLoginAlert alerts a user who asked for it of a login from
+// a device they have not logged in from before, that is from a user agent and
+// IP address no earlier session of theirs had.
+func (t *RedisTaskProcessor) ProcessTaskEvaluateLoginAlert(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadEvaluateLoginAlert
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+
+	session, err := t.store.GetSession(ctx, payload.SessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("session not found: %v", asynq.SkipRetry)
+		}
+		return fmt.Errorf("could not get session: %w", err)
+	}
+
+	preference, err := t.store.GetNotificationPreference(ctx, db.GetNotificationPreferenceParams{
+		Username:  session.Username,
+		EventType: db.AlertNewLogin,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("could not get notification preference: %w", err)
+	}
+	if len(preference.Channels) == 0 {
+		return nil
+	}
+
+	origins, err := t.store.ListSessionOrigins(ctx, db.ListSessionOriginsParams{
+		Username: session.Username,
+		Before:   session.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("could not list session origins: %w", err)
+	}
+	for _, origin := range origins {
+		if origin.UserAgent == session.UserAgent && clientHost(origin.ClientIp) == clientHost(session.ClientIp) {
+			return nil
+		}
+	}
+
+	user, err := t.store.GetUser(ctx, session.Username)
+	if err != nil {
+		return fmt.Errorf("could not get user: %w", err)
+	}
+
+	message, err := mail.RenderNewLogin(user.Language, mail.NewLoginData{
+		FullName:  user.FullName,
+		At:        session.CreatedAt,
+		ClientIP:  clientHost(session.ClientIp),
+		UserAgent: session.UserAgent,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = t.dispatchAlert(ctx, session.ID.String(), preference, message)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("username", session.Username).
+		Msg("alerted new login")
+	return nil
+}
+
+// clientHost drops the port sessions record the client address with.
+func clientHost(clientIP string) string {
+	host, _, err := net.SplitHostPort(clientIP)
+	if err != nil {
+		return clientIP
+	}
+	return host
+}
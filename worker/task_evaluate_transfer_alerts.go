@@ -0,0 +1,175 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/mail"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskEvaluateTransferAlerts = "task:evaluate_transfer_alerts"
+
+type PayloadEvaluateTransferAlerts struct {
+	EventID  uuid.UUID                `json:"event_id"`
+	Transfer PayloadTransferCompleted `json:"transfer"`
+}
+
+func (d *RedisTaskDistributor) DistributeTaskEvaluateTransferAlerts(ctx context.Context, payload *PayloadEvaluateTransferAlerts, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskEvaluateTransferAlerts, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskEvaluateTransferAlerts alerts the members of both accounts of a
+// transfer who asked for it: of the incoming transfer on the receiving side,
+// and of a large debit or of the balance falling below their threshold on the
+// paying side. A low balance alert is only sent by the debit that crosses the
+// threshold, not by every debit after it.
+func (t *RedisTaskProcessor) ProcessTaskEvaluateTransferAlerts(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadEvaluateTransferAlerts
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+	transfer := payload.Transfer
+	key := payload.EventID.String()
+
+	var alerts int
+
+	err := t.forEachAlertSubscriber(ctx, transfer.ToAccountID, db.AlertIncomingTransfer, func(preference db.NotificationPreference, user db.User, account db.Account) error {
+		alerts++
+		message, err := mail.RenderIncomingTransfer(user.Language, mail.IncomingTransferData{
+			FullName:      user.FullName,
+			AccountNumber: account.AccountNumber,
+			Amount:        transfer.Amount,
+			Currency:      transfer.Currency,
+			Reference:     transfer.Reference,
+		})
+		if err != nil {
+			return err
+		}
+		return t.dispatchAlert(ctx, key, preference, message)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = t.forEachAlertSubscriber(ctx, transfer.FromAccountID, db.AlertLargeDebit, func(preference db.NotificationPreference, user db.User, account db.Account) error {
+		if !isLargeDebit(transfer, preference.Threshold) {
+			return nil
+		}
+		alerts++
+		message, err := mail.RenderLargeDebit(user.Language, mail.LargeDebitData{
+			FullName:      user.FullName,
+			AccountNumber: account.AccountNumber,
+			Amount:        transfer.Amount,
+			Currency:      transfer.Currency,
+		})
+		if err != nil {
+			return err
+		}
+		return t.dispatchAlert(ctx, key, preference, message)
+	})
+	if err != nil {
+		return err
+	}
+
+	var balance *int64
+	err = t.forEachAlertSubscriber(ctx, transfer.FromAccountID, db.AlertLowBalance, func(preference db.NotificationPreference, user db.User, account db.Account) error {
+		if balance == nil {
+			after, err := t.store.GetBalanceAfterTransfer(ctx, db.GetBalanceAfterTransferParams{
+				TransferID: transfer.TransferID,
+				AccountID:  transfer.FromAccountID,
+			})
+			if err != nil {
+				return fmt.Errorf("could not get balance after transfer: %w", err)
+			}
+			// the fee is booked right after the transfer
+			after -= transfer.Fee
+			balance = &after
+		}
+		if !crossesLowBalance(transfer, *balance, preference.Threshold) {
+			return nil
+		}
+		alerts++
+		message, err := mail.RenderLowBalance(user.Language, mail.LowBalanceData{
+			FullName:      user.FullName,
+			AccountNumber: account.AccountNumber,
+			Amount:        transfer.Amount,
+			Balance:       *balance,
+			Currency:      account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+		return t.dispatchAlert(ctx, key, preference, message)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("event_id", key).
+		Int64("transfer_id", transfer.TransferID).
+		Int("alerts", alerts).
+		Msg("evaluated transfer alerts")
+	return nil
+}
+
+// isLargeDebit reports whether a transfer is large enough for a large debit
+// alert with the given threshold.
+func isLargeDebit(transfer PayloadTransferCompleted, threshold int64) bool {
+	return transfer.Amount >= threshold
+}
+
+// crossesLowBalance reports whether a transfer and its fee, which left the
+// paying account with the given balance, took it below the threshold.
+func crossesLowBalance(transfer PayloadTransferCompleted, balance int64, threshold int64) bool {
+	return balance < threshold && balance+transfer.Amount+transfer.Fee >= threshold
+}
+
+// forEachAlertSubscriber calls fn for every member of the account who turned
+// on alerts of the given type.
+func (t *RedisTaskProcessor) forEachAlertSubscriber(ctx context.Context, accountID int64, alertType string, fn func(preference db.NotificationPreference, user db.User, account db.Account) error) error {
+	preferences, err := t.store.ListAccountAlertSubscribers(ctx, db.ListAccountAlertSubscribersParams{
+		AccountID: accountID,
+		EventType: alertType,
+	})
+	if err != nil {
+		return fmt.Errorf("could not list %s subscribers: %w", alertType, err)
+	}
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	account, err := t.store.GetAccount(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("could not get account: %w", err)
+	}
+
+	for _, preference := range preferences {
+		user, err := t.store.GetUser(ctx, preference.Username)
+		if err != nil {
+			return fmt.Errorf("could not get user: %w", err)
+		}
+		if err := fn(preference, user, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
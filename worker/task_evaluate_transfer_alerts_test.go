@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsLargeDebit(t *testing.T) {
+	transfer := PayloadTransferCompleted{Amount: 600, Fee: 5}
+
+	require.True(t, isLargeDebit(transfer, 500))
+	require.True(t, isLargeDebit(transfer, 600))
+	require.False(t, isLargeDebit(transfer, 601))
+}
+
+func TestCrossesLowBalance(t *testing.T) {
+	testCases := []struct {
+		name      string
+		transfer  PayloadTransferCompleted
+		balance   int64
+		threshold int64
+		alert     bool
+	}{
+		{
+			name:      "Crosses",
+			transfer:  PayloadTransferCompleted{Amount: 50},
+			balance:   80,
+			threshold: 100,
+			alert:     true,
+		},
+		{
+			name:      "StaysAbove",
+			transfer:  PayloadTransferCompleted{Amount: 50},
+			balance:   100,
+			threshold: 100,
+			alert:     false,
+		},
+		{
+			name:      "AlreadyBelow",
+			transfer:  PayloadTransferCompleted{Amount: 50},
+			balance:   40,
+			threshold: 100,
+			alert:     false,
+		},
+		{
+			// the transfer alone leaves exactly the threshold
+			name:      "FeeCrosses",
+			transfer:  PayloadTransferCompleted{Amount: 50, Fee: 5},
+			balance:   95,
+			threshold: 100,
+			alert:     true,
+		},
+		{
+			name:      "AlreadyBelowBeforeFee",
+			transfer:  PayloadTransferCompleted{Amount: 50, Fee: 5},
+			balance:   40,
+			threshold: 100,
+			alert:     false,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.alert, crossesLowBalance(tc.transfer, tc.balance, tc.threshold))
+		})
+	}
+}
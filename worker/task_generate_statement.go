@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/statement"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskGenerateStatement = "task:generate_statement"
+
+type PayloadGenerateStatement struct {
+	StatementID uuid.UUID `json:"statement_id"`
+}
+
+func (d *RedisTaskDistributor) DistributeTaskGenerateStatement(ctx context.Context, payload *PayloadGenerateStatement, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskGenerateStatement, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskGenerateStatement renders a pending statement and stores the document.
+// The statement is marked as failed once the task has run out of retries.
+func (t *RedisTaskProcessor) ProcessTaskGenerateStatement(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadGenerateStatement
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+
+	stmt, err := t.store.GetStatement(ctx, payload.StatementID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("statement not found")
+		}
+		return fmt.Errorf("could not get statement: %w", err)
+	}
+
+	if stmt.Status != db.StatementPending {
+		return nil
+	}
+
+	content, err := t.renderStatement(ctx, stmt)
+	if err != nil {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			if _, failErr := t.store.FailStatement(ctx, db.FailStatementParams{
+				ID:            stmt.ID,
+				FailureReason: err.Error(),
+			}); failErr != nil {
+				return fmt.Errorf("could not mark statement as failed: %w", failErr)
+			}
+		}
+		return err
+	}
+
+	_, err = t.store.CompleteStatement(ctx, db.CompleteStatementParams{
+		ID:      stmt.ID,
+		Content: content,
+	})
+	if err != nil {
+		return fmt.Errorf("could not store statement: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("statement_id", stmt.ID.String()).
+		Int64("account_id", stmt.AccountID).
+		Int("bytes", len(content)).
+		Msg("generated statement")
+
+	return nil
+}
+
+func (t *RedisTaskProcessor) renderStatement(ctx context.Context, stmt db.Statement) ([]byte, error) {
+	accountStatement, err := t.store.BuildStatement(ctx, stmt.AccountID, stmt.PeriodStart, stmt.PeriodEnd)
+	if err != nil {
+		return nil, fmt.Errorf("could not build statement: %w", err)
+	}
+
+	content, err := statement.Render(stmt.Format, accountStatement)
+	if err != nil {
+		return nil, fmt.Errorf("could not render statement: %w", err)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskMatureTermDeposits = "task:mature_term_deposits"
+
+// PayloadMatureTermDeposits selects the day term deposits are matured for.
+// An empty Date means the current UTC day, which is what the scheduler enqueues.
+type PayloadMatureTermDeposits struct {
+	Date string `json:"date,omitempty"`
+}
+
+// ProcessTaskMatureTermDeposits pays interest on every active term deposit that
+// matured by the day, then pays it out or rolls it over as its owner asked.
+func (t *RedisTaskProcessor) ProcessTaskMatureTermDeposits(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadMatureTermDeposits
+	if len(task.Payload()) > 0 {
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+		}
+	}
+
+	date, err := payloadDate(payload.Date, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("invalid maturity date: %v", asynq.SkipRetry)
+	}
+
+	accountIDs, err := t.store.ListMaturedTermDeposits(ctx, date)
+	if err != nil {
+		return fmt.Errorf("could not list term deposits: %w", err)
+	}
+
+	var interest int64
+	var paidOut int
+	for _, accountID := range accountIDs {
+		result, err := t.store.MatureTermDepositTx(ctx, accountID, date)
+		if err != nil {
+			return fmt.Errorf("could not mature term deposit %d: %w", accountID, err)
+		}
+		interest += result.Interest
+		if result.TermDeposit.Status == db.TermDepositPaidOut {
+			paidOut++
+		}
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("date", date.Format(dateLayout)).
+		Int("deposits", len(accountIDs)).
+		Int("paid_out", paidOut).
+		Int64("interest", interest).
+		Msg("matured term deposits")
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskPostInterest = "task:post_interest"
+
+// PayloadPostInterest selects the last day of the period to post.
+// An empty PeriodEnd means the last day of the previous UTC month.
+type PayloadPostInterest struct {
+	PeriodEnd string `json:"period_end,omitempty"`
+}
+
+func (t *RedisTaskProcessor) ProcessTaskPostInterest(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadPostInterest
+	if len(task.Payload()) > 0 {
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+		}
+	}
+
+	now := time.Now().UTC()
+	periodEnd, err := payloadDate(payload.PeriodEnd, time.Date(now.Year(), now.Month(), 0, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return fmt.Errorf("invalid period end: %v", asynq.SkipRetry)
+	}
+
+	accountIDs, err := t.store.ListUnpostedAccrualAccounts(ctx, periodEnd)
+	if err != nil {
+		return fmt.Errorf("could not list accounts: %w", err)
+	}
+
+	var paid int64
+	for _, accountID := range accountIDs {
+		result, err := t.store.PostInterestTx(ctx, db.PostInterestTxParams{
+			AccountID: accountID,
+			PeriodEnd: periodEnd,
+		})
+		if err != nil {
+			return fmt.Errorf("could not post interest for account %d: %w", accountID, err)
+		}
+		paid += result.Posting.Amount
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("period_end", periodEnd.Format(dateLayout)).
+		Int("accounts", len(accountIDs)).
+		Int64("amount", paid).
+		Msg("posted interest")
+
+	return nil
+}
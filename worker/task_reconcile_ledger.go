@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskReconcileLedger = "task:reconcile_ledger"
+
+func (t *RedisTaskProcessor) ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error {
+	result, err := t.store.ReconcileTx(ctx)
+	if err != nil {
+		return fmt.Errorf("could not reconcile ledger: %w", err)
+	}
+
+	LogReconciliation(result.RunID.String(), len(result.Findings))
+	return nil
+}
+
+// LogReconciliation reports the outcome of a reconciliation run.
+// Runs with findings are logged at error level with alert set so they can be alerted on.
+func LogReconciliation(runID string, findings int) {
+	if findings > 0 {
+		log.Error().Bool("alert", true).
+			Str("run_id", runID).
+			Int("findings", findings).
+			Msg("ledger reconciliation found discrepancies")
+		return
+	}
+
+	log.Info().Str("run_id", runID).Msg("ledger reconciliation passed")
+}
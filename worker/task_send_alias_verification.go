@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/util"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendAliasVerification = "task:send_alias_verification"
+
+// aliasVerificationTexts are the text messages carrying a verification code, by language.
+var aliasVerificationTexts = map[string]string{
+	util.LanguageEnglish:    "Your Simple Bank verification code is %s. It expires in %d minutes. Never share it with anyone.",
+	util.LanguageVietnamese: "Mã xác minh Simple Bank của bạn là %s. Mã hết hạn sau %d phút. Không chia sẻ mã này với bất kỳ ai.",
+}
+
+type PayloadSendAliasVerification struct {
+	AliasType string `json:"alias_type"`
+	Alias     string `json:"alias"`
+	Username  string `json:"username"`
+}
+
+func (d *RedisTaskDistributor) DistributeTaskSendAliasVerification(ctx context.Context, payload *PayloadSendAliasVerification, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskSendAliasVerification, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskSendAliasVerification texts the verification code of a phone
+// number to it. The code is read from the alias rather than the payload, so it
+// never sits in the queue, and nothing is sent once it is used or expired.
+func (t *RedisTaskProcessor) ProcessTaskSendAliasVerification(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendAliasVerification
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+
+	alias, err := t.store.GetUserPaymentAlias(ctx, db.GetUserPaymentAliasParams{
+		AliasType: payload.AliasType,
+		Value:     payload.Alias,
+		Username:  payload.Username,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// deleted before the code went out
+			return nil
+		}
+		return fmt.Errorf("could not get payment alias: %w", err)
+	}
+
+	if alias.VerifiedAt.Valid || alias.SecretCode == "" || time.Now().After(alias.CodeExpiredAt) {
+		return nil
+	}
+
+	user, err := t.store.GetUser(ctx, alias.Username)
+	if err != nil {
+		return fmt.Errorf("could not get user: %w", err)
+	}
+
+	text, ok := aliasVerificationTexts[user.Language]
+	if !ok {
+		text = aliasVerificationTexts[util.DefaultLanguage]
+	}
+
+	err = t.smsSender.SendSMS(alias.Value, fmt.Sprintf(text, alias.SecretCode, int(util.AliasVerificationExpiry.Minutes())))
+	if err != nil {
+		return fmt.Errorf("could not send verification code: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("alias_type", alias.AliasType).
+		Str("username", alias.Username).
+		Msg("sent alias verification code")
+	return nil
+}
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/mail"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendNotification = "task:send_notification"
+
+// PayloadSendNotification carries an alert rendered in the language of the user.
+type PayloadSendNotification struct {
+	Username string       `json:"username"`
+	Channel  string       `json:"channel"`
+	Message  mail.Message `json:"message"`
+}
+
+func (d *RedisTaskDistributor) DistributeTaskSendNotification(ctx context.Context, payload *PayloadSendNotification, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskSendNotification, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskSendNotification sends an alert through the sender of its channel.
+func (t *RedisTaskProcessor) ProcessTaskSendNotification(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendNotification
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+
+	sender, ok := t.notificationSenders[payload.Channel]
+	if !ok {
+		return fmt.Errorf("no sender for channel %q: %v", payload.Channel, asynq.SkipRetry)
+	}
+
+	user, err := t.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("user not found: %v", asynq.SkipRetry)
+		}
+		return fmt.Errorf("could not get user: %w", err)
+	}
+
+	err = sender.SendNotification(ctx, user, payload.Message)
+	if err != nil {
+		return fmt.Errorf("could not send %s notification: %w", payload.Channel, err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("username", payload.Username).
+		Str("channel", payload.Channel).
+		Msg("sent notification")
+	return nil
+}
+
+// dispatchAlert queues an alert for every channel of the preference. The key
+// names the event the alert is for, so evaluating an event again never sends
+// the same alert twice.
+func (t *RedisTaskProcessor) dispatchAlert(ctx context.Context, key string, preference db.NotificationPreference, message mail.Message) error {
+	for _, channel := range preference.Channels {
+		opts := []asynq.Option{
+			asynq.MaxRetry(10),
+			asynq.Queue(QueueDefault),
+			asynq.TaskID(fmt.Sprintf("notification:%s:%s:%s:%s", key, preference.EventType, preference.Username, channel)),
+			asynq.Retention(outboxTaskRetention),
+		}
+		err := t.distributor.DistributeTaskSendNotification(ctx, &PayloadSendNotification{
+			Username: preference.Username,
+			Channel:  channel,
+			Message:  message,
+		}, opts...)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+	return nil
+}
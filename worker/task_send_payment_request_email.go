@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/nhat195/simple_bank/mail"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendPaymentRequestEmail = "task:send_payment_request_email"
+
+type PayloadSendPaymentRequestEmail struct {
+	PaymentRequestID uuid.UUID `json:"payment_request_id"`
+}
+
+func (d *RedisTaskDistributor) DistributeTaskSendPaymentRequestEmail(ctx context.Context, payload *PayloadSendPaymentRequestEmail, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskSendPaymentRequestEmail, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskSendPaymentRequestEmail tells the payer of a payment request about it.
+// Nothing is sent once the request has been answered or has expired.
+func (t *RedisTaskProcessor) ProcessTaskSendPaymentRequestEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendPaymentRequestEmail
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+
+	// the request is committed after the task is enqueued, so retry until it shows up
+	request, err := t.store.GetPaymentRequest(ctx, payload.PaymentRequestID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("payment request not found")
+		}
+		return fmt.Errorf("could not get payment request: %w", err)
+	}
+
+	if request.CheckOpen(time.Now()) != nil {
+		return nil
+	}
+
+	payer, err := t.store.GetUser(ctx, request.Payer)
+	if err != nil {
+		return fmt.Errorf("could not get payer: %w", err)
+	}
+
+	requester, err := t.store.GetUser(ctx, request.Requester)
+	if err != nil {
+		return fmt.Errorf("could not get requester: %w", err)
+	}
+
+	message, err := mail.RenderPaymentRequest(payer.Language, mail.PaymentRequestData{
+		PayerName:     payer.FullName,
+		RequesterName: requester.FullName,
+		Amount:        request.Amount,
+		Currency:      request.Currency,
+		Message:       request.Message,
+		ExpiresAt:     request.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("could not render payment request email: %w", err)
+	}
+
+	err = t.mailer.SendMessage(message, []string{payer.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("could not send payment request email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", payer.Email).
+		Msg("sent payment request email")
+	return nil
+}
@@ -7,10 +7,18 @@ import (
 	"fmt"
 
 	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/nhat195/simple_bank/mail"
+	"github.com/nhat195/simple_bank/util"
 	"github.com/rs/zerolog/log"
 )
 
-const TaskSendVerifyEmail = "task:send_verify_email"
+const (
+	TaskSendVerifyEmail = "task:send_verify_email"
+	// verifyEmailPath is the gateway route a user confirms their address at,
+	// filled in with the ID and secret code of their verify email.
+	verifyEmailPath = "/v1/verify_email?email_id=%d&secret_code=%s"
+)
 
 type PayloadVerifySendEmail struct {
 	Username string `json:"username"`
@@ -33,6 +41,9 @@ func (d *RedisTaskDistributor) DistributeTaskSendVerifyEmail(ctx context.Context
 	return nil
 }
 
+// ProcessTaskSendVerifyEmail sends the user the link to confirm their address,
+// in their language. A retry reuses the pending verify email for the address
+// instead of recording a new secret code each time.
 func (t *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error {
 	var payload PayloadVerifySendEmail
 
@@ -48,11 +59,38 @@ func (t *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Context, tas
 		}
 		return fmt.Errorf("could not get user: %w", err)
 	}
+
+	verifyEmail, err := t.store.GetPendingVerifyEmail(ctx, db.GetPendingVerifyEmailParams{
+		Username: user.Username,
+		Email:    user.Email,
+	})
+	if err == sql.ErrNoRows {
+		verifyEmail, err = t.store.CreateVerifyEmail(ctx, db.CreateVerifyEmailParams{
+			Username:   user.Username,
+			Email:      user.Email,
+			SecretCode: util.RandomString(32),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("could not get verify email: %w", err)
+	}
+
+	message, err := mail.RenderVerifyEmail(user.Language, mail.VerifyEmailData{
+		FullName:  user.FullName,
+		VerifyURL: t.publicURL + fmt.Sprintf(verifyEmailPath, verifyEmail.ID, verifyEmail.SecretCode),
+	})
+	if err != nil {
+		return fmt.Errorf("could not render verify email: %w", err)
+	}
+
+	err = t.mailer.SendMessage(message, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("could not send verify email: %w", err)
+	}
+
 	log.Info().Str("type", task.Type()).
 		Bytes("payload", task.Payload()).
 		Str("email", user.Email).
-		Msg("sending verify email")
-
+		Msg("sent verify email")
 	return nil
-
 }
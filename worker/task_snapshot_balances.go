@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSnapshotBalances = "task:snapshot_balances"
+
+// PayloadSnapshotBalances selects the day whose closing balances are recorded.
+// An empty Date means the previous UTC day, which is what the scheduler enqueues.
+type PayloadSnapshotBalances struct {
+	Date string `json:"date,omitempty"`
+}
+
+func (t *RedisTaskProcessor) ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSnapshotBalances
+	if len(task.Payload()) > 0 {
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+		}
+	}
+
+	date, err := payloadDate(payload.Date, time.Now().UTC().AddDate(0, 0, -1))
+	if err != nil {
+		return fmt.Errorf("invalid snapshot date: %v", asynq.SkipRetry)
+	}
+
+	n, err := t.store.CreateDailyBalances(ctx, db.CreateDailyBalancesParams{
+		BalanceDate: date,
+		AsOf:        date.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return fmt.Errorf("could not snapshot balances: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("date", date.Format(dateLayout)).
+		Int64("accounts", n).
+		Msg("recorded daily balances")
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	db "github.com/nhat195/simple_bank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskTransferCompleted = "task:transfer_completed"
+
+// PayloadTransferCompleted is the transfer.completed event as written to the outbox.
+type PayloadTransferCompleted = db.TransferCompletedEvent
+
+// WebhookTransferCompleted is the data of a transfer.completed webhook. Partners
+// only ever see account numbers, never the internal account IDs.
+type WebhookTransferCompleted struct {
+	TransferID        int64     `json:"transfer_id"`
+	FromAccountNumber string    `json:"from_account_number"`
+	ToAccountNumber   string    `json:"to_account_number"`
+	Amount            int64     `json:"amount"`
+	Currency          string    `json:"currency"`
+	Reference         string    `json:"reference"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+func (d *RedisTaskDistributor) DistributeTaskTransferCompleted(ctx context.Context, payload *PayloadTransferCompleted, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+	task := asynq.NewTask(TaskTransferCompleted, jsonPayload, opts...)
+	info, err := d.client.EnqueueContext(ctx, task)
+
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskTransferCompleted receives every committed customer transfer once
+// the outbox relay has published it, queues a webhook delivery for each
+// endpoint subscribed to transfers by a member of either account, and queues
+// the evaluation of the alerts of their members. The task ID is the ID of the
+// outbox event, which deliveries and alerts are deduplicated by.
+func (t *RedisTaskProcessor) ProcessTaskTransferCompleted(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadTransferCompleted
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %v", asynq.SkipRetry)
+	}
+
+	taskID, _ := asynq.GetTaskID(ctx)
+	eventID, err := uuid.Parse(taskID)
+	if err != nil {
+		return fmt.Errorf("task has no event ID: %v", asynq.SkipRetry)
+	}
+
+	endpoints, err := t.store.ListWebhookEndpointsForAccounts(ctx, db.ListWebhookEndpointsForAccountsParams{
+		EventType:  db.EventTransferCompleted,
+		AccountIds: []int64{payload.FromAccountID, payload.ToAccountID},
+	})
+	if err != nil {
+		return fmt.Errorf("could not list webhook endpoints: %w", err)
+	}
+
+	webhookData, err := json.Marshal(WebhookTransferCompleted{
+		TransferID:        payload.TransferID,
+		FromAccountNumber: payload.FromAccountNumber,
+		ToAccountNumber:   payload.ToAccountNumber,
+		Amount:            payload.Amount,
+		Currency:          payload.Currency,
+		Reference:         payload.Reference,
+		CreatedAt:         payload.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal webhook data: %w", err)
+	}
+
+	for _, endpoint := range endpoints {
+		delivery, err := t.store.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+			EndpointID: endpoint.ID,
+			EventID:    eventID,
+			EventType:  db.EventTransferCompleted,
+			Payload:    webhookData,
+		})
+		if err != nil {
+			return fmt.Errorf("could not create webhook delivery: %w", err)
+		}
+		if delivery.Status != db.WebhookDeliveryPending {
+			continue
+		}
+
+		opts := []asynq.Option{
+			asynq.MaxRetry(WebhookMaxRetry),
+			asynq.Queue(QueueDefault),
+			asynq.TaskID(fmt.Sprintf("webhook_delivery:%d", delivery.ID)),
+			asynq.Retention(outboxTaskRetention),
+		}
+		err = t.distributor.DistributeTaskDeliverWebhook(ctx, &PayloadDeliverWebhook{
+			DeliveryID: delivery.ID,
+		}, opts...)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+
+	opts := []asynq.Option{
+		asynq.Queue(QueueDefault),
+		asynq.TaskID(fmt.Sprintf("transfer_alerts:%s", eventID)),
+		asynq.Retention(outboxTaskRetention),
+	}
+	err = t.distributor.DistributeTaskEvaluateTransferAlerts(ctx, &PayloadEvaluateTransferAlerts{
+		EventID:  eventID,
+		Transfer: payload,
+	}, opts...)
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return err
+	}
+
+	log.Info().Str("type", task.Type()).
+		Str("event_id", eventID.String()).
+		Int64("transfer_id", payload.TransferID).
+		Int("webhooks", len(endpoints)).
+		Msg("transfer completed")
+
+	return nil
+}